@@ -515,6 +515,18 @@ func NewWasmApp(
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	availableCapabilities := "iterator,staking,stargate,cosmwasm_1_1"
+	// contracts can use x/feegrant, x/authz, the weighted votes and deposits of x/gov and the denom metadata of x/bank
+	// via custom messages and queries
+	wasmOpts = append([]wasm.Option{
+		wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{Custom: wasmkeeper.ChainCustomEncoders(
+			wasmkeeper.EncodeFeegrantAuthzMsg(appCodec),
+			wasmkeeper.EncodeGovCustomMsg,
+		)}),
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{Custom: wasmkeeper.ChainCustomQueriers(
+			wasmkeeper.FeegrantAuthzQuerier(app.GRPCQueryRouter(), appCodec),
+			wasmkeeper.BankMetadataQuerier(app.BankKeeper),
+		)}),
+	}, wasmOpts...)
 	app.WasmKeeper = wasm.NewKeeper(
 		appCodec,
 		keys[wasm.StoreKey],
@@ -532,6 +544,7 @@ func NewWasmApp(
 		wasmDir,
 		wasmConfig,
 		availableCapabilities,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		wasmOpts...,
 	)
 
@@ -930,6 +943,11 @@ func (app *WasmApp) AppCodec() codec.Codec {
 	return app.appCodec
 }
 
+// InterfaceRegistry returns WasmApp's InterfaceRegistry
+func (app *WasmApp) InterfaceRegistry() types.InterfaceRegistry {
+	return app.interfaceRegistry
+}
+
 func (app *WasmApp) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
 }
@@ -514,6 +514,18 @@ func NewWasmApp(
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	availableCapabilities := "iterator,staking,stargate,cosmwasm_1_1"
+	// contracts can use x/feegrant, x/authz, the weighted votes and deposits of x/gov and the denom metadata of x/bank
+	// via custom messages and queries
+	wasmOpts = append([]wasmkeeper.Option{
+		wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{Custom: wasmkeeper.ChainCustomEncoders(
+			wasmkeeper.EncodeFeegrantAuthzMsg(appCodec),
+			wasmkeeper.EncodeGovCustomMsg,
+		)}),
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{Custom: wasmkeeper.ChainCustomQueriers(
+			wasmkeeper.FeegrantAuthzQuerier(app.GRPCQueryRouter(), appCodec),
+			wasmkeeper.BankMetadataQuerier(app.BankKeeper),
+		)}),
+	}, wasmOpts...)
 	app.WasmKeeper = wasmpluskeeper.NewKeeper(
 		appCodec,
 		keys[wasmplustypes.StoreKey],
@@ -531,6 +543,7 @@ func NewWasmApp(
 		wasmDir,
 		wasmConfig,
 		availableCapabilities,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		wasmOpts...,
 	)
 
@@ -27,6 +27,7 @@ import (
 	"github.com/Finschia/finschia-sdk/version"
 	authcmd "github.com/Finschia/finschia-sdk/x/auth/client/cli"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/authz"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 	"github.com/Finschia/finschia-sdk/x/crisis"
 	genutilcli "github.com/Finschia/finschia-sdk/x/genutil/client/cli"
@@ -36,6 +37,7 @@ import (
 	"github.com/Finschia/wasmd/app/params"
 	"github.com/Finschia/wasmd/appplus"
 	"github.com/Finschia/wasmd/x/wasm"
+	wasmcli "github.com/Finschia/wasmd/x/wasm/client/cli"
 	wasmkeeper "github.com/Finschia/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
 )
@@ -173,6 +175,11 @@ func txCommand() *cobra.Command {
 	)
 
 	appplus.ModuleBasics.AddTxCommands(cmd)
+
+	// extend `tx authz grant` with the wasm contract authorizations
+	if grantCmd, _, err := cmd.Find([]string{authz.ModuleName, "grant"}); err == nil && grantCmd.Name() == "grant" {
+		wasmcli.ExtendGrantAuthorizationCmd(grantCmd)
+	}
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -18,6 +20,8 @@ import (
 	sdk "github.com/Finschia/finschia-sdk/types"
 	wasmvm "github.com/Finschia/wasmvm"
 
+	cliOs "github.com/Finschia/wasmd/x/wasm/client/cli/os"
+	"github.com/Finschia/wasmd/x/wasm/ioutils"
 	"github.com/Finschia/wasmd/x/wasm/keeper"
 	"github.com/Finschia/wasmd/x/wasm/types"
 )
@@ -33,15 +37,22 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdListCode(),
 		GetCmdListContractByCode(),
+		GetCmdListContractsByCreator(),
 		GetCmdQueryCode(),
 		GetCmdQueryCodeInfo(),
+		GetCmdQueryCodeInfoByChecksum(),
+		GetCmdVerifyCode(),
 		GetCmdGetContractInfo(),
 		GetCmdGetContractHistory(),
 		GetCmdGetContractState(),
+		GetCmdGetContractStorageUsage(),
 		GetCmdListPinnedCode(),
 		GetCmdLibVersion(),
 		GetCmdQueryParams(),
+		GetCmdQueryStargateQueryAcceptList(),
+		GetCmdQueryStargateMsgFilter(),
 		GetCmdBuildAddress(),
+		GetCmdSimulateExecute(),
 	)
 	return queryCmd
 }
@@ -188,6 +199,48 @@ func GetCmdListContractByCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListContractsByCreator lists all contracts instantiated by the given creator
+func GetCmdListContractsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-contracts-by-creator [creator]",
+		Short:   "List all contracts by creator",
+		Long:    "List all contracts instantiated by the given creator address in the order of creation",
+		Aliases: []string{"lcbc"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByCreator(
+				context.Background(),
+				&types.QueryContractsByCreatorRequest{
+					CreatorAddress: args[0],
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by creator")
+	return cmd
+}
+
 // GetCmdQueryCode returns the bytecode for a given contract
 func GetCmdQueryCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -269,6 +322,96 @@ func GetCmdQueryCodeInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryCodeInfoByChecksum returns the code infos for all code ids with the given checksum
+func GetCmdQueryCodeInfoByChecksum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-info-by-checksum [checksum]",
+		Short: "Prints out metadata of all code ids with the given checksum",
+		Long:  "Prints out metadata of all code ids with the given checksum. The checksum is the hex encoded sha256 hash of the wasm byte code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("checksum: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeInfoByChecksum(
+				context.Background(),
+				&types.QueryCodeInfoByChecksumRequest{
+					Checksum: hex.EncodeToString(checksum),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdVerifyCode compares the checksum of a local wasm file with the checksum of the code stored on chain
+func GetCmdVerifyCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-code [code_id] [wasm file]",
+		Short: "Verifies that a local wasm build matches the code stored on chain",
+		Long:  "Verifies that the sha256 checksum of a local wasm build (raw or gzip compressed) matches the checksum of the code stored on chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			wasm, err := cliOs.ReadFileWithSizeLimit(args[1], int64(types.MaxWasmSize))
+			if err != nil {
+				return err
+			}
+			if ioutils.IsGzip(wasm) {
+				if wasm, err = ioutils.Uncompress(wasm, uint64(types.MaxWasmSize)); err != nil {
+					return err
+				}
+			}
+			if !ioutils.IsWasm(wasm) {
+				return fmt.Errorf("invalid input file. Use wasm binary or gzip")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Code(
+				context.Background(),
+				&types.QueryCodeRequest{
+					CodeId: codeID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			if res.CodeInfoResponse == nil {
+				return fmt.Errorf("code not found")
+			}
+
+			checksum := sha256.Sum256(wasm)
+			if !bytes.Equal(checksum[:], res.DataHash) {
+				return fmt.Errorf("checksum mismatch: local %X, on chain %X", checksum, res.DataHash)
+			}
+			return clientCtx.PrintString(fmt.Sprintf("code id %d matches checksum %X\n", codeID, checksum))
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractInfo gets details about a given contract
 func GetCmdGetContractInfo() *cobra.Command {
 	cmd := &cobra.Command{
@@ -304,6 +447,41 @@ func GetCmdGetContractInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetContractStorageUsage prints the amount of state held by a contract
+func GetCmdGetContractStorageUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-storage-usage [bech32_address]",
+		Short:   "Prints out the number of keys and bytes of the state held by a contract given its address",
+		Long:    "Prints out the number of keys and bytes of the state held by a contract given its address",
+		Aliases: []string{"storage-usage"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStorageUsage(
+				context.Background(),
+				&types.QueryContractStorageUsageRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractState dumps full internal state of a given contract
 func GetCmdGetContractState() *cobra.Command {
 	cmd := &cobra.Command{
@@ -317,17 +495,21 @@ func GetCmdGetContractState() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdGetContractStateAll(),
 		GetCmdGetContractStateRaw(),
+		GetCmdGetContractStateRawBatch(),
 		GetCmdGetContractStateSmart(),
+		GetCmdGetContractStateSmartBatch(),
 	)
 	return cmd
 }
 
 func GetCmdGetContractStateAll() *cobra.Command {
+	decoder := newArgDecoder(hex.DecodeString)
 	cmd := &cobra.Command{
 		Use:   "all [bech32_address]",
 		Short: "Prints out all internal state of a contract given its address",
-		Long:  "Prints out all internal state of a contract given its address",
-		Args:  cobra.ExactArgs(1),
+		Long: `Prints out all internal state of a contract given its address.
+The result can be limited to the keys with a prefix and to a key range [start-key, end-key).`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -343,12 +525,29 @@ func GetCmdGetContractStateAll() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			var keys [3][]byte
+			for i, flagName := range []string{flagPrefix, flagStartKey, flagEndKey} {
+				value, err := cmd.Flags().GetString(flagName)
+				if err != nil {
+					return err
+				}
+				if value == "" {
+					continue
+				}
+				if keys[i], err = decoder.DecodeString(value); err != nil {
+					return fmt.Errorf("%s: %s", flagName, err)
+				}
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.AllContractState(
 				context.Background(),
 				&types.QueryAllContractStateRequest{
 					Address:    args[0],
 					Pagination: pageReq,
+					Prefix:     keys[0],
+					StartKey:   keys[1],
+					EndKey:     keys[2],
+					Reverse:    pageReq.Reverse,
 				},
 			)
 			if err != nil {
@@ -357,6 +556,10 @@ func GetCmdGetContractStateAll() *cobra.Command {
 			return clientCtx.PrintProto(res)
 		},
 	}
+	cmd.Flags().String(flagPrefix, "", "Prefix of the keys")
+	cmd.Flags().String(flagStartKey, "", "First key of the range, inclusive")
+	cmd.Flags().String(flagEndKey, "", "End of the range, exclusive")
+	decoder.RegisterFlags(cmd.PersistentFlags(), "key flags")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "contract state")
 	return cmd
@@ -403,6 +606,49 @@ func GetCmdGetContractStateRaw() *cobra.Command {
 	return cmd
 }
 
+func GetCmdGetContractStateRawBatch() *cobra.Command {
+	decoder := newArgDecoder(hex.DecodeString)
+	cmd := &cobra.Command{
+		Use:   "raw-batch [bech32_address] [key]...",
+		Short: "Prints out internal state for multiple keys of a contract given its address",
+		Long:  "Prints out internal state for multiple keys of a contract given its address. The values are read from the same block.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			keys := make([][]byte, len(args)-1)
+			for i, arg := range args[1:] {
+				if keys[i], err = decoder.DecodeString(arg); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RawContractStates(
+				context.Background(),
+				&types.QueryRawContractStatesRequest{
+					Address: args[0],
+					Keys:    keys,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	decoder.RegisterFlags(cmd.PersistentFlags(), "key arguments")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdGetContractStateSmart() *cobra.Command {
 	decoder := newArgDecoder(asciiDecodeString)
 	cmd := &cobra.Command{
@@ -451,6 +697,135 @@ func GetCmdGetContractStateSmart() *cobra.Command {
 	return cmd
 }
 
+// batchSmartQuery is an entry of the json file of GetCmdGetContractStateSmartBatch
+type batchSmartQuery struct {
+	Address string          `json:"address"`
+	Query   json.RawMessage `json:"query"`
+}
+
+// GetCmdGetContractStateSmartBatch calls multiple contracts with the queries of a json file and prints the results
+func GetCmdGetContractStateSmartBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "smart-batch [json_file]",
+		Short: "Calls contracts with the queries of a json file and prints the returned results",
+		Long: `Calls contracts with the queries of a json file and prints the returned results.
+All queries are executed on the same block and share the gas limit of a single smart query.
+The file contains a list of queries, e.g.
+[{"address": "link1...", "query": {"verifier": {}}}, {"address": "link1...", "query": {"balance": {}}}]`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var entries []batchSmartQuery
+			if err := json.Unmarshal(bz, &entries); err != nil {
+				return fmt.Errorf("parse queries: %s", err)
+			}
+			if len(entries) == 0 {
+				return errors.New("queries must not be empty")
+			}
+			queries := make([]types.QuerySmartContractStateRequest, len(entries))
+			for i, e := range entries {
+				if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+					return fmt.Errorf("query %d: %s", i, err)
+				}
+				if len(e.Query) == 0 {
+					return fmt.Errorf("query %d: query data must not be empty", i)
+				}
+				queries[i] = types.QuerySmartContractStateRequest{
+					Address:   e.Address,
+					QueryData: types.RawContractMessage(e.Query),
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BatchSmartContractState(
+				context.Background(),
+				&types.QueryBatchSmartContractStateRequest{Queries: queries},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSimulateExecute executes a contract without committing the state changes and prints the result
+func GetCmdSimulateExecute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-execute [bech32_address] [json_encoded_send_args] --sender [bech32_address] --amount [coins,optional] --trace [bool,optional]",
+		Short:   "Simulates the execution of a contract and prints the returned data, events, gas used and sub messages",
+		Long:    "Simulates the execution of a contract at the latest height and prints the returned data, events, gas used and sub messages. No state changes are committed.",
+		Aliases: []string{"simulate"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			execMsg := []byte(args[1])
+			if !json.Valid(execMsg) {
+				return errors.New("msg must be json")
+			}
+
+			sender, err := cmd.Flags().GetString(flagSender)
+			if err != nil {
+				return fmt.Errorf("sender: %s", err)
+			}
+			if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+				return fmt.Errorf("sender: %s", err)
+			}
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return fmt.Errorf("amount: %s", err)
+			}
+			amount, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return fmt.Errorf("amount: %s", err)
+			}
+			trace, err := cmd.Flags().GetBool(flagTrace)
+			if err != nil {
+				return fmt.Errorf("trace: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateExecuteContract(
+				context.Background(),
+				&types.QuerySimulateExecuteContractRequest{
+					Address: args[0],
+					Sender:  sender,
+					Msg:     execMsg,
+					Funds:   amount,
+					Trace:   trace,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagSender, "", "The address of the simulated caller")
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	cmd.Flags().Bool(flagTrace, false, "Record the call tree of the execution and print the unredacted error of a failed execution")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractHistory prints the code history for a given contract
 func GetCmdGetContractHistory() *cobra.Command {
 	cmd := &cobra.Command{
@@ -618,3 +993,60 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryStargateQueryAcceptList lists the stargate queries that contracts are allowed to execute
+func GetCmdQueryStargateQueryAcceptList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stargate-query-accept-list",
+		Short:   "Query the stargate queries that contracts are allowed to execute",
+		Long:    "Query the stargate queries that contracts are allowed to execute. The list is part of the wasm params and is updated by governance.",
+		Aliases: []string{"stargate-queries"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StargateQueryAcceptList(cmd.Context(), &types.QueryStargateQueryAcceptListRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStargateMsgFilter shows the filter for the stargate messages dispatched by contracts
+func GetCmdQueryStargateMsgFilter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stargate-msg-filter",
+		Short: "Query the filter for the stargate messages dispatched by contracts",
+		Long:  "Query the filter for the stargate messages dispatched by contracts. The filter is merged from the filter of the app wiring and the filter set in the wasm params.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StargateMsgFilter(cmd.Context(), &types.QueryStargateMsgFilterRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
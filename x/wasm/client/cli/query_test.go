@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"net/url"
 	"os"
 	"strconv"
@@ -249,6 +250,8 @@ func TestGetCmdGetContractStateAll(t *testing.T) {
 		{"invalid request", invalidRequestError, ctx, invalidRequestFlags, argsWithAddr},
 		{"invalid url", invalidControlChar, context.Background(), invalidNodeFlags, argsWithAddr},
 		{"invalid address", invalidAddrError, ctx, nil, []string{""}},
+		{"with key range", nil, makeContext(bz), []string{"--prefix=0003", "--start-key=000366", "--end-key=000367", "--reverse"}, argsWithAddr},
+		{"invalid prefix", errors.New("prefix: " + hex.ErrLength.Error()), ctx, []string{"--prefix=a"}, argsWithAddr},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -295,6 +298,35 @@ func TestGetCmdGetContractStateRaw(t *testing.T) {
 	}
 }
 
+func TestGetCmdGetContractStateRawBatch(t *testing.T) {
+	res := types.QueryRawContractStatesResponse{}
+	bz, err := res.Marshal()
+	require.NoError(t, err)
+	ctx := makeContext(bz)
+	args := []string{accAddress, queryJsonHex, queryJsonHex}
+	tests := testcase{
+		{"execute success", nil, ctx, nil, args},
+		{"bad status", badStatusError, ctx, nil, args},
+		{"invalid url", invalidControlChar, context.Background(), invalidNodeFlags, args},
+		{"invalid address", invalidAddrError, ctx, nil, []string{"", "a"}},
+		{"invalid key", hex.ErrLength, ctx, nil, []string{accAddress, queryJsonHex, "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := GetCmdGetContractStateRawBatch()
+			err := cmd.ParseFlags(tt.flags)
+			require.NoError(t, err)
+			cmd.SetContext(tt.ctx)
+			actual := cmd.RunE(cmd, tt.args)
+			if tt.want == nil {
+				assert.Nilf(t, actual, "GetCmdGetContractStateRawBatch()")
+			} else {
+				assert.Equalf(t, tt.want.Error(), actual.Error(), "GetCmdGetContractStateRawBatch()")
+			}
+		})
+	}
+}
+
 func TestGetCmdGetContractStateSmart(t *testing.T) {
 	res := types.QueryRawContractStateResponse{}
 	bz, err := res.Marshal()
@@ -324,6 +356,46 @@ func TestGetCmdGetContractStateSmart(t *testing.T) {
 	}
 }
 
+func TestGetCmdGetContractStateSmartBatch(t *testing.T) {
+	res := types.QueryBatchSmartContractStateResponse{}
+	bz, err := res.Marshal()
+	require.NoError(t, err)
+	ctx := makeContext(bz)
+	writeFile := func(content string) string {
+		f, err := os.CreateTemp(t.TempDir(), "queries")
+		require.NoError(t, err)
+		_, err = f.WriteString(content)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		return f.Name()
+	}
+	args := []string{writeFile(fmt.Sprintf(`[{"address":%q,"query":%s},{"address":%q,"query":%s}]`, accAddress, queryJson, accAddress, queryJson))}
+	tests := testcase{
+		{"execute success", nil, ctx, nil, args},
+		{"bad status", badStatusError, ctx, nil, args},
+		{"invalid url", invalidControlChar, context.Background(), invalidNodeFlags, args},
+		{"not existing file", errors.New("open not existing: no such file or directory"), ctx, nil, []string{"not existing"}},
+		{"invalid json", errors.New("parse queries: invalid character 'a' looking for beginning of value"), ctx, nil, []string{writeFile("a")}},
+		{"empty queries", errors.New("queries must not be empty"), ctx, nil, []string{writeFile("[]")}},
+		{"invalid address", errors.New("query 0: " + invalidAddrError.Error()), ctx, nil, []string{writeFile(`[{"address":"","query":{}}]`)}},
+		{"empty query", errors.New("query 0: query data must not be empty"), ctx, nil, []string{writeFile(fmt.Sprintf(`[{"address":%q}]`, accAddress))}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := GetCmdGetContractStateSmartBatch()
+			err := cmd.ParseFlags(tt.flags)
+			require.NoError(t, err)
+			cmd.SetContext(tt.ctx)
+			actual := cmd.RunE(cmd, tt.args)
+			if tt.want == nil {
+				assert.Nilf(t, actual, "GetCmdGetContractStateSmartBatch()")
+			} else {
+				assert.Equalf(t, tt.want.Error(), actual.Error(), "GetCmdGetContractStateSmartBatch()")
+			}
+		})
+	}
+}
+
 func TestGetCmdGetContractHistory(t *testing.T) {
 	res := types.QueryContractHistoryResponse{}
 	bz, err := res.Marshal()
@@ -379,6 +451,58 @@ func TestGetCmdListPinnedCode(t *testing.T) {
 	}
 }
 
+func TestGetCmdQueryStargateQueryAcceptList(t *testing.T) {
+	res := types.QueryStargateQueryAcceptListResponse{}
+	bz, err := res.Marshal()
+	require.NoError(t, err)
+	ctx := makeContext(bz)
+	tests := testcase{
+		{"execute success", nil, ctx, nil, nil},
+		{"bad status", badStatusError, ctx, nil, nil},
+		{"invalid url", invalidControlChar, context.Background(), invalidNodeFlags, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := GetCmdQueryStargateQueryAcceptList()
+			err := cmd.ParseFlags(tt.flags)
+			require.NoError(t, err)
+			cmd.SetContext(tt.ctx)
+			actual := cmd.RunE(cmd, tt.args)
+			if tt.want == nil {
+				assert.Nilf(t, actual, "GetCmdQueryStargateQueryAcceptList()")
+			} else {
+				assert.Equalf(t, tt.want.Error(), actual.Error(), "GetCmdQueryStargateQueryAcceptList()")
+			}
+		})
+	}
+}
+
+func TestGetCmdQueryStargateMsgFilter(t *testing.T) {
+	res := types.QueryStargateMsgFilterResponse{}
+	bz, err := res.Marshal()
+	require.NoError(t, err)
+	ctx := makeContext(bz)
+	tests := testcase{
+		{"execute success", nil, ctx, nil, nil},
+		{"bad status", badStatusError, ctx, nil, nil},
+		{"invalid url", invalidControlChar, context.Background(), invalidNodeFlags, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := GetCmdQueryStargateMsgFilter()
+			err := cmd.ParseFlags(tt.flags)
+			require.NoError(t, err)
+			cmd.SetContext(tt.ctx)
+			actual := cmd.RunE(cmd, tt.args)
+			if tt.want == nil {
+				assert.Nilf(t, actual, "GetCmdQueryStargateMsgFilter()")
+			} else {
+				assert.Equalf(t, tt.want.Error(), actual.Error(), "GetCmdQueryStargateMsgFilter()")
+			}
+		})
+	}
+}
+
 func makeContext(bz []byte) context.Context {
 	result := ocrpctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}
 	mockClient := ocrpcmocks.RemoteClient{}
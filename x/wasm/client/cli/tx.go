@@ -1,10 +1,14 @@
 package cli
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -15,6 +19,8 @@ import (
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/version"
+	"github.com/Finschia/finschia-sdk/x/authz"
+	authzcli "github.com/Finschia/finschia-sdk/x/authz/client/cli"
 
 	"github.com/Finschia/wasmd/x/wasm/client/cli/os"
 	"github.com/Finschia/wasmd/x/wasm/ioutils"
@@ -33,6 +39,24 @@ const (
 	flagInstantiateByAddress      = "instantiate-only-address"
 	flagInstantiateByAnyOfAddress = "instantiate-anyof-addresses"
 	flagUnpinCode                 = "unpin-code"
+	flagSource                    = "code-source-url"
+	flagBuilder                   = "builder"
+	flagCodeHash                  = "code-hash"
+	flagContract                  = "contract"
+	flagMaxCalls                  = "max-calls"
+	flagMaxFunds                  = "max-funds"
+	flagAllowAllMsgs              = "allow-all-messages"
+	flagAllowedMsgKeys            = "allow-msg-keys"
+	flagAllowedRawMsgs            = "allow-raw-msgs"
+	flagSender                    = "sender"
+	flagTrace                     = "trace"
+	flagMaxContractBytes          = "max-contract-bytes"
+	flagPrefix                    = "prefix"
+	flagStartKey                  = "start-key"
+	flagEndKey                    = "end-key"
+
+	authzTypeContractExecution = "contract-execution"
+	authzTypeContractMigration = "contract-migration"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -52,6 +76,8 @@ func GetTxCmd() *cobra.Command {
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		UpdateInstantiateConfigCmd(),
+		RemoveCodeCmd(),
 	)
 	return txCmd
 }
@@ -83,28 +109,30 @@ func StoreCodeCmd() *cobra.Command {
 	cmd.Flags().String(flagInstantiateNobody, "", "Nobody except the governance process can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Deprecated: Only this address can instantiate a contract from the code, optional")
 	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
+	addVerificationFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// addVerificationFlags registers the optional code verification flags
+func addVerificationFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagSource, "", "Code Source URL is a valid absolute HTTPS URI to the contract's source code, optional")
+	cmd.Flags().String(flagBuilder, "", "Builder is a valid docker image name with tag, optional")
+	cmd.Flags().BytesHex(flagCodeHash, nil, "CodeHash is the sha256 hash of the wasm code, optional")
+}
+
 func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet) (types.MsgStoreCode, error) {
-	wasm, err := os.ReadFileWithSizeLimit(file, int64(types.MaxWasmSize))
+	wasm, err := readWasmFile(file)
 	if err != nil {
 		return types.MsgStoreCode{}, err
 	}
 
-	// gzip the wasm file
-	if ioutils.IsWasm(wasm) {
-		wasm, err = ioutils.GzipIt(wasm)
-
-		if err != nil {
-			return types.MsgStoreCode{}, err
-		}
-	} else if !ioutils.IsGzip(wasm) {
-		return types.MsgStoreCode{}, fmt.Errorf("invalid input file. Use wasm binary or gzip")
+	perm, err := parseAccessConfigFlags(flags)
+	if err != nil {
+		return types.MsgStoreCode{}, err
 	}
 
-	perm, err := parseAccessConfigFlags(flags)
+	source, builder, codeHash, err := parseVerificationFlags(wasm, flags)
 	if err != nil {
 		return types.MsgStoreCode{}, err
 	}
@@ -113,10 +141,63 @@ func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet)
 		Sender:                sender.String(),
 		WASMByteCode:          wasm,
 		InstantiatePermission: perm,
+		Source:                source,
+		Builder:               builder,
+		CodeHash:              codeHash,
 	}
 	return msg, nil
 }
 
+// readWasmFile reads a wasm binary or gzip file and returns the gzipped wasm code
+func readWasmFile(file string) ([]byte, error) {
+	wasm, err := os.ReadFileWithSizeLimit(file, int64(types.MaxWasmSize))
+	if err != nil {
+		return nil, err
+	}
+
+	// gzip the wasm file
+	if ioutils.IsWasm(wasm) {
+		wasm, err = ioutils.GzipIt(wasm)
+
+		if err != nil {
+			return nil, err
+		}
+	} else if !ioutils.IsGzip(wasm) {
+		return nil, fmt.Errorf("invalid input file. Use wasm binary or gzip")
+	}
+	return wasm, nil
+}
+
+// parseVerificationFlags reads the optional code verification flags. The code hash is compared with the
+// checksum of the given gzipped wasm code.
+func parseVerificationFlags(gzippedWasm []byte, flags *flag.FlagSet) (string, string, []byte, error) {
+	source, err := flags.GetString(flagSource)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("source: %s", err)
+	}
+	builder, err := flags.GetString(flagBuilder)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("builder: %s", err)
+	}
+	codeHash, err := flags.GetBytesHex(flagCodeHash)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("code hash: %s", err)
+	}
+	if err := types.ValidateVerificationInfo(source, builder, codeHash); err != nil {
+		return "", "", nil, err
+	}
+	if len(codeHash) != 0 {
+		wasm, err := ioutils.Uncompress(gzippedWasm, uint64(types.MaxWasmSize))
+		if err != nil {
+			return "", "", nil, err
+		}
+		if checksum := sha256.Sum256(wasm); !bytes.Equal(checksum[:], codeHash) {
+			return "", "", nil, fmt.Errorf("code hash mismatch: %X, checksum: %X", codeHash, checksum)
+		}
+	}
+	return source, builder, codeHash, nil
+}
+
 func parseAccessConfigFlags(flags *flag.FlagSet) (*types.AccessConfig, error) {
 	addrs, err := flags.GetStringSlice(flagInstantiateByAnyOfAddress)
 	if err != nil {
@@ -282,48 +363,57 @@ func parseInstantiateArgs(rawCodeID, initMsg string, sender sdk.AccAddress, flag
 		return nil, err
 	}
 
+	label, adminStr, amount, err := parseInstantiateFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+
+	// build and sign the transaction, then broadcast to Ostracon
+	msg := types.MsgInstantiateContract{
+		Sender: sender.String(),
+		CodeID: codeID,
+		Label:  label,
+		Funds:  amount,
+		Msg:    []byte(initMsg),
+		Admin:  adminStr,
+	}
+	return &msg, nil
+}
+
+// parseInstantiateFlags reads the label, the admin and the funds of a new contract instance
+func parseInstantiateFlags(flags *flag.FlagSet) (string, string, sdk.Coins, error) {
 	amountStr, err := flags.GetString(flagAmount)
 	if err != nil {
-		return nil, fmt.Errorf("amount: %s", err)
+		return "", "", nil, fmt.Errorf("amount: %s", err)
 	}
 	amount, err := sdk.ParseCoinsNormalized(amountStr)
 	if err != nil {
-		return nil, fmt.Errorf("amount: %s", err)
+		return "", "", nil, fmt.Errorf("amount: %s", err)
 	}
 	label, err := flags.GetString(flagLabel)
 	if err != nil {
-		return nil, fmt.Errorf("label: %s", err)
+		return "", "", nil, fmt.Errorf("label: %s", err)
 	}
 	if label == "" {
-		return nil, errors.New("label is required on all contracts")
+		return "", "", nil, errors.New("label is required on all contracts")
 	}
 	adminStr, err := flags.GetString(flagAdmin)
 	if err != nil {
-		return nil, fmt.Errorf("admin: %s", err)
+		return "", "", nil, fmt.Errorf("admin: %s", err)
 	}
 	noAdmin, err := flags.GetBool(flagNoAdmin)
 	if err != nil {
-		return nil, fmt.Errorf("no-admin: %s", err)
+		return "", "", nil, fmt.Errorf("no-admin: %s", err)
 	}
 
 	// ensure sensible admin is set (or explicitly immutable)
 	if adminStr == "" && !noAdmin {
-		return nil, fmt.Errorf("you must set an admin or explicitly pass --no-admin to make it immutible (wasmd issue #719)")
+		return "", "", nil, fmt.Errorf("you must set an admin or explicitly pass --no-admin to make it immutible (wasmd issue #719)")
 	}
 	if adminStr != "" && noAdmin {
-		return nil, fmt.Errorf("you set an admin and passed --no-admin, those cannot both be true")
+		return "", "", nil, fmt.Errorf("you set an admin and passed --no-admin, those cannot both be true")
 	}
-
-	// build and sign the transaction, then broadcast to Ostracon
-	msg := types.MsgInstantiateContract{
-		Sender: sender.String(),
-		CodeID: codeID,
-		Label:  label,
-		Funds:  amount,
-		Msg:    []byte(initMsg),
-		Admin:  adminStr,
-	}
-	return &msg, nil
+	return label, adminStr, amount, nil
 }
 
 // ExecuteContractCmd will instantiate a contract from previously uploaded code.
@@ -373,3 +463,172 @@ func parseExecuteArgs(contractAddr string, execMsg string, sender sdk.AccAddress
 		Msg:      []byte(execMsg),
 	}, nil
 }
+
+// ExtendGrantAuthorizationCmd adds the wasm contract authorization types to the `tx authz grant` command.
+// Any other authorization type is passed on to the original command.
+func ExtendGrantAuthorizationCmd(cmd *cobra.Command) {
+	sdkRunE := cmd.RunE
+	cmd.Use = strings.Replace(cmd.Use, "\"redelegate\"", fmt.Sprintf("\"redelegate\"|%q|%q", authzTypeContractExecution, authzTypeContractMigration), 1)
+	cmd.Long = strings.TrimSpace(cmd.Long + fmt.Sprintf(`
+ $ %s tx authz grant link1skjw.. %s --contract=link1sdf.. --max-calls=1 --allow-all-messages --from=link1skl..
+ $ %s tx authz grant link1skjw.. %s --contract=link1sdf.. --max-calls=1 --allow-msg-keys=transfer,burn --from=link1skl..
+`, version.AppName, authzTypeContractExecution, version.AppName, authzTypeContractMigration))
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		switch args[1] {
+		case authzTypeContractExecution, authzTypeContractMigration:
+		default:
+			return sdkRunE(cmd, args)
+		}
+
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(args[0])
+		if err != nil {
+			return err
+		}
+
+		exp, err := cmd.Flags().GetInt64(authzcli.FlagExpiration)
+		if err != nil {
+			return err
+		}
+
+		if err := checkContractGrantFlags(args[1], cmd.Flags()); err != nil {
+			return err
+		}
+		grant, err := parseContractGrantFlags(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		var authorization authz.Authorization
+		switch args[1] {
+		case authzTypeContractExecution:
+			authorization = types.NewContractExecutionAuthorization(*grant)
+		default:
+			authorization = types.NewContractMigrationAuthorization(*grant)
+		}
+		if err := authorization.ValidateBasic(); err != nil {
+			return err
+		}
+
+		msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0))
+		if err != nil {
+			return err
+		}
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	}
+
+	cmd.Flags().String(flagContract, "", "The contract address for contract authorizations")
+	cmd.Flags().Uint64(flagMaxCalls, 0, "Maximal number of calls to the contract for contract authorizations")
+	cmd.Flags().String(flagMaxFunds, "", "Maximal amount of tokens transferable to the contract for contract execution authorizations")
+	cmd.Flags().Bool(flagAllowAllMsgs, false, "Allow all messages for contract authorizations")
+	cmd.Flags().StringSlice(flagAllowedMsgKeys, []string{}, "Allowed msg keys for contract authorizations")
+	cmd.Flags().StringArray(flagAllowedRawMsgs, []string{}, "Allowed raw msg for contract authorizations, can be given multiple times")
+}
+
+// checkContractGrantFlags rejects the flags that are not supported by the authorization type. A migration does not
+// transfer funds, so that a max funds limit would never be consumed and grant unlimited migrations.
+func checkContractGrantFlags(authzType string, flags *flag.FlagSet) error {
+	if authzType != authzTypeContractMigration {
+		return nil
+	}
+	maxFunds, err := flags.GetString(flagMaxFunds)
+	if err != nil {
+		return fmt.Errorf("max funds: %s", err)
+	}
+	if maxFunds != "" {
+		return fmt.Errorf("%s flag is not supported for %s, use %s", flagMaxFunds, authzTypeContractMigration, flagMaxCalls)
+	}
+	return nil
+}
+
+func parseContractGrantFlags(flags *flag.FlagSet) (*types.ContractGrant, error) {
+	contractAddr, err := flags.GetString(flagContract)
+	if err != nil {
+		return nil, fmt.Errorf("contract: %s", err)
+	}
+	contract, err := sdk.AccAddressFromBech32(contractAddr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, flagContract)
+	}
+
+	limit, err := parseContractAuthzLimitFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := parseContractAuthzFilterFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewContractGrant(contract, limit, filter)
+}
+
+func parseContractAuthzLimitFlags(flags *flag.FlagSet) (types.ContractAuthzLimitX, error) {
+	maxCalls, err := flags.GetUint64(flagMaxCalls)
+	if err != nil {
+		return nil, fmt.Errorf("max calls: %s", err)
+	}
+	maxFundsStr, err := flags.GetString(flagMaxFunds)
+	if err != nil {
+		return nil, fmt.Errorf("max funds: %s", err)
+	}
+
+	var maxFunds sdk.Coins
+	if maxFundsStr != "" {
+		if maxFunds, err = sdk.ParseCoinsNormalized(maxFundsStr); err != nil {
+			return nil, sdkerrors.Wrap(err, flagMaxFunds)
+		}
+	}
+
+	switch {
+	case maxCalls != 0 && !maxFunds.Empty():
+		return types.NewCombinedLimit(maxCalls, maxFunds...), nil
+	case maxCalls != 0:
+		return types.NewMaxCallsLimit(maxCalls), nil
+	case !maxFunds.Empty():
+		return types.NewMaxFundsLimit(maxFunds...), nil
+	default:
+		return nil, fmt.Errorf("%s or %s flag is required", flagMaxCalls, flagMaxFunds)
+	}
+}
+
+func parseContractAuthzFilterFlags(flags *flag.FlagSet) (types.ContractAuthzFilterX, error) {
+	allowAllMsgs, err := flags.GetBool(flagAllowAllMsgs)
+	if err != nil {
+		return nil, fmt.Errorf("allow all messages: %s", err)
+	}
+	msgKeys, err := flags.GetStringSlice(flagAllowedMsgKeys)
+	if err != nil {
+		return nil, fmt.Errorf("allowed msg keys: %s", err)
+	}
+	rawMsgs, err := flags.GetStringArray(flagAllowedRawMsgs)
+	if err != nil {
+		return nil, fmt.Errorf("allowed raw msgs: %s", err)
+	}
+
+	count := 0
+	for _, set := range []bool{allowAllMsgs, len(msgKeys) != 0, len(rawMsgs) != 0} {
+		if set {
+			count++
+		}
+	}
+	if count != 1 {
+		return nil, fmt.Errorf("exactly one of %s, %s or %s flags is required", flagAllowAllMsgs, flagAllowedMsgKeys, flagAllowedRawMsgs)
+	}
+
+	switch {
+	case allowAllMsgs:
+		return types.NewAllowAllMessagesFilter(), nil
+	case len(msgKeys) != 0:
+		return types.NewAcceptedMessageKeysFilter(msgKeys...), nil
+	default:
+		msgs := make([]types.RawContractMessage, len(rawMsgs))
+		for i, msg := range rawMsgs {
+			msgs[i] = types.RawContractMessage(msg)
+		}
+		return types.NewAcceptedMessagesFilter(msgs...), nil
+	}
+}
@@ -1,11 +1,18 @@
 package cli
 
 import (
+	"crypto/sha256"
+	"os"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authzcli "github.com/Finschia/finschia-sdk/x/authz/client/cli"
+
+	"github.com/Finschia/wasmd/x/wasm/ioutils"
 	"github.com/Finschia/wasmd/x/wasm/types"
 )
 
@@ -57,3 +64,174 @@ func TestParseAccessConfigFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestParseVerificationFlags(t *testing.T) {
+	wasmCode, err := os.ReadFile("../../keeper/testdata/hackatom.wasm")
+	require.NoError(t, err)
+	gzippedWasm, err := ioutils.GzipIt(wasmCode)
+	require.NoError(t, err)
+
+	const (
+		source   = "--code-source-url=https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom"
+		builder  = "--builder=cosmwasm/rust-optimizer:0.12.6"
+		codeHash = "--code-hash=470c5b703a682f778b8b088d48169b8d6e43f7f44ac70316692cdbe69e6605e3"
+	)
+	specs := map[string]struct {
+		args       []string
+		expSource  string
+		expBuilder string
+		expErr     bool
+	}{
+		"all set": {
+			args:       []string{source, builder, codeHash},
+			expSource:  "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom",
+			expBuilder: "cosmwasm/rust-optimizer:0.12.6",
+		},
+		"none set": {
+			args: []string{},
+		},
+		"source missing": {
+			args:   []string{builder, codeHash},
+			expErr: true,
+		},
+		"builder missing": {
+			args:   []string{source, codeHash},
+			expErr: true,
+		},
+		"code hash missing": {
+			args:   []string{source, builder},
+			expErr: true,
+		},
+		"code hash mismatch": {
+			args:   []string{source, builder, "--code-hash=0101010101010101010101010101010101010101010101010101010101010101"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := StoreCodeCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			gotSource, gotBuilder, gotCodeHash, gotErr := parseVerificationFlags(gzippedWasm, flags)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expSource, gotSource)
+			assert.Equal(t, spec.expBuilder, gotBuilder)
+			if spec.expSource != "" {
+				checksum := sha256.Sum256(wasmCode)
+				assert.Equal(t, checksum[:], gotCodeHash)
+			}
+		})
+	}
+}
+
+func TestParseContractGrantFlags(t *testing.T) {
+	const contractAddr = "link12kr02kew9fl73rqekalavuu0xaxcgwr6nkk395"
+	contract := sdk.MustAccAddressFromBech32(contractAddr)
+	oneToken := sdk.NewCoin("stake", sdk.OneInt())
+
+	specs := map[string]struct {
+		args      []string
+		expLimit  types.ContractAuthzLimitX
+		expFilter types.ContractAuthzFilterX
+		expErr    bool
+	}{
+		"max calls with allow all": {
+			args:      []string{"--contract=" + contractAddr, "--max-calls=1", "--allow-all-messages"},
+			expLimit:  types.NewMaxCallsLimit(1),
+			expFilter: types.NewAllowAllMessagesFilter(),
+		},
+		"max funds with msg keys": {
+			args:      []string{"--contract=" + contractAddr, "--max-funds=1stake", "--allow-msg-keys=foo,bar"},
+			expLimit:  types.NewMaxFundsLimit(oneToken),
+			expFilter: types.NewAcceptedMessageKeysFilter("foo", "bar"),
+		},
+		"combined limit with raw msgs": {
+			args:      []string{"--contract=" + contractAddr, "--max-calls=2", "--max-funds=1stake", `--allow-raw-msgs={"foo":{}}`},
+			expLimit:  types.NewCombinedLimit(2, oneToken),
+			expFilter: types.NewAcceptedMessagesFilter([]byte(`{"foo":{}}`)),
+		},
+		"invalid contract": {
+			args:   []string{"--contract=foo", "--max-calls=1", "--allow-all-messages"},
+			expErr: true,
+		},
+		"no limit": {
+			args:   []string{"--contract=" + contractAddr, "--allow-all-messages"},
+			expErr: true,
+		},
+		"invalid max funds": {
+			args:   []string{"--contract=" + contractAddr, "--max-funds=foo", "--allow-all-messages"},
+			expErr: true,
+		},
+		"no filter": {
+			args:   []string{"--contract=" + contractAddr, "--max-calls=1"},
+			expErr: true,
+		},
+		"multiple filters": {
+			args:   []string{"--contract=" + contractAddr, "--max-calls=1", "--allow-all-messages", "--allow-msg-keys=foo"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cmd := &cobra.Command{RunE: func(*cobra.Command, []string) error { return nil }}
+			cmd.Flags().Int64(authzcli.FlagExpiration, 0, "")
+			ExtendGrantAuthorizationCmd(cmd)
+			flags := cmd.Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			gotGrant, gotErr := parseContractGrantFlags(flags)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, contract.String(), gotGrant.Contract)
+			assert.Equal(t, spec.expLimit, gotGrant.GetLimit())
+			assert.Equal(t, spec.expFilter, gotGrant.GetFilter())
+		})
+	}
+}
+
+func TestCheckContractGrantFlags(t *testing.T) {
+	specs := map[string]struct {
+		authzType string
+		args      []string
+		expErr    bool
+	}{
+		"execution with max funds": {
+			authzType: authzTypeContractExecution,
+			args:      []string{"--max-funds=1stake"},
+		},
+		"migration with max calls": {
+			authzType: authzTypeContractMigration,
+			args:      []string{"--max-calls=1"},
+		},
+		"migration with max funds": {
+			authzType: authzTypeContractMigration,
+			args:      []string{"--max-funds=1stake"},
+			expErr:    true,
+		},
+		"migration with combined limit": {
+			authzType: authzTypeContractMigration,
+			args:      []string{"--max-calls=1", "--max-funds=1stake"},
+			expErr:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cmd := &cobra.Command{RunE: func(*cobra.Command, []string) error { return nil }}
+			cmd.Flags().Int64(authzcli.FlagExpiration, 0, "")
+			ExtendGrantAuthorizationCmd(cmd)
+			flags := cmd.Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			gotErr := checkContractGrantFlags(spec.authzType, flags)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
@@ -18,4 +18,7 @@ var ProposalHandlers = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(cli.ProposalPinCodesCmd),
 	govclient.NewProposalHandler(cli.ProposalUnpinCodesCmd),
 	govclient.NewProposalHandler(cli.ProposalUpdateInstantiateConfigCmd),
+	govclient.NewProposalHandler(cli.ProposalSetContractStorageQuotaCmd),
+	govclient.NewProposalHandler(cli.ProposalStoreAndInstantiateContractCmd),
+	govclient.NewProposalHandler(cli.ProposalUpdateParamsCmd),
 }
@@ -3,11 +3,15 @@ package testutil
 import (
 	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 
 	clitestutil "github.com/Finschia/finschia-sdk/testutil/cli"
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/query"
 
 	"github.com/Finschia/wasmd/x/wasm/client/cli"
@@ -75,6 +79,50 @@ func (s *IntegrationTestSuite) TestGetCmdListContractByCode() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdListContractsByCreator() {
+	val := s.network.Validators[0]
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				val.Address.String(),
+			},
+			true,
+		},
+		"invalid creator": {
+			[]string{
+				"invalid",
+			},
+			false,
+		},
+		"no creator": {
+			[]string{},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.GetCmdListContractsByCreator()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, s.queryCommonArgs()...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var contracts types.QueryContractsByCreatorResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &contracts), out.String())
+			s.Require().Contains(contracts.ContractAddresses, s.contractAddress)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryCodeInfo() {
 	val := s.network.Validators[0]
 
@@ -135,6 +183,108 @@ func (s *IntegrationTestSuite) TestGetCmdQueryCodeInfo() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryCodeInfoByChecksum() {
+	val := s.network.Validators[0]
+
+	codeID, err := strconv.ParseUint(s.codeID, 10, 64)
+	s.Require().NoError(err)
+	checksum := "470C5B703A682F778B8B088D48169B8D6E43F7F44AC70316692CDBE69E6605E3"
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{checksum},
+			true,
+		},
+		"lower case checksum": {
+			[]string{strings.ToLower(checksum)},
+			true,
+		},
+		"no checksum": {
+			[]string{},
+			false,
+		},
+		"invalid checksum": {
+			[]string{"invalid"},
+			false,
+		},
+		"no exist checksum": {
+			[]string{hex.EncodeToString(make([]byte, 32))},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.GetCmdQueryCodeInfoByChecksum()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, s.queryCommonArgs()...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res types.QueryCodeInfoByChecksumResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			var gotCodeIDs []uint64
+			for _, info := range res.CodeInfos {
+				s.Require().Equal(checksum, info.DataHash.String())
+				gotCodeIDs = append(gotCodeIDs, info.CodeID)
+			}
+			s.Require().Contains(gotCodeIDs, codeID)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdVerifyCode() {
+	val := s.network.Validators[0]
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{s.codeID, "../../keeper/testdata/hackatom.wasm"},
+			true,
+		},
+		"valid query with gzipped code": {
+			[]string{s.codeID, "../../keeper/testdata/hackatom.wasm.gzip"},
+			true,
+		},
+		"checksum mismatch": {
+			[]string{s.codeID, "../../keeper/testdata/reflect.wasm"},
+			false,
+		},
+		"no exist codeID": {
+			[]string{"100", "../../keeper/testdata/hackatom.wasm"},
+			false,
+		},
+		"no wasm file": {
+			[]string{s.codeID},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.GetCmdVerifyCode()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, s.queryCommonArgs()...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Contains(out.String(), "matches checksum 470C5B703A682F778B8B088D48169B8D6E43F7F44AC70316692CDBE69E6605E3")
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdGetContractInfo() {
 	val := s.network.Validators[0]
 
@@ -164,6 +314,7 @@ func (s *IntegrationTestSuite) TestGetCmdGetContractInfo() {
 					IBCPortID: "",
 					Extension: nil,
 				},
+				StorageUsage: s.contractStorageUsage(),
 			},
 		},
 		"no contractAddress": {
@@ -199,6 +350,59 @@ func (s *IntegrationTestSuite) TestGetCmdGetContractInfo() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdGetContractStorageUsage() {
+	val := s.network.Validators[0]
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.contractAddress,
+			},
+			true,
+			&types.QueryContractStorageUsageResponse{
+				Address:      s.contractAddress,
+				StorageUsage: s.contractStorageUsage(),
+			},
+		},
+		"wrong bech32_address": {
+			[]string{
+				"xxx",
+			},
+			false,
+			nil,
+		},
+		"no exist bech32_address": {
+			[]string{
+				s.nonExistValidAddress,
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.GetCmdGetContractStorageUsage()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, s.queryCommonArgs()...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res types.QueryContractStorageUsageResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().Equal(tc.expected, &res)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdGetContractStateAll() {
 	val := s.network.Validators[0]
 
@@ -222,6 +426,34 @@ func (s *IntegrationTestSuite) TestGetCmdGetContractStateAll() {
 				Pagination: &query.PageResponse{},
 			},
 		},
+		"valid query with prefix": {
+			[]string{
+				s.contractAddress,
+				fmt.Sprintf("--%s=%s", "prefix", hex.EncodeToString([]byte("conf"))),
+			},
+			true,
+			&types.QueryAllContractStateResponse{
+				Models: []types.Model{
+					{
+						Key:   []byte("config"),
+						Value: []byte(fmt.Sprintf("{\"verifier\":\"%s\",\"beneficiary\":\"%s\",\"funder\":\"%s\"}", s.verifier, s.beneficiary, s.verifier)),
+					},
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"valid query with key range": {
+			[]string{
+				s.contractAddress,
+				fmt.Sprintf("--%s=%s", "start-key", hex.EncodeToString([]byte("a"))),
+				fmt.Sprintf("--%s=%s", "end-key", hex.EncodeToString([]byte("config"))),
+			},
+			true,
+			&types.QueryAllContractStateResponse{
+				Models:     []types.Model{},
+				Pagination: &query.PageResponse{},
+			},
+		},
 		"wrong bech32_address": {
 			[]string{
 				"xxx",
@@ -320,6 +552,70 @@ func (s *IntegrationTestSuite) TestGetCmdGetContractStateRaw() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdGetContractStateRawBatch() {
+	val := s.network.Validators[0]
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.contractAddress,
+				hex.EncodeToString([]byte("config")),
+				hex.EncodeToString([]byte("verifier")),
+			},
+			true,
+			&types.QueryRawContractStatesResponse{
+				Models: []types.Model{
+					{
+						Key:   []byte("config"),
+						Value: []byte(fmt.Sprintf("{\"verifier\":\"%s\",\"beneficiary\":\"%s\",\"funder\":\"%s\"}", s.verifier, s.beneficiary, s.verifier)),
+					},
+					{
+						Key: []byte("verifier"),
+					},
+				},
+			},
+		},
+		"wrong bech32_address": {
+			[]string{
+				"xxx",
+				hex.EncodeToString([]byte("config")),
+			},
+			false,
+			nil,
+		},
+		"no exist bech32_address": {
+			[]string{
+				s.nonExistValidAddress,
+				hex.EncodeToString([]byte("config")),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.GetCmdGetContractStateRawBatch()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, s.queryCommonArgs()...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res types.QueryRawContractStatesResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().Equal(tc.expected, &res)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdGetContractStateSmart() {
 	val := s.network.Validators[0]
 
@@ -391,6 +687,176 @@ func (s *IntegrationTestSuite) TestGetCmdGetContractStateSmart() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdGetContractStateSmartBatch() {
+	val := s.network.Validators[0]
+
+	writeQueries := func(content string) string {
+		file := filepath.Join(s.T().TempDir(), "queries.json")
+		s.Require().NoError(os.WriteFile(file, []byte(content), 0o600))
+		return file
+	}
+
+	testCases := map[string]struct {
+		args       []string
+		valid      bool
+		expResults []types.SmartContractStateResult
+	}{
+		"valid query": {
+			[]string{
+				writeQueries(fmt.Sprintf(`[{"address":%q,"query":{"verifier":{}}},{"address":%q,"query":{"raw":{"key":"config"}}}]`, s.contractAddress, s.contractAddress)),
+			},
+			true,
+			[]types.SmartContractStateResult{
+				{
+					Address: s.contractAddress,
+					Data:    []byte(fmt.Sprintf("{\"verifier\":\"%s\"}", s.verifier)),
+				},
+				{
+					Address: s.contractAddress,
+					Error:   "Error parsing into type hackatom::msg::QueryMsg: unknown variant `raw`, expected one of `verifier`, `other_balance`, `recurse`, `get_int`: query wasm contract failed",
+				},
+			},
+		},
+		"wrong bech32_address": {
+			[]string{
+				writeQueries(`[{"address":"xxx","query":{"verifier":{}}}]`),
+			},
+			false,
+			nil,
+		},
+		"no exist file": {
+			[]string{
+				filepath.Join(s.T().TempDir(), "not_exist.json"),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.GetCmdGetContractStateSmartBatch()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, s.queryCommonArgs()...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res types.QueryBatchSmartContractStateResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().Len(res.Results, len(tc.expResults))
+			for i, exp := range tc.expResults {
+				s.Require().Equal(exp.Address, res.Results[i].Address)
+				s.Require().Equal(exp.Error, res.Results[i].Error)
+				if exp.Error == "" {
+					s.Require().Equal(exp.Data, res.Results[i].Data)
+				}
+			}
+			s.Require().NotZero(res.GasUsed)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdSimulateExecute() {
+	val := s.network.Validators[0]
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100)))
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+		trace bool
+	}{
+		"valid simulation": {
+			[]string{
+				s.contractAddress,
+				"{\"release\":{}}",
+				fmt.Sprintf("--sender=%s", s.verifier),
+				fmt.Sprintf("--amount=%s", amount),
+			},
+			true,
+			false,
+		},
+		"valid simulation with trace": {
+			[]string{
+				s.contractAddress,
+				"{\"release\":{}}",
+				fmt.Sprintf("--sender=%s", s.verifier),
+				fmt.Sprintf("--amount=%s", amount),
+				"--trace",
+			},
+			true,
+			true,
+		},
+		"unauthorized sender": {
+			[]string{
+				s.contractAddress,
+				"{\"release\":{}}",
+				fmt.Sprintf("--sender=%s", s.beneficiary),
+				fmt.Sprintf("--amount=%s", amount),
+			},
+			false,
+			false,
+		},
+		"no sender": {
+			[]string{
+				s.contractAddress,
+				"{\"release\":{}}",
+			},
+			false,
+			false,
+		},
+		"invalid json msg": {
+			[]string{
+				s.contractAddress,
+				"not json",
+				fmt.Sprintf("--sender=%s", s.verifier),
+			},
+			false,
+			false,
+		},
+		"wrong bech32_address": {
+			[]string{
+				"xxx",
+				"{\"release\":{}}",
+				fmt.Sprintf("--sender=%s", s.verifier),
+			},
+			false,
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.GetCmdSimulateExecute()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, s.queryCommonArgs()...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res types.QuerySimulateExecuteContractResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().Equal([]byte{0xf0, 0x0b, 0xaa}, res.Data)
+			s.Require().NotZero(res.GasUsed)
+			s.Require().Len(res.Messages, 1)
+			s.Require().Contains(string(res.Messages[0].Msg), s.beneficiary.String())
+			if !tc.trace {
+				s.Require().Empty(res.Trace)
+				return
+			}
+			s.Require().Len(res.Trace, 1)
+			s.Require().Equal(s.contractAddress, res.Trace[0].Contract)
+			s.Require().Len(res.Trace[0].Children, 1)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdGetContractHistory() {
 	val := s.network.Validators[0]
 
@@ -93,6 +93,12 @@ func (s *IntegrationTestSuite) queryCommonArgs() []string {
 	}
 }
 
+// contractStorageUsage returns the storage usage of the hackatom config stored on instantiation
+func (s *IntegrationTestSuite) contractStorageUsage() types.ContractStorageUsage {
+	config := fmt.Sprintf("{\"verifier\":\"%s\",\"beneficiary\":\"%s\",\"funder\":\"%s\"}", s.verifier, s.beneficiary, s.verifier)
+	return types.ContractStorageUsage{Bytes: uint64(len("config") + len(config)), Keys: 1}
+}
+
 func (s *IntegrationTestSuite) deployContract() string {
 	val := s.network.Validators[0]
 
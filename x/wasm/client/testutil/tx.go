@@ -165,3 +165,111 @@ func (s *IntegrationTestSuite) TestExecuteContractCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestUpdateInstantiateConfigCmd() {
+	val := s.network.Validators[0]
+	codeID := s.deployContract()
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid updateInstantiateConfig": {
+			[]string{
+				codeID,
+				fmt.Sprintf("--instantiate-anyof-addresses=%s", val.Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			true,
+		},
+		"no permission flag": {
+			[]string{
+				codeID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			false,
+		},
+		"invalid codeID": {
+			[]string{
+				"a",
+				fmt.Sprintf("--instantiate-nobody=%s", "true"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			false,
+		},
+		"no sender": {
+			[]string{
+				codeID,
+				fmt.Sprintf("--instantiate-nobody=%s", "true"),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.UpdateInstantiateConfigCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestRemoveCodeCmd() {
+	val := s.network.Validators[0]
+	codeID := s.deployContract()
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"invalid codeID": {
+			[]string{
+				"a",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			false,
+		},
+		"no sender": {
+			[]string{
+				codeID,
+			},
+			false,
+		},
+		"valid removeCode": {
+			[]string{
+				codeID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.RemoveCodeCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
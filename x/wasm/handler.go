@@ -39,6 +39,20 @@ func NewHandler(k types.ContractOpsKeeper) sdk.Handler {
 			res, err = msgServer.UpdateAdmin(sdk.WrapSDKContext(ctx), msg)
 		case *MsgClearAdmin:
 			res, err = msgServer.ClearAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgUpdateInstantiateConfig:
+			res, err = msgServer.UpdateInstantiateConfig(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgUpdateParams:
+			res, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgSudoContract:
+			res, err = msgServer.SudoContract(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgPinCodes:
+			res, err = msgServer.PinCodes(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgUnpinCodes:
+			res, err = msgServer.UnpinCodes(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgStoreAndInstantiateContract:
+			res, err = msgServer.StoreAndInstantiateContract(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgRemoveCode:
+			res, err = msgServer.RemoveCode(sdk.WrapSDKContext(ctx), msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -16,28 +16,48 @@ const (
 	DefaultDeserializationCostPerByte = 1
 )
 
-var (
-	costHumanize            = DefaultGasCostHumanAddress * DefaultGasMultiplier
-	costCanonical           = DefaultGasCostCanonicalAddress * DefaultGasMultiplier
-	costJSONDeserialization = wasmvmtypes.UFraction{
-		Numerator:   DefaultDeserializationCostPerByte * DefaultGasMultiplier,
-		Denominator: 1,
+// apiCosts are the costs in cosmwasm gas that the api charges for the address conversions
+type apiCosts struct {
+	humanize  uint64
+	canonical uint64
+}
+
+func humanAddress(cost uint64) func(canon []byte) (string, uint64, error) {
+	return func(canon []byte) (string, uint64, error) {
+		if err := sdk.VerifyAddressFormat(canon); err != nil {
+			return "", cost, err
+		}
+		return sdk.AccAddress(canon).String(), cost, nil
 	}
-)
+}
 
-func humanAddress(canon []byte) (string, uint64, error) {
-	if err := sdk.VerifyAddressFormat(canon); err != nil {
-		return "", costHumanize, err
+func canonicalAddress(cost uint64) func(human string) ([]byte, uint64, error) {
+	return func(human string) ([]byte, uint64, error) {
+		bz, err := sdk.AccAddressFromBech32(human)
+		return bz, cost, err
 	}
-	return sdk.AccAddress(canon).String(), costHumanize, nil
 }
 
-func canonicalAddress(human string) ([]byte, uint64, error) {
-	bz, err := sdk.AccAddressFromBech32(human)
-	return bz, costCanonical, err
+// cosmwasmAPI returns the api for the wasmvm. The address conversions are charged with the costs set
+// by the WithAPICosts option or with the default SDK gas costs converted by the gas register otherwise.
+func (k Keeper) cosmwasmAPI(ctx sdk.Context) wasmvm.GoAPI {
+	costs := apiCosts{
+		humanize:  k.gasRegister.ToWasmVMGas(ctx, DefaultGasCostHumanAddress),
+		canonical: k.gasRegister.ToWasmVMGas(ctx, DefaultGasCostCanonicalAddress),
+	}
+	if k.apiCosts != nil {
+		costs = *k.apiCosts
+	}
+	return wasmvm.GoAPI{
+		HumanAddress:     humanAddress(costs.humanize),
+		CanonicalAddress: canonicalAddress(costs.canonical),
+	}
 }
 
-var cosmwasmAPI = wasmvm.GoAPI{
-	HumanAddress:     humanAddress,
-	CanonicalAddress: canonicalAddress,
+// jsonDeserializationCost returns the cosmwasm gas charged per byte for the json deserialization in the wasmvm
+func (k Keeper) jsonDeserializationCost(ctx sdk.Context) wasmvmtypes.UFraction {
+	return wasmvmtypes.UFraction{
+		Numerator:   k.gasRegister.ToWasmVMGas(ctx, DefaultDeserializationCostPerByte),
+		Denominator: 1,
+	}
 }
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+func TestAPICostsPerKeeper(t *testing.T) {
+	const canonicalSDKGas = 1_000
+	cheapCtx, cheapKeepers := CreateTestInput(t, false, AvailableCapabilities, WithAPICosts(1, 1))
+	expensiveCtx, expensiveKeepers := CreateTestInput(t, false, AvailableCapabilities, WithAPICosts(1, canonicalSDKGas*DefaultGasMultiplier))
+
+	// hackatom validates the verifier and beneficiary addresses on instantiation with addr_canonicalize
+	instantiateGas := func(ctx sdk.Context, keepers TestKeepers) sdk.Gas {
+		example := StoreHackatomExampleContract(t, ctx, keepers)
+		initMsg := HackatomExampleInitMsg{
+			Verifier:    bytes.Repeat([]byte{1}, 20),
+			Beneficiary: bytes.Repeat([]byte{2}, 20),
+		}
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsg.GetBytes(t), "demo contract", nil)
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	cheapGas := instantiateGas(cheapCtx, cheapKeepers)
+	expensiveGas := instantiateGas(expensiveCtx, expensiveKeepers)
+
+	// both keepers coexist and charge their own costs for the two addresses
+	assert.Equal(t, sdk.Gas(2*canonicalSDKGas), expensiveGas-cheapGas)
+}
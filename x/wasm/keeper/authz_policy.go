@@ -11,6 +11,7 @@ type AuthorizationPolicy interface {
 	CanInstantiateContract(c types.AccessConfig, actor sdk.AccAddress) bool
 	CanModifyContract(admin, actor sdk.AccAddress) bool
 	CanModifyCodeAccessConfig(creator, actor sdk.AccAddress, isSubset bool) bool
+	CanRemoveCode(creator, actor sdk.AccAddress) bool
 }
 
 type DefaultAuthorizationPolicy struct{}
@@ -31,6 +32,10 @@ func (p DefaultAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor sdk
 	return creator != nil && creator.Equals(actor) && isSubset
 }
 
+func (p DefaultAuthorizationPolicy) CanRemoveCode(creator, actor sdk.AccAddress) bool {
+	return creator != nil && creator.Equals(actor)
+}
+
 type GovAuthorizationPolicy struct{}
 
 func (p GovAuthorizationPolicy) CanCreateCode(types.AccessConfig, sdk.AccAddress) bool {
@@ -48,3 +53,7 @@ func (p GovAuthorizationPolicy) CanModifyContract(sdk.AccAddress, sdk.AccAddress
 func (p GovAuthorizationPolicy) CanModifyCodeAccessConfig(sdk.AccAddress, sdk.AccAddress, bool) bool {
 	return true
 }
+
+func (p GovAuthorizationPolicy) CanRemoveCode(sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
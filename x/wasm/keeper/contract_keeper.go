@@ -10,7 +10,7 @@ var _ types.ContractOpsKeeper = PermissionedKeeper{}
 
 // decoratedKeeper contains a subset of the wasm keeper that are already or can be guarded by an authorization policy in the future
 type decoratedKeeper interface {
-	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, checksum []byte, err error)
+	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, source, builder string, codeHash []byte, authZ AuthorizationPolicy) (codeID uint64, checksum []byte, err error)
 
 	instantiate(
 		ctx sdk.Context,
@@ -31,7 +31,11 @@ type decoratedKeeper interface {
 	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	setContractInfoExtension(ctx sdk.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error
 	setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, autz AuthorizationPolicy) error
+	removeCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, authz AuthorizationPolicy) error
+	setContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota types.StorageQuota) error
 	ClassicAddressGenerator() AddressGenerator
+	SetParams(ctx sdk.Context, ps types.Params)
+	GetAuthority() string
 }
 
 type PermissionedKeeper struct {
@@ -51,8 +55,19 @@ func NewDefaultPermissionKeeper(nested decoratedKeeper) *PermissionedKeeper {
 	return NewPermissionedKeeper(nested, DefaultAuthorizationPolicy{})
 }
 
+// WithGovAuthorizationPolicy returns a keeper on the same nested keeper that checks all operations against the gov
+// authorization policy. It must only be used for operations that were already authorized by the module authority.
+func (p PermissionedKeeper) WithGovAuthorizationPolicy() types.ContractOpsKeeper {
+	return NewGovPermissionKeeper(p.nested)
+}
+
 func (p PermissionedKeeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig) (codeID uint64, checksum []byte, err error) {
-	return p.nested.create(ctx, creator, wasmCode, instantiateAccess, p.authZPolicy)
+	return p.nested.create(ctx, creator, wasmCode, instantiateAccess, "", "", nil, p.authZPolicy)
+}
+
+// CreateWithVerificationInfo uploads and compiles a WASM contract and stores the optional code verification metadata with it
+func (p PermissionedKeeper) CreateWithVerificationInfo(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, source, builder string, codeHash []byte) (codeID uint64, checksum []byte, err error) {
+	return p.nested.create(ctx, creator, wasmCode, instantiateAccess, source, builder, codeHash, p.authZPolicy)
 }
 
 // Instantiate creates an instance of a WASM contract using the classic sequence based address generator
@@ -128,3 +143,27 @@ func (p PermissionedKeeper) SetContractInfoExtension(ctx sdk.Context, contract s
 func (p PermissionedKeeper) SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig) error {
 	return p.nested.setAccessConfig(ctx, codeID, caller, newConfig, p.authZPolicy)
 }
+
+// RemoveCode deletes a code id that is neither pinned nor used by any contract.
+func (p PermissionedKeeper) RemoveCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress) error {
+	return p.nested.removeCode(ctx, codeID, caller, p.authZPolicy)
+}
+
+// SetContractStorageQuota sets the maximum state size of a contract that takes precedence over the params.
+func (p PermissionedKeeper) SetContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota types.StorageQuota) error {
+	return p.nested.setContractStorageQuota(ctx, contractAddress, quota)
+}
+
+// SetParams validates and sets all wasm parameters.
+func (p PermissionedKeeper) SetParams(ctx sdk.Context, ps types.Params) error {
+	if err := ps.ValidateBasic(); err != nil {
+		return err
+	}
+	p.nested.SetParams(ctx, ps)
+	return nil
+}
+
+// GetAuthority returns the address that is allowed to execute privileged operations.
+func (p PermissionedKeeper) GetAuthority() string {
+	return p.nested.GetAuthority()
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/authz"
+	"github.com/Finschia/finschia-sdk/x/feegrant"
+	wasmvmtypes "github.com/Finschia/wasmvm/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// EncodeFeegrantAuthzMsg encodes the custom messages of contracts for x/feegrant and x/authz.
+func EncodeFeegrantAuthzMsg(unpacker codectypes.AnyUnpacker) CustomEncoder {
+	return func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+		var custom types.FeegrantAuthzMsg
+		if err := json.Unmarshal(msg, &custom); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		switch {
+		case custom.Feegrant != nil:
+			return encodeFeegrantMsg(sender, custom.Feegrant)
+		case custom.Authz != nil:
+			return encodeAuthzMsg(unpacker, sender, custom.Authz)
+		}
+		return nil, sdkerrors.Wrap(types.ErrUnknownMsg, "unknown variant of Custom")
+	}
+}
+
+func encodeFeegrantMsg(sender sdk.AccAddress, msg *types.FeegrantMsg) ([]sdk.Msg, error) {
+	switch {
+	case msg.GrantAllowance != nil:
+		grantee, err := sdk.AccAddressFromBech32(msg.GrantAllowance.Grantee)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "grantee: %s", err)
+		}
+		spendLimit, err := ConvertWasmCoinsToSdkCoins(msg.GrantAllowance.SpendLimit)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "spend limit")
+		}
+		basic := &feegrant.BasicAllowance{SpendLimit: spendLimit.Sort()}
+		if msg.GrantAllowance.Expiration != 0 {
+			expiration, err := convertWasmTimestamp(msg.GrantAllowance.Expiration)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "expiration")
+			}
+			basic.Expiration = &expiration
+		}
+		var allowance feegrant.FeeAllowanceI = basic
+		if len(msg.GrantAllowance.AllowedMessages) != 0 {
+			if allowance, err = feegrant.NewAllowedMsgAllowance(basic, msg.GrantAllowance.AllowedMessages); err != nil {
+				return nil, err
+			}
+		}
+		sdkMsg, err := feegrant.NewMsgGrantAllowance(allowance, sender, grantee)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{sdkMsg}, nil
+	case msg.RevokeAllowance != nil:
+		grantee, err := sdk.AccAddressFromBech32(msg.RevokeAllowance.Grantee)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "grantee: %s", err)
+		}
+		sdkMsg := feegrant.NewMsgRevokeAllowance(sender, grantee)
+		return []sdk.Msg{&sdkMsg}, nil
+	default:
+		return nil, sdkerrors.Wrap(types.ErrUnknownMsg, "unknown variant of Feegrant")
+	}
+}
+
+func encodeAuthzMsg(unpacker codectypes.AnyUnpacker, sender sdk.AccAddress, msg *types.AuthzMsg) ([]sdk.Msg, error) {
+	switch {
+	case msg.Grant != nil:
+		grantee, err := sdk.AccAddressFromBech32(msg.Grant.Grantee)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "grantee: %s", err)
+		}
+		expiration, err := convertWasmTimestamp(msg.Grant.Expiration)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "expiration")
+		}
+		sdkMsg, err := authz.NewMsgGrant(sender, grantee, authz.NewGenericAuthorization(msg.Grant.MsgTypeURL), expiration)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{sdkMsg}, nil
+	case msg.Revoke != nil:
+		grantee, err := sdk.AccAddressFromBech32(msg.Revoke.Grantee)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "grantee: %s", err)
+		}
+		sdkMsg := authz.NewMsgRevoke(sender, grantee, msg.Revoke.MsgTypeURL)
+		return []sdk.Msg{&sdkMsg}, nil
+	case msg.Exec != nil:
+		// the inner messages are checked by the stargate filter of the MessageEncoders before they are dispatched
+		encodeStargate := EncodeStargateMsg(unpacker)
+		execMsgs := make([]sdk.Msg, 0, len(msg.Exec.Msgs))
+		for i := range msg.Exec.Msgs {
+			sdkMsgs, err := encodeStargate(sender, &msg.Exec.Msgs[i])
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "exec message %d", i)
+			}
+			execMsgs = append(execMsgs, sdkMsgs...)
+		}
+		sdkMsg := authz.NewMsgExec(sender, execMsgs)
+		return []sdk.Msg{&sdkMsg}, nil
+	default:
+		return nil, sdkerrors.Wrap(types.ErrUnknownMsg, "unknown variant of Authz")
+	}
+}
+
+// convertWasmTimestamp converts nanoseconds since epoch to time
+func convertWasmTimestamp(nanos uint64) (time.Time, error) {
+	if nanos > math.MaxInt64 {
+		return time.Time{}, sdkerrors.Wrapf(types.ErrInvalid, "timestamp %d out of range", nanos)
+	}
+	return time.Unix(0, int64(nanos)).UTC(), nil
+}
+
+// FeegrantAuthzQuerier handles the custom queries of contracts for x/feegrant and x/authz.
+// The queries are routed to the gRPC query services and the responses are returned json encoded.
+// Like the gRPC queries, they are public: a contract can query the grants of any granter and grantee,
+// not only the ones it is part of.
+func FeegrantAuthzQuerier(queryRouter GRPCQueryRouter, cdc codec.Codec) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var custom types.FeegrantAuthzQuery
+		if err := json.Unmarshal(request, &custom); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		switch {
+		case custom.Feegrant != nil && custom.Feegrant.Allowance != nil:
+			q := custom.Feegrant.Allowance
+			return queryGRPC(ctx, queryRouter, cdc, "/cosmos.feegrant.v1beta1.Query/Allowance",
+				&feegrant.QueryAllowanceRequest{Granter: q.Granter, Grantee: q.Grantee},
+				&feegrant.QueryAllowanceResponse{},
+			)
+		case custom.Feegrant != nil && custom.Feegrant.Allowances != nil:
+			q := custom.Feegrant.Allowances
+			return queryGRPC(ctx, queryRouter, cdc, "/cosmos.feegrant.v1beta1.Query/Allowances",
+				&feegrant.QueryAllowancesRequest{Grantee: q.Grantee},
+				&feegrant.QueryAllowancesResponse{},
+			)
+		case custom.Authz != nil && custom.Authz.Grants != nil:
+			q := custom.Authz.Grants
+			return queryGRPC(ctx, queryRouter, cdc, "/cosmos.authz.v1beta1.Query/Grants",
+				&authz.QueryGrantsRequest{Granter: q.Granter, Grantee: q.Grantee, MsgTypeUrl: q.MsgTypeURL},
+				&authz.QueryGrantsResponse{},
+			)
+		}
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown Custom query variant"}
+	}
+}
+
+func queryGRPC(ctx sdk.Context, queryRouter GRPCQueryRouter, cdc codec.Codec, path string, req, res codec.ProtoMarshaler) ([]byte, error) {
+	route := queryRouter.Route(path)
+	if route == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", path)}
+	}
+	bz, err := cdc.Marshal(req)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "to proto")
+	}
+	rsp, err := route(ctx, abci.RequestQuery{Data: bz, Path: path})
+	if err != nil {
+		return nil, err
+	}
+	return ConvertProtoToJSONMarshal(cdc, res, rsp.Value)
+}
@@ -0,0 +1,392 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/baseapp"
+	"github.com/Finschia/finschia-sdk/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/authz"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/feegrant"
+	wasmvmtypes "github.com/Finschia/wasmvm/types"
+
+	"github.com/Finschia/wasmd/x/wasm/keeper/testdata"
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestEncodeFeegrantAuthzMsg(t *testing.T) {
+	var (
+		addr1 = RandomAccountAddress(t)
+		addr2 = RandomAccountAddress(t)
+	)
+	expiration := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: addr2.String(),
+		ToAddress:   addr1.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 12345)),
+	}
+	bankMsgBin, err := proto.Marshal(bankMsg)
+	require.NoError(t, err)
+
+	mustAllowanceMsg := func(a feegrant.FeeAllowanceI) sdk.Msg {
+		msg, err := feegrant.NewMsgGrantAllowance(a, addr1, addr2)
+		require.NoError(t, err)
+		return msg
+	}
+	mustAllowedMsgAllowance := func(a feegrant.FeeAllowanceI, allowed []string) feegrant.FeeAllowanceI {
+		r, err := feegrant.NewAllowedMsgAllowance(a, allowed)
+		require.NoError(t, err)
+		return r
+	}
+	mustGrantMsg := func(typeURL string) sdk.Msg {
+		msg, err := authz.NewMsgGrant(addr1, addr2, authz.NewGenericAuthorization(typeURL), expiration)
+		require.NoError(t, err)
+		return msg
+	}
+	revokeAllowanceMsg := feegrant.NewMsgRevokeAllowance(addr1, addr2)
+	revokeMsg := authz.NewMsgRevoke(addr1, addr2, "/cosmos.bank.v1beta1.MsgSend")
+	execMsg := authz.NewMsgExec(addr1, []sdk.Msg{bankMsg})
+
+	specs := map[string]struct {
+		src    string
+		exp    []sdk.Msg
+		expErr *sdkerrors.Error
+	}{
+		"feegrant grant allowance": {
+			src: fmt.Sprintf(`{"feegrant":{"grant_allowance":{"grantee":%q,"spend_limit":[{"denom":"utgd","amount":"2"},{"denom":"uatom","amount":"1"}],"expiration":"%d"}}}`, addr2.String(), expiration.UnixNano()),
+			exp: []sdk.Msg{mustAllowanceMsg(&feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("utgd", 2)),
+				Expiration: &expiration,
+			})},
+		},
+		"feegrant grant allowance without limits": {
+			src: fmt.Sprintf(`{"feegrant":{"grant_allowance":{"grantee":%q}}}`, addr2.String()),
+			exp: []sdk.Msg{mustAllowanceMsg(&feegrant.BasicAllowance{})},
+		},
+		"feegrant grant allowance with allowed messages": {
+			src: fmt.Sprintf(`{"feegrant":{"grant_allowance":{"grantee":%q,"allowed_messages":["/cosmos.bank.v1beta1.MsgSend"]}}}`, addr2.String()),
+			exp: []sdk.Msg{mustAllowanceMsg(mustAllowedMsgAllowance(&feegrant.BasicAllowance{}, []string{"/cosmos.bank.v1beta1.MsgSend"}))},
+		},
+		"feegrant grant allowance with invalid grantee": {
+			src:    `{"feegrant":{"grant_allowance":{"grantee":"invalid"}}}`,
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"feegrant revoke allowance": {
+			src: fmt.Sprintf(`{"feegrant":{"revoke_allowance":{"grantee":%q}}}`, addr2.String()),
+			exp: []sdk.Msg{&revokeAllowanceMsg},
+		},
+		"feegrant unknown variant": {
+			src:    `{"feegrant":{}}`,
+			expErr: types.ErrUnknownMsg,
+		},
+		"authz grant": {
+			src: fmt.Sprintf(`{"authz":{"grant":{"grantee":%q,"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","expiration":"%d"}}}`, addr2.String(), expiration.UnixNano()),
+			exp: []sdk.Msg{mustGrantMsg("/cosmos.bank.v1beta1.MsgSend")},
+		},
+		"authz grant with invalid expiration": {
+			src:    fmt.Sprintf(`{"authz":{"grant":{"grantee":%q,"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","expiration":"18446744073709551615"}}}`, addr2.String()),
+			expErr: types.ErrInvalid,
+		},
+		"authz revoke": {
+			src: fmt.Sprintf(`{"authz":{"revoke":{"grantee":%q,"msg_type_url":"/cosmos.bank.v1beta1.MsgSend"}}}`, addr2.String()),
+			exp: []sdk.Msg{&revokeMsg},
+		},
+		"authz exec": {
+			src: fmt.Sprintf(`{"authz":{"exec":{"msgs":[{"type_url":"/cosmos.bank.v1beta1.MsgSend","value":%q}]}}}`, base64.StdEncoding.EncodeToString(bankMsgBin)),
+			exp: []sdk.Msg{&execMsg},
+		},
+		"authz exec with invalid type url": {
+			src:    fmt.Sprintf(`{"authz":{"exec":{"msgs":[{"type_url":"/cosmos.bank.v2.MsgSend","value":%q}]}}}`, base64.StdEncoding.EncodeToString(bankMsgBin)),
+			expErr: types.ErrInvalidMsg,
+		},
+		"authz unknown variant": {
+			src:    `{"authz":{}}`,
+			expErr: types.ErrUnknownMsg,
+		},
+		"unknown variant": {
+			src:    `{"foo":{}}`,
+			expErr: types.ErrUnknownMsg,
+		},
+		"invalid json": {
+			src:    `not json`,
+			expErr: sdkerrors.ErrJSONUnmarshal,
+		},
+	}
+	encoder := EncodeFeegrantAuthzMsg(MakeEncodingConfig(t).Marshaler)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := encoder(addr1, json.RawMessage(spec.src))
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestEncodeAuthzExecWithStargateFilter(t *testing.T) {
+	addr1 := RandomAccountAddress(t)
+	addr2 := RandomAccountAddress(t)
+	bankMsgBin, err := proto.Marshal(&banktypes.MsgSend{
+		FromAddress: addr2.String(),
+		ToAddress:   addr1.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 12345)),
+	})
+	require.NoError(t, err)
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	srcMsg, err := json.Marshal(types.FeegrantAuthzMsg{Authz: &types.AuthzMsg{Exec: &types.AuthzExecMsg{
+		Msgs: []wasmvmtypes.StargateMsg{{TypeURL: sendTypeURL, Value: bankMsgBin}},
+	}}})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		filter types.StargateMsgFilter
+		expErr *sdkerrors.Error
+	}{
+		"no restriction": {},
+		"not in deny list": {
+			filter: types.StargateMsgFilter{DenyList: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+		},
+		"in deny list": {
+			filter: types.StargateMsgFilter{DenyList: []string{sendTypeURL}},
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+		"not in accept list": {
+			filter: types.StargateMsgFilter{AcceptList: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+		"exceeds max size": {
+			filter: types.StargateMsgFilter{MaxMsgSize: uint64(len(bankMsgBin) - 1)},
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+	}
+	cdc := MakeEncodingConfig(t).Marshaler
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var ctx sdk.Context
+			source := stargateMsgFilterSourceFn(func(ctx sdk.Context) types.StargateMsgFilter {
+				return spec.filter
+			})
+			encoder := DefaultEncoders(cdc, nil).Merge(&MessageEncoders{
+				Custom:         EncodeFeegrantAuthzMsg(cdc),
+				StargateFilter: StargateMsgFilterChecker(source),
+			})
+			res, gotErr := encoder.Encode(ctx, addr1, "", wasmvmtypes.CosmosMsg{Custom: srcMsg})
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, res, 1)
+		})
+	}
+}
+
+func TestEncodeFeegrantAuthzMsgWithStargateFilter(t *testing.T) {
+	addr1 := RandomAccountAddress(t)
+	addr2 := RandomAccountAddress(t)
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	specs := map[string]struct {
+		src     types.FeegrantAuthzMsg
+		typeURL string
+	}{
+		"feegrant grant allowance": {
+			src:     types.FeegrantAuthzMsg{Feegrant: &types.FeegrantMsg{GrantAllowance: &types.GrantAllowanceMsg{Grantee: addr2.String()}}},
+			typeURL: sdk.MsgTypeURL(&feegrant.MsgGrantAllowance{}),
+		},
+		"feegrant revoke allowance": {
+			src:     types.FeegrantAuthzMsg{Feegrant: &types.FeegrantMsg{RevokeAllowance: &types.RevokeAllowanceMsg{Grantee: addr2.String()}}},
+			typeURL: sdk.MsgTypeURL(&feegrant.MsgRevokeAllowance{}),
+		},
+		"authz grant": {
+			src:     types.FeegrantAuthzMsg{Authz: &types.AuthzMsg{Grant: &types.AuthzGrantMsg{Grantee: addr2.String(), MsgTypeURL: sendTypeURL, Expiration: 1}}},
+			typeURL: sdk.MsgTypeURL(&authz.MsgGrant{}),
+		},
+		"authz revoke": {
+			src:     types.FeegrantAuthzMsg{Authz: &types.AuthzMsg{Revoke: &types.AuthzRevokeMsg{Grantee: addr2.String(), MsgTypeURL: sendTypeURL}}},
+			typeURL: sdk.MsgTypeURL(&authz.MsgRevoke{}),
+		},
+		"authz exec": {
+			src:     types.FeegrantAuthzMsg{Authz: &types.AuthzMsg{Exec: &types.AuthzExecMsg{}}},
+			typeURL: sdk.MsgTypeURL(&authz.MsgExec{}),
+		},
+	}
+	cdc := MakeEncodingConfig(t).Marshaler
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			srcMsg, err := json.Marshal(spec.src)
+			require.NoError(t, err)
+			encode := func(filter types.StargateMsgFilter) ([]sdk.Msg, error) {
+				source := stargateMsgFilterSourceFn(func(ctx sdk.Context) types.StargateMsgFilter {
+					return filter
+				})
+				encoder := DefaultEncoders(cdc, nil).Merge(&MessageEncoders{
+					Custom:         EncodeFeegrantAuthzMsg(cdc),
+					StargateFilter: StargateMsgFilterChecker(source),
+				})
+				return encoder.Encode(sdk.Context{}, addr1, "", wasmvmtypes.CosmosMsg{Custom: srcMsg})
+			}
+
+			// when not denied
+			res, err := encode(types.StargateMsgFilter{DenyList: []string{"/cosmos.gov.v1beta1.MsgVote"}})
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			// when denied
+			_, err = encode(types.StargateMsgFilter{DenyList: []string{spec.typeURL}})
+			assert.ErrorIs(t, err, types.ErrStargateMsgNotAllowed)
+			// when not accepted
+			_, err = encode(types.StargateMsgFilter{AcceptList: []string{"/cosmos.gov.v1beta1.MsgVote"}})
+			assert.ErrorIs(t, err, types.ErrStargateMsgNotAllowed)
+		})
+	}
+}
+
+func TestReflectFeegrantAuthz(t *testing.T) {
+	cdc := MakeEncodingConfig(t).Marshaler
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, WithMessageEncoders(reflectFeegrantAuthzEncoders(cdc)))
+	keeper := keepers.ContractKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	bob := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+
+	codeID, _, err := keeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "reflect contract 1", nil)
+	require.NoError(t, err)
+
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(keepers.EncodingConfig.InterfaceRegistry)
+	feegrant.RegisterQueryServer(queryRouter, keepers.FeeGrantKeeper)
+	authz.RegisterQueryServer(queryRouter, keepers.AuthzKeeper)
+	querier := FeegrantAuthzQuerier(queryRouter, cdc)
+
+	reflectCustom := func(t *testing.T, custom types.FeegrantAuthzMsg) error {
+		msg := mustReflectFeegrantAuthzMsg(t, custom)
+		_, err := keeper.Execute(ctx, contractAddr, creator, msg, nil)
+		return err
+	}
+	query := func(t *testing.T, custom types.FeegrantAuthzQuery) []byte {
+		bz, err := json.Marshal(custom)
+		require.NoError(t, err)
+		res, err := querier(ctx, bz)
+		require.NoError(t, err)
+		return res
+	}
+
+	// contract grants a fee allowance to bob
+	err = reflectCustom(t, types.FeegrantAuthzMsg{Feegrant: &types.FeegrantMsg{GrantAllowance: &types.GrantAllowanceMsg{
+		Grantee:    bob.String(),
+		SpendLimit: wasmvmtypes.Coins{{Denom: "denom", Amount: "100"}},
+	}}})
+	require.NoError(t, err)
+	allowance, err := keepers.FeeGrantKeeper.GetAllowance(ctx, contractAddr, bob)
+	require.NoError(t, err)
+	assert.Equal(t, &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom", 100))}, allowance)
+
+	// and queries it
+	res := query(t, types.FeegrantAuthzQuery{Feegrant: &types.FeegrantQuery{Allowance: &types.AllowanceQuery{Granter: contractAddr.String(), Grantee: bob.String()}}})
+	var allowanceRsp feegrant.QueryAllowanceResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &allowanceRsp))
+	assert.Equal(t, contractAddr.String(), allowanceRsp.Allowance.Granter)
+	res = query(t, types.FeegrantAuthzQuery{Feegrant: &types.FeegrantQuery{Allowances: &types.AllowancesQuery{Grantee: bob.String()}}})
+	var allowancesRsp feegrant.QueryAllowancesResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &allowancesRsp))
+	assert.Len(t, allowancesRsp.Allowances, 1)
+
+	// and revokes it
+	err = reflectCustom(t, types.FeegrantAuthzMsg{Feegrant: &types.FeegrantMsg{RevokeAllowance: &types.RevokeAllowanceMsg{Grantee: bob.String()}}})
+	require.NoError(t, err)
+	_, err = keepers.FeeGrantKeeper.GetAllowance(ctx, contractAddr, bob)
+	require.Error(t, err)
+
+	// contract grants bob to send its tokens
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	expiration := ctx.BlockTime().Add(time.Hour)
+	err = reflectCustom(t, types.FeegrantAuthzMsg{Authz: &types.AuthzMsg{Grant: &types.AuthzGrantMsg{
+		Grantee:    bob.String(),
+		MsgTypeURL: sendTypeURL,
+		Expiration: uint64(expiration.UnixNano()),
+	}}})
+	require.NoError(t, err)
+	gotAuthorization, gotExpiration := keepers.AuthzKeeper.GetCleanAuthorization(ctx, bob, contractAddr, sendTypeURL)
+	assert.Equal(t, authz.NewGenericAuthorization(sendTypeURL), gotAuthorization)
+	assert.Equal(t, expiration, gotExpiration)
+
+	// and queries it
+	res = query(t, types.FeegrantAuthzQuery{Authz: &types.AuthzQuery{Grants: &types.AuthzGrantsQuery{Granter: contractAddr.String(), Grantee: bob.String()}}})
+	var grantsRsp authz.QueryGrantsResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &grantsRsp))
+	require.Len(t, grantsRsp.Grants, 1)
+	assert.Equal(t, expiration, grantsRsp.Grants[0].Expiration)
+
+	// and revokes it
+	err = reflectCustom(t, types.FeegrantAuthzMsg{Authz: &types.AuthzMsg{Revoke: &types.AuthzRevokeMsg{Grantee: bob.String(), MsgTypeURL: sendTypeURL}}})
+	require.NoError(t, err)
+	gotAuthorization, _ = keepers.AuthzKeeper.GetCleanAuthorization(ctx, bob, contractAddr, sendTypeURL)
+	assert.Nil(t, gotAuthorization)
+
+	// bob grants the contract to send his tokens
+	require.NoError(t, keepers.AuthzKeeper.SaveGrant(ctx, contractAddr, bob, authz.NewGenericAuthorization(sendTypeURL), expiration))
+	bankMsgBin, err := proto.Marshal(&banktypes.MsgSend{
+		FromAddress: bob.String(),
+		ToAddress:   creator.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("denom", 1000)),
+	})
+	require.NoError(t, err)
+	execMsg := types.FeegrantAuthzMsg{Authz: &types.AuthzMsg{Exec: &types.AuthzExecMsg{
+		Msgs: []wasmvmtypes.StargateMsg{{TypeURL: sendTypeURL, Value: bankMsgBin}},
+	}}}
+
+	// and the contract sends them on behalf of bob
+	err = reflectCustom(t, execMsg)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 99000), keepers.BankKeeper.GetBalance(ctx, bob, "denom"))
+	assert.Equal(t, sdk.NewInt64Coin("denom", 101000), keepers.BankKeeper.GetBalance(ctx, creator, "denom"))
+
+	// without a grant the exec fails
+	require.NoError(t, keepers.AuthzKeeper.DeleteGrant(ctx, contractAddr, bob, sendTypeURL))
+	err = reflectCustom(t, execMsg)
+	require.Error(t, err)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 99000), keepers.BankKeeper.GetBalance(ctx, bob, "denom"))
+}
+
+// reflectFeegrantAuthzEncoders decodes the raw custom messages of the reflect contract as feegrant and authz messages
+func reflectFeegrantAuthzEncoders(cdc codec.Codec) *MessageEncoders {
+	encode := EncodeFeegrantAuthzMsg(cdc)
+	return &MessageEncoders{
+		Custom: func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+			var custom reflectCustomMsg
+			if err := json.Unmarshal(msg, &custom); err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+			}
+			return encode(sender, custom.Raw)
+		},
+	}
+}
+
+func mustReflectFeegrantAuthzMsg(t *testing.T, custom types.FeegrantAuthzMsg) []byte {
+	rawBz, err := json.Marshal(custom)
+	require.NoError(t, err)
+	customBz, err := json.Marshal(reflectCustomMsg{Raw: rawBz})
+	require.NoError(t, err)
+	bz, err := json.Marshal(testdata.ReflectHandleMsg{
+		Reflect: &testdata.ReflectPayload{Msgs: []wasmvmtypes.CosmosMsg{{Custom: customBz}}},
+	})
+	require.NoError(t, err)
+	return bz
+}
@@ -4,86 +4,57 @@ import (
 	storetypes "github.com/Finschia/finschia-sdk/store/types"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	paramtypes "github.com/Finschia/finschia-sdk/x/params/types"
 	wasmvmtypes "github.com/Finschia/wasmvm/types"
 
 	"github.com/Finschia/wasmd/x/wasm/types"
 )
 
 const (
-	// DefaultGasMultiplier is how many CosmWasm gas points = 1 Cosmos SDK gas point.
-	//
-	// CosmWasm gas strategy is documented in https://github.com/CosmWasm/cosmwasm/blob/v1.0.0-beta/docs/GAS.md.
-	// Cosmos SDK reference costs can be found here: https://github.com/cosmos/cosmos-sdk/blob/v0.42.10/store/types/gas.go#L198-L209.
-	//
-	// The original multiplier of 100 up to CosmWasm 0.16 was based on
-	//     "A write at ~3000 gas and ~200us = 10 gas per us (microsecond) cpu/io
-	//     Rough timing have 88k gas at 90us, which is equal to 1k sdk gas... (one read)"
-	// as well as manual Wasmer benchmarks from 2019. This was then multiplied by 150_000
-	// in the 0.16 -> 1.0 upgrade (https://github.com/CosmWasm/cosmwasm/pull/1120).
-	//
-	// The multiplier deserves more reproducible benchmarking and a strategy that allows easy adjustments.
-	// This is tracked in https://github.com/CosmWasm/wasmd/issues/566 and https://github.com/CosmWasm/wasmd/issues/631.
-	// Gas adjustments are consensus breaking but may happen in any release marked as consensus breaking.
-	// Do not make assumptions on how much gas an operation will consume in places that are hard to adjust,
-	// such as hardcoding them in contracts.
-	//
-	// Please note that all gas prices returned to wasmvm should have this multiplied.
-	// Benchmarks and numbers were discussed in: https://github.com/CosmWasm/wasmd/pull/634#issuecomment-938055852
-	DefaultGasMultiplier uint64 = 140_000_000
-	// DefaultInstanceCost is how much SDK gas we charge each time we load a WASM instance.
-	// Creating a new instance is costly, and this helps put a recursion limit to contracts calling contracts.
-	// Benchmarks and numbers were discussed in: https://github.com/CosmWasm/wasmd/pull/634#issuecomment-938056803
-	DefaultInstanceCost uint64 = 60_000
-	// DefaultCompileCost is how much SDK gas is charged *per byte* for compiling WASM code.
-	// Benchmarks and numbers were discussed in: https://github.com/CosmWasm/wasmd/pull/634#issuecomment-938056803
-	DefaultCompileCost uint64 = 3
-	// DefaultEventAttributeDataCost is how much SDK gas is charged *per byte* for attribute data in events.
-	// This is used with len(key) + len(value)
-	DefaultEventAttributeDataCost uint64 = 1
+	// DefaultGasMultiplier is how many CosmWasm gas points = 1 Cosmos SDK gas point, see types.DefaultGasMultiplier
+	DefaultGasMultiplier = types.DefaultGasMultiplier
+	// DefaultInstanceCost is how much SDK gas we charge each time we load a WASM instance
+	DefaultInstanceCost = types.DefaultInstanceCost
+	// DefaultCompileCost is how much SDK gas is charged *per byte* for compiling WASM code
+	DefaultCompileCost = types.DefaultCompileCost
+	// DefaultEventAttributeDataCost is how much SDK gas is charged *per byte* for attribute data in events
+	DefaultEventAttributeDataCost = types.DefaultEventAttributeDataCost
 	// DefaultContractMessageDataCost is how much SDK gas is charged *per byte* of the message that goes to the contract
-	// This is used with len(msg). Note that the message is deserialized in the receiving contract and this is charged
-	// with wasm gas already. The derserialization of results is also charged in wasmvm. I am unsure if we need to add
-	// additional costs here.
-	// Note: also used for error fields on reply, and data on reply. Maybe these should be pulled out to a different (non-zero) field
-	DefaultContractMessageDataCost uint64 = 0
-	// DefaultPerAttributeCost is how much SDK gas we charge per attribute count.
-	DefaultPerAttributeCost uint64 = 10
-	// DefaultPerCustomEventCost is how much SDK gas we charge per event count.
-	DefaultPerCustomEventCost uint64 = 20
-	// DefaultEventAttributeDataFreeTier number of bytes of total attribute data we do not charge.
-	DefaultEventAttributeDataFreeTier = 100
+	DefaultContractMessageDataCost = types.DefaultContractMessageDataCost
+	// DefaultPerAttributeCost is how much SDK gas we charge per attribute count
+	DefaultPerAttributeCost = types.DefaultPerAttributeCost
+	// DefaultPerCustomEventCost is how much SDK gas we charge per event count
+	DefaultPerCustomEventCost = types.DefaultPerCustomEventCost
+	// DefaultEventAttributeDataFreeTier number of bytes of total attribute data we do not charge
+	DefaultEventAttributeDataFreeTier = types.DefaultEventAttributeDataFreeTier
 )
 
-// default: 0.15 gas.
-// see https://github.com/CosmWasm/wasmd/pull/898#discussion_r937727200
-var defaultPerByteUncompressCost = wasmvmtypes.UFraction{
-	Numerator:   15,
-	Denominator: 100,
-}
-
 // DefaultPerByteUncompressCost is how much SDK gas we charge per source byte to unpack
 func DefaultPerByteUncompressCost() wasmvmtypes.UFraction {
-	return defaultPerByteUncompressCost
+	return wasmvmtypes.UFraction{
+		Numerator:   types.DefaultPerByteUncompressCostNumerator,
+		Denominator: types.DefaultPerByteUncompressCostDenominator,
+	}
 }
 
 // GasRegister abstract source for gas costs
 type GasRegister interface {
 	// NewContractInstanceCosts costs to crate a new contract instance from code
-	NewContractInstanceCosts(pinned bool, msgLen int) sdk.Gas
+	NewContractInstanceCosts(ctx sdk.Context, pinned bool, msgLen int) sdk.Gas
 	// CompileCosts costs to persist and "compile" a new wasm contract
-	CompileCosts(byteLength int) sdk.Gas
+	CompileCosts(ctx sdk.Context, byteLength int) sdk.Gas
 	// UncompressCosts costs to unpack a new wasm contract
-	UncompressCosts(byteLength int) sdk.Gas
+	UncompressCosts(ctx sdk.Context, byteLength int) sdk.Gas
 	// InstantiateContractCosts costs when interacting with a wasm contract
-	InstantiateContractCosts(pinned bool, msgLen int) sdk.Gas
+	InstantiateContractCosts(ctx sdk.Context, pinned bool, msgLen int) sdk.Gas
 	// ReplyCosts costs to handle a message reply
-	ReplyCosts(pinned bool, reply wasmvmtypes.Reply) sdk.Gas
+	ReplyCosts(ctx sdk.Context, pinned bool, reply wasmvmtypes.Reply) sdk.Gas
 	// EventCosts costs to persist an event
-	EventCosts(attrs []wasmvmtypes.EventAttribute, events wasmvmtypes.Events) sdk.Gas
+	EventCosts(ctx sdk.Context, attrs []wasmvmtypes.EventAttribute, events wasmvmtypes.Events) sdk.Gas
 	// ToWasmVMGas converts from sdk gas to wasmvm gas
-	ToWasmVMGas(source sdk.Gas) uint64
+	ToWasmVMGas(ctx sdk.Context, source sdk.Gas) uint64
 	// FromWasmVMGas converts from wasmvm gas to sdk gas
-	FromWasmVMGas(source uint64) sdk.Gas
+	FromWasmVMGas(ctx sdk.Context, source uint64) sdk.Gas
 }
 
 // WasmGasRegisterConfig config type
@@ -114,22 +85,30 @@ type WasmGasRegisterConfig struct {
 
 // DefaultGasRegisterConfig default values
 func DefaultGasRegisterConfig() WasmGasRegisterConfig {
+	return GasRegisterConfigFromParams(types.DefaultGasRegisterParams())
+}
+
+// GasRegisterConfigFromParams converts the gas register params of the chain state into a config
+func GasRegisterConfigFromParams(p types.GasRegisterParams) WasmGasRegisterConfig {
 	return WasmGasRegisterConfig{
-		InstanceCost:               DefaultInstanceCost,
-		CompileCost:                DefaultCompileCost,
-		GasMultiplier:              DefaultGasMultiplier,
-		EventPerAttributeCost:      DefaultPerAttributeCost,
-		CustomEventCost:            DefaultPerCustomEventCost,
-		EventAttributeDataCost:     DefaultEventAttributeDataCost,
-		EventAttributeDataFreeTier: DefaultEventAttributeDataFreeTier,
-		ContractMessageDataCost:    DefaultContractMessageDataCost,
-		UncompressCost:             DefaultPerByteUncompressCost(),
+		InstanceCost:               p.InstanceCost,
+		CompileCost:                p.CompileCost,
+		GasMultiplier:              p.GasMultiplier,
+		EventPerAttributeCost:      p.EventPerAttributeCost,
+		CustomEventCost:            p.CustomEventCost,
+		EventAttributeDataCost:     p.EventAttributeDataCost,
+		EventAttributeDataFreeTier: p.EventAttributeDataFreeTier,
+		ContractMessageDataCost:    p.ContractMessageDataCost,
+		UncompressCost: wasmvmtypes.UFraction{
+			Numerator:   p.UncompressCostNumerator,
+			Denominator: p.UncompressCostDenominator,
+		},
 	}
 }
 
 // WasmGasRegister implements GasRegister interface
 type WasmGasRegister struct {
-	c WasmGasRegisterConfig
+	config func(ctx sdk.Context) WasmGasRegisterConfig
 }
 
 // NewDefaultWasmGasRegister creates instance with default values
@@ -137,80 +116,104 @@ func NewDefaultWasmGasRegister() WasmGasRegister {
 	return NewWasmGasRegister(DefaultGasRegisterConfig())
 }
 
-// NewWasmGasRegister constructor
+// NewWasmGasRegister constructor of a gas register with fixed costs
 func NewWasmGasRegister(c WasmGasRegisterConfig) WasmGasRegister {
 	if c.GasMultiplier == 0 {
 		panic(sdkerrors.Wrap(sdkerrors.ErrLogic, "GasMultiplier can not be 0"))
 	}
 	return WasmGasRegister{
-		c: c,
+		config: func(sdk.Context) WasmGasRegisterConfig { return c },
+	}
+}
+
+// NewParamsWasmGasRegister constructor of a gas register that reads the costs from the gas register params
+// of the wasm module, so that they can be changed by governance. The params are read from the context on
+// every call.
+func NewParamsWasmGasRegister(paramSpace paramtypes.Subspace) WasmGasRegister {
+	return WasmGasRegister{
+		config: func(ctx sdk.Context) WasmGasRegisterConfig {
+			var p types.GasRegisterParams
+			// reading the costs must not consume gas itself
+			paramSpace.Get(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyGasRegister, &p)
+			return GasRegisterConfigFromParams(p)
+		},
 	}
 }
 
 // NewContractInstanceCosts costs to crate a new contract instance from code
-func (g WasmGasRegister) NewContractInstanceCosts(pinned bool, msgLen int) storetypes.Gas {
-	return g.InstantiateContractCosts(pinned, msgLen)
+func (g WasmGasRegister) NewContractInstanceCosts(ctx sdk.Context, pinned bool, msgLen int) storetypes.Gas {
+	return g.InstantiateContractCosts(ctx, pinned, msgLen)
 }
 
 // CompileCosts costs to persist and "compile" a new wasm contract
-func (g WasmGasRegister) CompileCosts(byteLength int) storetypes.Gas {
+func (g WasmGasRegister) CompileCosts(ctx sdk.Context, byteLength int) storetypes.Gas {
 	if byteLength < 0 {
 		panic(sdkerrors.Wrap(types.ErrInvalid, "negative length"))
 	}
-	return g.c.CompileCost * uint64(byteLength)
+	return g.config(ctx).CompileCost * uint64(byteLength)
 }
 
 // UncompressCosts costs to unpack a new wasm contract
-func (g WasmGasRegister) UncompressCosts(byteLength int) sdk.Gas {
+func (g WasmGasRegister) UncompressCosts(ctx sdk.Context, byteLength int) sdk.Gas {
 	if byteLength < 0 {
 		panic(sdkerrors.Wrap(types.ErrInvalid, "negative length"))
 	}
-	return g.c.UncompressCost.Mul(uint64(byteLength)).Floor()
+	c := g.config(ctx)
+	return c.UncompressCost.Mul(uint64(byteLength)).Floor()
 }
 
 // InstantiateContractCosts costs when interacting with a wasm contract
-func (g WasmGasRegister) InstantiateContractCosts(pinned bool, msgLen int) sdk.Gas {
+func (g WasmGasRegister) InstantiateContractCosts(ctx sdk.Context, pinned bool, msgLen int) sdk.Gas {
+	return instantiateContractCosts(g.config(ctx), pinned, msgLen)
+}
+
+func instantiateContractCosts(c WasmGasRegisterConfig, pinned bool, msgLen int) sdk.Gas {
 	if msgLen < 0 {
 		panic(sdkerrors.Wrap(types.ErrInvalid, "negative length"))
 	}
-	dataCosts := sdk.Gas(msgLen) * g.c.ContractMessageDataCost
+	dataCosts := sdk.Gas(msgLen) * c.ContractMessageDataCost
 	if pinned {
 		return dataCosts
 	}
-	return g.c.InstanceCost + dataCosts
+	return c.InstanceCost + dataCosts
 }
 
 // ReplyCosts costs to to handle a message reply
-func (g WasmGasRegister) ReplyCosts(pinned bool, reply wasmvmtypes.Reply) sdk.Gas {
+func (g WasmGasRegister) ReplyCosts(ctx sdk.Context, pinned bool, reply wasmvmtypes.Reply) sdk.Gas {
+	c := g.config(ctx)
 	var eventGas sdk.Gas
 	msgLen := len(reply.Result.Err)
 	if reply.Result.Ok != nil {
 		msgLen += len(reply.Result.Ok.Data)
 		var attrs []wasmvmtypes.EventAttribute
 		for _, e := range reply.Result.Ok.Events {
-			eventGas += sdk.Gas(len(e.Type)) * g.c.EventAttributeDataCost
+			eventGas += sdk.Gas(len(e.Type)) * c.EventAttributeDataCost
 			attrs = append(attrs, e.Attributes...)
 		}
 		// apply free tier on the whole set not per event
-		eventGas += g.EventCosts(attrs, nil)
+		eventGas += eventCosts(c, attrs, nil)
 	}
-	return eventGas + g.InstantiateContractCosts(pinned, msgLen)
+	return eventGas + instantiateContractCosts(c, pinned, msgLen)
 }
 
 // EventCosts costs to persist an event
-func (g WasmGasRegister) EventCosts(attrs []wasmvmtypes.EventAttribute, events wasmvmtypes.Events) sdk.Gas {
-	gas, remainingFreeTier := g.eventAttributeCosts(attrs, g.c.EventAttributeDataFreeTier)
+func (g WasmGasRegister) EventCosts(ctx sdk.Context, attrs []wasmvmtypes.EventAttribute, events wasmvmtypes.Events) sdk.Gas {
+	return eventCosts(g.config(ctx), attrs, events)
+}
+
+func eventCosts(c WasmGasRegisterConfig, attrs []wasmvmtypes.EventAttribute, events wasmvmtypes.Events) sdk.Gas {
+	gas, remainingFreeTier := eventAttributeCosts(c, attrs, c.EventAttributeDataFreeTier)
 	for _, e := range events {
-		gas += g.c.CustomEventCost
-		gas += sdk.Gas(len(e.Type)) * g.c.EventAttributeDataCost // no free tier with event type
+		gas += c.CustomEventCost
+		gas += sdk.Gas(len(e.Type)) * c.EventAttributeDataCost // no free tier with event type
 		var attrCost sdk.Gas
-		attrCost, remainingFreeTier = g.eventAttributeCosts(e.Attributes, remainingFreeTier)
+		attrCost, remainingFreeTier = eventAttributeCosts(c, e.Attributes, remainingFreeTier)
 		gas += attrCost
 	}
 	return gas
 }
 
-func (g WasmGasRegister) eventAttributeCosts(attrs []wasmvmtypes.EventAttribute, freeTier uint64) (sdk.Gas, uint64) {
+func eventAttributeCosts(c WasmGasRegisterConfig, attrs []wasmvmtypes.EventAttribute, freeTier uint64) (sdk.Gas, uint64) {
 	if len(attrs) == 0 {
 		return 0, freeTier
 	}
@@ -220,8 +223,8 @@ func (g WasmGasRegister) eventAttributeCosts(attrs []wasmvmtypes.EventAttribute,
 	}
 	storedBytes, freeTier = calcWithFreeTier(storedBytes, freeTier)
 	// total Length * costs + attribute count * costs
-	r := sdk.NewIntFromUint64(g.c.EventAttributeDataCost).Mul(sdk.NewIntFromUint64(storedBytes)).
-		Add(sdk.NewIntFromUint64(g.c.EventPerAttributeCost).Mul(sdk.NewIntFromUint64(uint64(len(attrs)))))
+	r := sdk.NewIntFromUint64(c.EventAttributeDataCost).Mul(sdk.NewIntFromUint64(storedBytes)).
+		Add(sdk.NewIntFromUint64(c.EventPerAttributeCost).Mul(sdk.NewIntFromUint64(uint64(len(attrs)))))
 	if !r.IsUint64() {
 		panic(sdk.ErrorOutOfGas{Descriptor: "overflow"})
 	}
@@ -238,8 +241,8 @@ func calcWithFreeTier(storedBytes uint64, freeTier uint64) (uint64, uint64) {
 }
 
 // ToWasmVMGas convert to wasmVM contract runtime gas unit
-func (g WasmGasRegister) ToWasmVMGas(source storetypes.Gas) uint64 {
-	x := source * g.c.GasMultiplier
+func (g WasmGasRegister) ToWasmVMGas(ctx sdk.Context, source storetypes.Gas) uint64 {
+	x := source * g.config(ctx).GasMultiplier
 	if x < source {
 		panic(sdk.ErrorOutOfGas{Descriptor: "overflow"})
 	}
@@ -247,6 +250,6 @@ func (g WasmGasRegister) ToWasmVMGas(source storetypes.Gas) uint64 {
 }
 
 // FromWasmVMGas converts to SDK gas unit
-func (g WasmGasRegister) FromWasmVMGas(source uint64) sdk.Gas {
-	return source / g.c.GasMultiplier
+func (g WasmGasRegister) FromWasmVMGas(ctx sdk.Context, source uint64) sdk.Gas {
+	return source / g.config(ctx).GasMultiplier
 }
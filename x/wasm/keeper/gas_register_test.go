@@ -41,11 +41,11 @@ func TestCompileCosts(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			if spec.expPanic {
 				assert.Panics(t, func() {
-					NewWasmGasRegister(spec.srcConfig).CompileCosts(spec.srcLen)
+					NewWasmGasRegister(spec.srcConfig).CompileCosts(sdk.Context{}, spec.srcLen)
 				})
 				return
 			}
-			gotGas := NewWasmGasRegister(spec.srcConfig).CompileCosts(spec.srcLen)
+			gotGas := NewWasmGasRegister(spec.srcConfig).CompileCosts(sdk.Context{}, spec.srcLen)
 			assert.Equal(t, spec.exp, gotGas)
 		})
 	}
@@ -103,11 +103,11 @@ func TestNewContractInstanceCosts(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			if spec.expPanic {
 				assert.Panics(t, func() {
-					NewWasmGasRegister(spec.srcConfig).NewContractInstanceCosts(spec.pinned, spec.srcLen)
+					NewWasmGasRegister(spec.srcConfig).NewContractInstanceCosts(sdk.Context{}, spec.pinned, spec.srcLen)
 				})
 				return
 			}
-			gotGas := NewWasmGasRegister(spec.srcConfig).NewContractInstanceCosts(spec.pinned, spec.srcLen)
+			gotGas := NewWasmGasRegister(spec.srcConfig).NewContractInstanceCosts(sdk.Context{}, spec.pinned, spec.srcLen)
 			assert.Equal(t, spec.exp, gotGas)
 		})
 	}
@@ -166,11 +166,11 @@ func TestContractInstanceCosts(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			if spec.expPanic {
 				assert.Panics(t, func() {
-					NewWasmGasRegister(spec.srcConfig).InstantiateContractCosts(spec.pinned, spec.srcLen)
+					NewWasmGasRegister(spec.srcConfig).InstantiateContractCosts(sdk.Context{}, spec.pinned, spec.srcLen)
 				})
 				return
 			}
-			gotGas := NewWasmGasRegister(spec.srcConfig).InstantiateContractCosts(spec.pinned, spec.srcLen)
+			gotGas := NewWasmGasRegister(spec.srcConfig).InstantiateContractCosts(sdk.Context{}, spec.pinned, spec.srcLen)
 			assert.Equal(t, spec.exp, gotGas)
 		})
 	}
@@ -311,11 +311,11 @@ func TestReplyCost(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			if spec.expPanic {
 				assert.Panics(t, func() {
-					NewWasmGasRegister(spec.srcConfig).ReplyCosts(spec.pinned, spec.src)
+					NewWasmGasRegister(spec.srcConfig).ReplyCosts(sdk.Context{}, spec.pinned, spec.src)
 				})
 				return
 			}
-			gotGas := NewWasmGasRegister(spec.srcConfig).ReplyCosts(spec.pinned, spec.src)
+			gotGas := NewWasmGasRegister(spec.srcConfig).ReplyCosts(sdk.Context{}, spec.pinned, spec.src)
 			assert.Equal(t, spec.exp, gotGas)
 		})
 	}
@@ -342,7 +342,7 @@ func TestEventCosts(t *testing.T) {
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			gotGas := NewDefaultWasmGasRegister().EventCosts(spec.srcAttrs, spec.srcEvents)
+			gotGas := NewDefaultWasmGasRegister().EventCosts(sdk.Context{}, spec.srcAttrs, spec.srcEvents)
 			assert.Equal(t, spec.expGas, gotGas)
 		})
 	}
@@ -380,12 +380,12 @@ func TestToWasmVMGasConversion(t *testing.T) {
 			if spec.expPanic {
 				assert.Panics(t, func() {
 					r := NewWasmGasRegister(spec.srcConfig)
-					_ = r.ToWasmVMGas(spec.src)
+					_ = r.ToWasmVMGas(sdk.Context{}, spec.src)
 				})
 				return
 			}
 			r := NewWasmGasRegister(spec.srcConfig)
-			got := r.ToWasmVMGas(spec.src)
+			got := r.ToWasmVMGas(sdk.Context{}, spec.src)
 			assert.Equal(t, spec.exp, got)
 		})
 	}
@@ -423,12 +423,12 @@ func TestFromWasmVMGasConversion(t *testing.T) {
 			if spec.expPanic {
 				assert.Panics(t, func() {
 					r := NewWasmGasRegister(spec.srcConfig)
-					_ = r.FromWasmVMGas(spec.src)
+					_ = r.FromWasmVMGas(sdk.Context{}, spec.src)
 				})
 				return
 			}
 			r := NewWasmGasRegister(spec.srcConfig)
-			got := r.FromWasmVMGas(spec.src)
+			got := r.FromWasmVMGas(sdk.Context{}, spec.src)
 			assert.Equal(t, spec.exp, got)
 		})
 	}
@@ -463,11 +463,63 @@ func TestUncompressCosts(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			if spec.expPanic {
-				assert.Panics(t, func() { NewDefaultWasmGasRegister().UncompressCosts(spec.lenIn) })
+				assert.Panics(t, func() { NewDefaultWasmGasRegister().UncompressCosts(sdk.Context{}, spec.lenIn) })
 				return
 			}
-			got := NewDefaultWasmGasRegister().UncompressCosts(spec.lenIn)
+			got := NewDefaultWasmGasRegister().UncompressCosts(sdk.Context{}, spec.lenIn)
 			assert.Equal(t, spec.exp, got)
 		})
 	}
 }
+
+func TestParamsWasmGasRegister(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	r := k.gasRegister
+
+	// with default params
+	assert.Equal(t, DefaultCompileCost*100, r.CompileCosts(ctx, 100))
+	assert.Equal(t, DefaultInstanceCost, r.InstantiateContractCosts(ctx, false, 0))
+	assert.Equal(t, uint64(2*DefaultGasMultiplier), r.ToWasmVMGas(ctx, 2))
+
+	// when the params are changed
+	params := k.GetParams(ctx)
+	params.GasRegister.CompileCost = 7
+	params.GasRegister.InstanceCost = 1
+	params.GasRegister.GasMultiplier = 10
+	k.SetParams(ctx, params)
+
+	// then the new costs apply without a new register
+	assert.Equal(t, sdk.Gas(700), r.CompileCosts(ctx, 100))
+	assert.Equal(t, sdk.Gas(1), r.InstantiateContractCosts(ctx, false, 0))
+	assert.Equal(t, uint64(20), r.ToWasmVMGas(ctx, 2))
+	assert.Equal(t, sdk.Gas(2), r.FromWasmVMGas(ctx, 20))
+
+	// and a change that is reverted does not apply
+	revertedCtx, _ := ctx.CacheContext()
+	params.GasRegister.CompileCost = 9
+	k.SetParams(revertedCtx, params)
+	assert.Equal(t, sdk.Gas(900), r.CompileCosts(revertedCtx, 100))
+	assert.Equal(t, sdk.Gas(700), r.CompileCosts(ctx, 100))
+
+	// and reading the params does not consume gas
+	gasCtx := ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+	r.CompileCosts(gasCtx, 100)
+	assert.Equal(t, sdk.Gas(0), gasCtx.GasMeter().GasConsumed())
+}
+
+func TestParamsWasmGasRegisterReadsParamsOnEveryCall(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	r := NewParamsWasmGasRegister(k.paramSpace)
+	assert.Equal(t, DefaultCompileCost*100, r.CompileCosts(ctx, 100))
+
+	// when the params are changed in the subspace directly, as done by a parameter change proposal
+	p := types.DefaultGasRegisterParams()
+	p.CompileCost = 7
+	k.paramSpace.Set(ctx, types.ParamStoreKeyGasRegister, p)
+
+	// then the new costs apply within the same block
+	assert.Equal(t, sdk.Gas(700), r.CompileCosts(ctx, 100))
+	assert.Equal(t, sdk.Gas(700), r.CompileCosts(ctx.WithIsCheckTx(true), 100))
+}
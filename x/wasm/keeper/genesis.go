@@ -46,6 +46,11 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "contract number %d", i)
 		}
+		if contract.StorageQuota != nil {
+			if err := keeper.setContractStorageQuota(ctx, contractAddr, *contract.StorageQuota); err != nil {
+				return nil, sdkerrors.Wrapf(err, "storage quota of contract number %d", i)
+			}
+		}
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
 
@@ -110,11 +115,15 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		})
 		// redact contract info
 		contract.Created = nil
-		genState.Contracts = append(genState.Contracts, types.Contract{
+		genContract := types.Contract{
 			ContractAddress: addr.String(),
 			ContractInfo:    contract,
 			ContractState:   state,
-		})
+		}
+		if quota, ok := keeper.GetContractStorageQuota(ctx, addr); ok {
+			genContract.StorageQuota = &quota
+		}
+		genState.Contracts = append(genState.Contracts, genContract)
 		return false
 	})
 
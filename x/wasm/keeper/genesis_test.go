@@ -23,6 +23,7 @@ import (
 	"github.com/Finschia/finschia-sdk/store/prefix"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	authkeeper "github.com/Finschia/finschia-sdk/x/auth/keeper"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	bankpluskeeper "github.com/Finschia/finschia-sdk/x/bankplus/keeper"
 	distributionkeeper "github.com/Finschia/finschia-sdk/x/distribution/keeper"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
@@ -56,6 +57,7 @@ func TestGenesisExportImport(t *testing.T) {
 			history           []types.ContractCodeHistoryEntry
 			pinned            bool
 			contractExtension bool
+			storageQuota      *types.StorageQuota
 		)
 		f.Fuzz(&codeInfo)
 		f.Fuzz(&contract)
@@ -63,6 +65,7 @@ func TestGenesisExportImport(t *testing.T) {
 		f.NilChance(0).Fuzz(&history)
 		f.Fuzz(&pinned)
 		f.Fuzz(&contractExtension)
+		f.Fuzz(&storageQuota)
 
 		creatorAddr, err := sdk.AccAddressFromBech32(codeInfo.Creator)
 		require.NoError(t, err)
@@ -85,6 +88,9 @@ func TestGenesisExportImport(t *testing.T) {
 		wasmKeeper.storeContractInfo(srcCtx, contractAddr, &contract)
 		wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...)
 		wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
+		if storageQuota != nil {
+			require.NoError(t, wasmKeeper.setContractStorageQuota(srcCtx, contractAddr, *storageQuota))
+		}
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -121,6 +127,7 @@ func TestGenesisExportImport(t *testing.T) {
 		newHistory := x.ResetFromGenesis(dstCtx)
 		wasmKeeper.storeContractInfo(srcCtx, address, x)
 		wasmKeeper.addToContractCodeSecondaryIndex(srcCtx, address, newHistory)
+		wasmKeeper.addToContractCreatorSecondaryIndex(srcCtx, sdk.MustAccAddressFromBech32(x.Creator), newHistory.Updated, address)
 		wasmKeeper.appendToContractHistory(srcCtx, address, newHistory)
 		iter.Close()
 		return false
@@ -152,6 +159,46 @@ func TestGenesisExportImport(t *testing.T) {
 	}
 }
 
+func TestGenesisExportImportWithRemovedCode(t *testing.T) {
+	wasmKeeper, srcCtx, _ := setupKeeper(t)
+	contractKeeper := NewGovPermissionKeeper(wasmKeeper)
+	wasmKeeper.SetParams(srcCtx, types.DefaultParams())
+
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	creatorAddr := RandomAccountAddress(t)
+	for i := 0; i < 3; i++ {
+		_, _, err := contractKeeper.Create(srcCtx, creatorAddr, wasmCode, nil)
+		require.NoError(t, err)
+	}
+	require.NoError(t, contractKeeper.RemoveCode(srcCtx, 2, creatorAddr))
+	wasmKeeper.PruneRemovedCodes(srcCtx)
+
+	// export
+	exportedState := ExportGenesis(srcCtx, wasmKeeper)
+	require.Len(t, exportedState.Codes, 2)
+	assert.Equal(t, uint64(1), exportedState.Codes[0].CodeID)
+	assert.Equal(t, uint64(3), exportedState.Codes[1].CodeID)
+	exportedGenesis, err := wasmKeeper.cdc.MarshalJSON(exportedState)
+	require.NoError(t, err)
+
+	// re-import
+	dstKeeper, dstCtx, _ := setupKeeper(t)
+	var importState types.GenesisState
+	require.NoError(t, dstKeeper.cdc.UnmarshalJSON(exportedGenesis, &importState))
+	require.NoError(t, importState.ValidateBasic())
+	_, err = InitGenesis(dstCtx, dstKeeper, importState, &StakingKeeperMock{}, TestHandler(contractKeeper))
+	require.NoError(t, err)
+
+	assert.NotNil(t, dstKeeper.GetCodeInfo(dstCtx, 1))
+	assert.Nil(t, dstKeeper.GetCodeInfo(dstCtx, 2))
+	assert.NotNil(t, dstKeeper.GetCodeInfo(dstCtx, 3))
+	// and removed code ids are not reused
+	codeID, _, err := NewGovPermissionKeeper(dstKeeper).Create(dstCtx, creatorAddr, wasmCode, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), codeID)
+}
+
 func TestGenesisInit(t *testing.T) {
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
@@ -463,7 +510,19 @@ func TestImportContractWithCodeHistoryReset(t *testing.T) {
 		"code_upload_access": {
 			"permission": "Everybody"
 		},
-		"instantiate_default_permission": "Everybody"
+		"instantiate_default_permission": "Everybody",
+		"gas_register": {
+			"instance_cost": "60000",
+			"compile_cost": "3",
+			"uncompress_cost_numerator": "15",
+			"uncompress_cost_denominator": "100",
+			"gas_multiplier": "140000000",
+			"event_per_attribute_cost": "10",
+			"event_attribute_data_cost": "1",
+			"event_attribute_data_free_tier": "100",
+			"contract_message_data_cost": "0",
+			"custom_event_cost": "20"
+		}
 	},
   "codes": [
     {
@@ -686,6 +745,7 @@ func setupKeeper(t *testing.T) (*Keeper, sdk.Context, []sdk.StoreKey) {
 		tempDir,
 		wasmConfig,
 		AvailableCapabilities,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	return &srcKeeper, ctx, []sdk.StoreKey{keyWasm, keyParams}
 }
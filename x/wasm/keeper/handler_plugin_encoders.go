@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	codectypes "github.com/Finschia/finschia-sdk/codec/types"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/authz"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 	distributiontypes "github.com/Finschia/finschia-sdk/x/distribution/types"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
@@ -38,6 +40,9 @@ type MessageEncoders struct {
 	Stargate     func(sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) ([]sdk.Msg, error)
 	Wasm         func(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg, error)
 	Gov          func(sender sdk.AccAddress, msg *wasmvmtypes.GovMsg) ([]sdk.Msg, error)
+	// StargateFilter is called before a stargate message is encoded and for every message encoded by the custom
+	// encoder, and rejects it with an error. Optional
+	StargateFilter func(ctx sdk.Context, sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) error
 }
 
 func DefaultEncoders(unpacker codectypes.AnyUnpacker, portSource types.ICS20TransferPortSource) MessageEncoders {
@@ -81,6 +86,9 @@ func (e MessageEncoders) Merge(o *MessageEncoders) MessageEncoders {
 	if o.Gov != nil {
 		e.Gov = o.Gov
 	}
+	if o.StargateFilter != nil {
+		e.StargateFilter = o.StargateFilter
+	}
 	return e
 }
 
@@ -89,7 +97,15 @@ func (e MessageEncoders) Encode(ctx sdk.Context, contractAddr sdk.AccAddress, co
 	case msg.Bank != nil:
 		return e.Bank(contractAddr, msg.Bank)
 	case msg.Custom != nil:
-		return e.Custom(contractAddr, msg.Custom)
+		sdkMsgs, err := e.Custom(contractAddr, msg.Custom)
+		if err != nil {
+			return nil, err
+		}
+		// a message type that is denied for stargate messages must not come back in through a custom encoder
+		if err := e.filterCustomMsgs(ctx, contractAddr, sdkMsgs); err != nil {
+			return nil, err
+		}
+		return sdkMsgs, nil
 	case msg.Distribution != nil:
 		return e.Distribution(contractAddr, msg.Distribution)
 	case msg.IBC != nil:
@@ -97,7 +113,19 @@ func (e MessageEncoders) Encode(ctx sdk.Context, contractAddr sdk.AccAddress, co
 	case msg.Staking != nil:
 		return e.Staking(contractAddr, msg.Staking)
 	case msg.Stargate != nil:
-		return e.Stargate(contractAddr, msg.Stargate)
+		if e.StargateFilter != nil {
+			if err := e.StargateFilter(ctx, contractAddr, msg.Stargate); err != nil {
+				return nil, err
+			}
+		}
+		sdkMsgs, err := e.Stargate(contractAddr, msg.Stargate)
+		if err != nil {
+			return nil, err
+		}
+		if err := e.filterExecMsgs(ctx, contractAddr, sdkMsgs); err != nil {
+			return nil, err
+		}
+		return sdkMsgs, nil
 	case msg.Wasm != nil:
 		return e.Wasm(contractAddr, msg.Wasm)
 	case msg.Gov != nil:
@@ -129,6 +157,22 @@ func NoCustomMsg(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error)
 	return nil, sdkerrors.Wrap(types.ErrUnknownMsg, "custom variant not supported")
 }
 
+// ChainCustomEncoders combines custom encoders into one. The encoders are called in order until one of them
+// does not fail with ErrUnknownMsg.
+func ChainCustomEncoders(encoders ...CustomEncoder) CustomEncoder {
+	return func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+		err := sdkerrors.Wrap(types.ErrUnknownMsg, "custom variant not supported")
+		for _, encode := range encoders {
+			var sdkMsgs []sdk.Msg
+			sdkMsgs, err = encode(sender, msg)
+			if !errors.Is(err, types.ErrUnknownMsg) {
+				return sdkMsgs, err
+			}
+		}
+		return nil, err
+	}
+}
+
 func EncodeDistributionMsg(sender sdk.AccAddress, msg *wasmvmtypes.DistributionMsg) ([]sdk.Msg, error) {
 	switch {
 	case msg.SetWithdrawAddress != nil:
@@ -207,6 +251,69 @@ func EncodeStargateMsg(unpacker codectypes.AnyUnpacker) StargateEncoder {
 	}
 }
 
+type stargateMsgFilterSource interface {
+	GetStargateMsgFilter(ctx sdk.Context) types.StargateMsgFilter
+}
+
+// StargateMsgFilterChecker rejects the stargate messages that are not allowed by the filter of the source.
+// The size is checked before the message is unpacked.
+func StargateMsgFilterChecker(source stargateMsgFilterSource) func(ctx sdk.Context, sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) error {
+	return func(ctx sdk.Context, sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) error {
+		return source.GetStargateMsgFilter(ctx).Accept(msg.TypeURL, len(msg.Value))
+	}
+}
+
+// filterCustomMsgs applies the stargate filter to the messages encoded by a custom encoder and to the
+// messages they execute by authz MsgExec
+func (e MessageEncoders) filterCustomMsgs(ctx sdk.Context, sender sdk.AccAddress, msgs []sdk.Msg) error {
+	if e.StargateFilter == nil {
+		return nil
+	}
+	for _, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidMsg, err.Error())
+		}
+		if err := e.StargateFilter(ctx, sender, &wasmvmtypes.StargateMsg{TypeURL: any.TypeUrl, Value: any.Value}); err != nil {
+			return sdkerrors.Wrap(err, "custom")
+		}
+	}
+	return e.filterExecMsgs(ctx, sender, msgs)
+}
+
+// filterExecMsgs applies the stargate filter to the messages executed by authz MsgExec, including nested ones,
+// so that the filter can not be bypassed by wrapping a message
+func (e MessageEncoders) filterExecMsgs(ctx sdk.Context, sender sdk.AccAddress, msgs []sdk.Msg) error {
+	if e.StargateFilter == nil {
+		return nil
+	}
+	for _, msg := range msgs {
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			continue
+		}
+		for _, any := range exec.Msgs {
+			if any == nil {
+				return sdkerrors.Wrap(types.ErrInvalidMsg, "empty authz exec message")
+			}
+			if err := e.StargateFilter(ctx, sender, &wasmvmtypes.StargateMsg{TypeURL: any.TypeUrl, Value: any.Value}); err != nil {
+				return sdkerrors.Wrap(err, "authz exec")
+			}
+			if any.TypeUrl != sdk.MsgTypeURL(&authz.MsgExec{}) {
+				continue
+			}
+			nested, ok := any.GetCachedValue().(*authz.MsgExec)
+			if !ok {
+				return sdkerrors.Wrap(types.ErrInvalidMsg, "authz exec message not unpacked")
+			}
+			if err := e.filterExecMsgs(ctx, sender, []sdk.Msg{nested}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func EncodeWasmMsg(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg, error) {
 	switch {
 	case msg.Execute != nil:
@@ -313,6 +420,48 @@ func EncodeGovMsg(sender sdk.AccAddress, msg *wasmvmtypes.GovMsg) ([]sdk.Msg, er
 	return []sdk.Msg{vote}, nil
 }
 
+// wasmVoteOptions maps the vote options of the wasmvm VoteMsg to the gov options
+var wasmVoteOptions = map[string]govtypes.VoteOption{
+	"yes":          govtypes.OptionYes,
+	"no":           govtypes.OptionNo,
+	"abstain":      govtypes.OptionAbstain,
+	"no_with_veto": govtypes.OptionNoWithVeto,
+}
+
+// EncodeGovCustomMsg encodes the custom messages of contracts for weighted votes and deposits
+// that are not supported by the wasmvm GovMsg.
+func EncodeGovCustomMsg(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	var custom types.GovCustomMsg
+	if err := json.Unmarshal(msg, &custom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	switch {
+	case custom.Gov != nil && custom.Gov.VoteWeighted != nil:
+		options := make(govtypes.WeightedVoteOptions, len(custom.Gov.VoteWeighted.Options))
+		for i, o := range custom.Gov.VoteWeighted.Options {
+			option, ok := wasmVoteOptions[o.Option]
+			if !ok {
+				return nil, sdkerrors.Wrapf(govtypes.ErrInvalidVote, "unknown vote option: %s", o.Option)
+			}
+			weight, err := sdk.NewDecFromStr(o.Weight)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(govtypes.ErrInvalidVote, "weight of %s: %s", o.Option, err)
+			}
+			options[i] = govtypes.WeightedVoteOption{Option: option, Weight: weight}
+		}
+		vote := govtypes.NewMsgVoteWeighted(sender, custom.Gov.VoteWeighted.ProposalID, options)
+		return []sdk.Msg{vote}, nil
+	case custom.Gov != nil && custom.Gov.Deposit != nil:
+		amount, err := ConvertWasmCoinsToSdkCoins(custom.Gov.Deposit.Amount)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "amount")
+		}
+		deposit := govtypes.NewMsgDeposit(sender, custom.Gov.Deposit.ProposalID, amount.Sort())
+		return []sdk.Msg{deposit}, nil
+	}
+	return nil, sdkerrors.Wrap(types.ErrUnknownMsg, "unknown variant of Custom")
+}
+
 // ConvertWasmIBCTimeoutHeightToCosmosHeight converts a wasmvm type ibc timeout height to ibc module type height
 func ConvertWasmIBCTimeoutHeightToCosmosHeight(ibcTimeoutBlock *wasmvmtypes.IBCTimeoutBlock) ibcclienttypes.Height {
 	if ibcTimeoutBlock == nil {
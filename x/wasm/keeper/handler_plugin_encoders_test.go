@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -9,6 +10,8 @@ import (
 
 	codectypes "github.com/Finschia/finschia-sdk/codec/types"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/authz"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 	distributiontypes "github.com/Finschia/finschia-sdk/x/distribution/types"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
@@ -581,6 +584,280 @@ func TestEncoding(t *testing.T) {
 	}
 }
 
+func TestEncodeStargateMsgWithFilter(t *testing.T) {
+	addr1 := RandomAccountAddress(t)
+	addr2 := RandomAccountAddress(t)
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: addr2.String(),
+		ToAddress:   addr1.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 12345)),
+	}
+	bankMsgBin, err := proto.Marshal(bankMsg)
+	require.NoError(t, err)
+	srcMsg := wasmvmtypes.CosmosMsg{
+		Stargate: &wasmvmtypes.StargateMsg{
+			TypeURL: "/cosmos.bank.v1beta1.MsgSend",
+			Value:   bankMsgBin,
+		},
+	}
+
+	specs := map[string]struct {
+		filter types.StargateMsgFilter
+		expErr *sdkerrors.Error
+	}{
+		"no restriction": {},
+		"in accept list": {
+			filter: types.StargateMsgFilter{AcceptList: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		},
+		"not in accept list": {
+			filter: types.StargateMsgFilter{AcceptList: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+		"not in deny list": {
+			filter: types.StargateMsgFilter{DenyList: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+		},
+		"in deny list": {
+			filter: types.StargateMsgFilter{DenyList: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+		"within max size": {
+			filter: types.StargateMsgFilter{MaxMsgSize: uint64(len(bankMsgBin))},
+		},
+		"exceeds max size": {
+			filter: types.StargateMsgFilter{MaxMsgSize: uint64(len(bankMsgBin) - 1)},
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+	}
+	encodingConfig := MakeEncodingConfig(t)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var ctx sdk.Context
+			source := stargateMsgFilterSourceFn(func(ctx sdk.Context) types.StargateMsgFilter {
+				return spec.filter
+			})
+			encoder := DefaultEncoders(encodingConfig.Marshaler, nil).Merge(&MessageEncoders{
+				StargateFilter: StargateMsgFilterChecker(source),
+			})
+			res, gotErr := encoder.Encode(ctx, addr2, "", srcMsg)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, []sdk.Msg{bankMsg}, res)
+		})
+	}
+}
+
+func TestEncodeStargateAuthzExecWithFilter(t *testing.T) {
+	addr1 := RandomAccountAddress(t)
+	addr2 := RandomAccountAddress(t)
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: addr2.String(),
+		ToAddress:   addr1.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 12345)),
+	}
+	execMsg := authz.NewMsgExec(addr1, []sdk.Msg{bankMsg})
+	nestedExecMsg := authz.NewMsgExec(addr1, []sdk.Msg{&execMsg})
+	toStargate := func(msg sdk.Msg) wasmvmtypes.CosmosMsg {
+		bz, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		return wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{TypeURL: sdk.MsgTypeURL(msg), Value: bz}}
+	}
+	execTypeURL, sendTypeURL := sdk.MsgTypeURL(&execMsg), sdk.MsgTypeURL(bankMsg)
+
+	specs := map[string]struct {
+		src    wasmvmtypes.CosmosMsg
+		filter types.StargateMsgFilter
+		expErr *sdkerrors.Error
+	}{
+		"exec no restriction": {
+			src: toStargate(&execMsg),
+		},
+		"exec inner msg in deny list": {
+			src:    toStargate(&execMsg),
+			filter: types.StargateMsgFilter{DenyList: []string{sendTypeURL}},
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+		"exec inner msg not in accept list": {
+			src:    toStargate(&execMsg),
+			filter: types.StargateMsgFilter{AcceptList: []string{execTypeURL}},
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+		"exec inner msg in accept list": {
+			src:    toStargate(&execMsg),
+			filter: types.StargateMsgFilter{AcceptList: []string{execTypeURL, sendTypeURL}},
+		},
+		"nested exec inner msg in deny list": {
+			src:    toStargate(&nestedExecMsg),
+			filter: types.StargateMsgFilter{DenyList: []string{sendTypeURL}},
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+		"nested exec inner msg not in accept list": {
+			src:    toStargate(&nestedExecMsg),
+			filter: types.StargateMsgFilter{AcceptList: []string{execTypeURL}},
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+		"nested exec inner msg in accept list": {
+			src:    toStargate(&nestedExecMsg),
+			filter: types.StargateMsgFilter{AcceptList: []string{execTypeURL, sendTypeURL}},
+		},
+	}
+	encodingConfig := MakeEncodingConfig(t)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var ctx sdk.Context
+			source := stargateMsgFilterSourceFn(func(ctx sdk.Context) types.StargateMsgFilter {
+				return spec.filter
+			})
+			encoder := DefaultEncoders(encodingConfig.Marshaler, nil).Merge(&MessageEncoders{
+				StargateFilter: StargateMsgFilterChecker(source),
+			})
+			res, gotErr := encoder.Encode(ctx, addr2, "", spec.src)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, res, 1)
+		})
+	}
+}
+
+type stargateMsgFilterSourceFn func(ctx sdk.Context) types.StargateMsgFilter
+
+func (f stargateMsgFilterSourceFn) GetStargateMsgFilter(ctx sdk.Context) types.StargateMsgFilter {
+	return f(ctx)
+}
+
+func TestEncodeGovCustomMsg(t *testing.T) {
+	addr1 := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		src    string
+		exp    []sdk.Msg
+		expErr *sdkerrors.Error
+	}{
+		"vote weighted": {
+			src: `{"gov":{"vote_weighted":{"proposal_id":1,"options":[{"option":"yes","weight":"0.7"},{"option":"no_with_veto","weight":"0.3"}]}}}`,
+			exp: []sdk.Msg{&govtypes.MsgVoteWeighted{
+				ProposalId: 1,
+				Voter:      addr1.String(),
+				Options: govtypes.WeightedVoteOptions{
+					{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(7, 1)},
+					{Option: govtypes.OptionNoWithVeto, Weight: sdk.NewDecWithPrec(3, 1)},
+				},
+			}},
+		},
+		"vote weighted all options": {
+			src: `{"gov":{"vote_weighted":{"proposal_id":2,"options":[{"option":"no","weight":"0.25"},{"option":"abstain","weight":"0.75"}]}}}`,
+			exp: []sdk.Msg{&govtypes.MsgVoteWeighted{
+				ProposalId: 2,
+				Voter:      addr1.String(),
+				Options: govtypes.WeightedVoteOptions{
+					{Option: govtypes.OptionNo, Weight: sdk.NewDecWithPrec(25, 2)},
+					{Option: govtypes.OptionAbstain, Weight: sdk.NewDecWithPrec(75, 2)},
+				},
+			}},
+		},
+		"vote weighted unknown option": {
+			src:    `{"gov":{"vote_weighted":{"proposal_id":1,"options":[{"option":"maybe","weight":"1"}]}}}`,
+			expErr: govtypes.ErrInvalidVote,
+		},
+		"vote weighted invalid weight": {
+			src:    `{"gov":{"vote_weighted":{"proposal_id":1,"options":[{"option":"yes","weight":"half"}]}}}`,
+			expErr: govtypes.ErrInvalidVote,
+		},
+		"deposit": {
+			src: `{"gov":{"deposit":{"proposal_id":3,"amount":[{"denom":"utgd","amount":"200"},{"denom":"uatom","amount":"100"}]}}}`,
+			exp: []sdk.Msg{&govtypes.MsgDeposit{
+				ProposalId: 3,
+				Depositor:  addr1.String(),
+				Amount:     sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("utgd", 200)),
+			}},
+		},
+		"deposit invalid amount": {
+			src:    `{"gov":{"deposit":{"proposal_id":3,"amount":[{"denom":"uatom","amount":"one"}]}}}`,
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"unknown gov variant": {
+			src:    `{"gov":{}}`,
+			expErr: types.ErrUnknownMsg,
+		},
+		"unknown custom variant": {
+			src:    `{"foo":{}}`,
+			expErr: types.ErrUnknownMsg,
+		},
+		"invalid json": {
+			src:    `not json`,
+			expErr: sdkerrors.ErrJSONUnmarshal,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			res, gotErr := EncodeGovCustomMsg(addr1, []byte(spec.src))
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, res)
+		})
+	}
+}
+
+func TestChainCustomEncoders(t *testing.T) {
+	addr1 := RandomAccountAddress(t)
+	myMsg := &govtypes.MsgVote{Voter: addr1.String()}
+	myErr := sdkerrors.ErrInvalidRequest
+	unknown := CustomEncoder(NoCustomMsg)
+	encodes := func(msg sdk.Msg) CustomEncoder {
+		return func(sender sdk.AccAddress, _ json.RawMessage) ([]sdk.Msg, error) {
+			return []sdk.Msg{msg}, nil
+		}
+	}
+	fails := func(sender sdk.AccAddress, _ json.RawMessage) ([]sdk.Msg, error) {
+		return nil, myErr
+	}
+
+	specs := map[string]struct {
+		encoders []CustomEncoder
+		exp      []sdk.Msg
+		expErr   *sdkerrors.Error
+	}{
+		"first encodes": {
+			encoders: []CustomEncoder{encodes(myMsg), fails},
+			exp:      []sdk.Msg{myMsg},
+		},
+		"unknown msg falls through": {
+			encoders: []CustomEncoder{unknown, encodes(myMsg)},
+			exp:      []sdk.Msg{myMsg},
+		},
+		"other error stops": {
+			encoders: []CustomEncoder{unknown, fails, encodes(myMsg)},
+			expErr:   myErr,
+		},
+		"all unknown": {
+			encoders: []CustomEncoder{unknown, unknown},
+			expErr:   types.ErrUnknownMsg,
+		},
+		"no encoders": {
+			expErr: types.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			res, gotErr := ChainCustomEncoders(spec.encoders...)(addr1, []byte(`{}`))
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, res)
+		})
+	}
+}
+
 func TestConvertWasmCoinToSdkCoin(t *testing.T) {
 	specs := map[string]struct {
 		src    wasmvmtypes.Coin
@@ -3,8 +3,10 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math"
 	"path/filepath"
@@ -64,6 +66,40 @@ type AccountPruner interface {
 	CleanupExistingAccount(ctx sdk.Context, existingAccount authtypes.AccountI) (handled bool, err error)
 }
 
+// EntryPoint names the contract entry point that is about to be called by the wasm VM
+type EntryPoint string
+
+const (
+	EntryPointInstantiate       EntryPoint = "instantiate"
+	EntryPointExecute           EntryPoint = "execute"
+	EntryPointMigrate           EntryPoint = "migrate"
+	EntryPointSudo              EntryPoint = "sudo"
+	EntryPointReply             EntryPoint = "reply"
+	EntryPointQuery             EntryPoint = "query"
+	EntryPointIBCChannelOpen    EntryPoint = "ibc_channel_open"
+	EntryPointIBCChannelConnect EntryPoint = "ibc_channel_connect"
+	EntryPointIBCChannelClose   EntryPoint = "ibc_channel_close"
+	EntryPointIBCPacketReceive  EntryPoint = "ibc_packet_receive"
+	EntryPointIBCPacketAck      EntryPoint = "ibc_packet_ack"
+	EntryPointIBCPacketTimeout  EntryPoint = "ibc_packet_timeout"
+)
+
+// ContractAccessChecker is an extension point to reject calls into a contract before the wasm VM is invoked.
+// It is consulted for every entry point, including calls from submessages, IBC callbacks and smart queries.
+// A migration is checked for the migrate entry point of the current code and the instantiate entry point of the new code.
+type ContractAccessChecker interface {
+	// CheckContractAccess returns an error when the entry point of the contract instance of the given code id must
+	// not be called.
+	CheckContractAccess(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64, entryPoint EntryPoint) error
+}
+
+// allowAllContractAccess is the default ContractAccessChecker that does not restrict any call
+type allowAllContractAccess struct{}
+
+func (allowAllContractAccess) CheckContractAccess(sdk.Context, sdk.AccAddress, uint64, EntryPoint) error {
+	return nil
+}
+
 // WasmVMResponseHandler is an extension point to handles the response data returned by a contract call.
 type WasmVMResponseHandler interface {
 	// Handle processes the data returned by a contract invocation.
@@ -96,12 +132,22 @@ type Keeper struct {
 	messenger             Messenger
 	metrics               *Metrics
 	// queryGasLimit is the max wasmvm gas that can be spent on executing a query with a contract
-	queryGasLimit        uint64
+	queryGasLimit uint64
+	// simulationGasLimit is the max gas that can be spent on a simulation, optional
+	simulationGasLimit   *uint64
 	paramSpace           paramtypes.Subspace
 	gasRegister          GasRegister
 	maxQueryStackSize    uint32
 	acceptedAccountTypes map[reflect.Type]struct{}
 	accountPruner        AccountPruner
+	accessChecker        ContractAccessChecker
+	// apiCosts overwrites the address conversion costs of the api when set
+	apiCosts *apiCosts
+	// stargateMsgFilter restricts the stargate messages of contracts when no filter is set in the params
+	stargateMsgFilter types.StargateMsgFilter
+	// the address capable of executing privileged messages like MsgUpdateParams, MsgSudoContract or MsgPinCodes.
+	// typically, this should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new contract Keeper instance
@@ -123,9 +169,10 @@ func NewKeeper(
 	homeDir string,
 	wasmConfig types.WasmConfig,
 	availableCapabilities string,
+	authority string,
 	opts ...Option,
 ) Keeper {
-	wasmer, err := wasmvm.NewVM(filepath.Join(homeDir, "wasm"), availableCapabilities, contractMemoryLimit, wasmConfig.ContractDebugMode, wasmConfig.MemoryCacheSize)
+	wasmer, err := newWasmVMEngine(filepath.Join(homeDir, "wasm"), availableCapabilities, contractMemoryLimit, wasmConfig.ContractDebugMode, wasmConfig.MemoryCacheSize)
 	if err != nil {
 		panic(err)
 	}
@@ -141,17 +188,22 @@ func NewKeeper(
 		accountKeeper:        accountKeeper,
 		bank:                 NewBankCoinTransferrer(bankKeeper),
 		accountPruner:        NewVestingCoinBurner(bankKeeper),
+		accessChecker:        allowAllContractAccess{},
 		portKeeper:           portKeeper,
 		capabilityKeeper:     capabilityKeeper,
-		messenger:            NewDefaultMessageHandler(router, channelKeeper, capabilityKeeper, bankKeeper, cdc, portSource),
 		queryGasLimit:        wasmConfig.SmartQueryGasLimit,
+		simulationGasLimit:   wasmConfig.SimulationGasLimit,
 		paramSpace:           paramSpace,
 		metrics:              NopMetrics(),
-		gasRegister:          NewDefaultWasmGasRegister(),
+		gasRegister:          NewParamsWasmGasRegister(paramSpace),
 		maxQueryStackSize:    types.DefaultMaxQueryStackSize,
 		acceptedAccountTypes: defaultAcceptedAccountTypes,
+		stargateMsgFilter:    types.StargateMsgFilter{MaxMsgSize: types.DefaultMaxStargateMsgSize},
+		authority:            authority,
 	}
-	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, queryRouter, keeper)
+	keeper.messenger = NewDefaultMessageHandler(router, channelKeeper, capabilityKeeper, bankKeeper, cdc, portSource,
+		&MessageEncoders{StargateFilter: StargateMsgFilterChecker(keeper)})
+	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, queryRouter, keeper, cdc)
 	for _, o := range opts {
 		o.apply(keeper)
 	}
@@ -183,7 +235,70 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
-func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, checksum []byte, err error) {
+// GetStargateQueryAcceptList returns the stargate queries that contracts are allowed to execute
+func (k Keeper) GetStargateQueryAcceptList(ctx sdk.Context) []types.AcceptedStargateQuery {
+	var acceptList []types.AcceptedStargateQuery
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyStargateQueryAcceptList, &acceptList)
+	return acceptList
+}
+
+// GetStargateMsgFilter returns the effective filter for the stargate messages dispatched by contracts.
+// A message is accepted only when the filters of the app wiring and of the params both accept it. The max size set
+// in the params replaces the one of the app wiring.
+func (k Keeper) GetStargateMsgFilter(ctx sdk.Context) types.StargateMsgFilter {
+	var params types.StargateMsgFilter
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyStargateMsgFilter, &params)
+	wiring := k.stargateMsgFilter
+
+	filter := types.StargateMsgFilter{MaxMsgSize: wiring.MaxMsgSize}
+	if params.MaxMsgSize != 0 {
+		filter.MaxMsgSize = params.MaxMsgSize
+	}
+	filter.DenyList = appendMissing(filter.DenyList, wiring.DenyList...)
+	filter.DenyList = appendMissing(filter.DenyList, params.DenyList...)
+	switch {
+	case len(wiring.AcceptList) == 0:
+		filter.AcceptList = params.AcceptList
+	case len(params.AcceptList) == 0:
+		filter.AcceptList = wiring.AcceptList
+	default:
+		// the type urls of the app wiring that the params do not accept are denied, so that an empty
+		// intersection of both accept lists rejects all messages
+		filter.AcceptList = wiring.AcceptList
+		for _, typeURL := range wiring.AcceptList {
+			if !containsString(params.AcceptList, typeURL) {
+				filter.DenyList = appendMissing(filter.DenyList, typeURL)
+			}
+		}
+	}
+	return filter
+}
+
+// appendMissing appends the strings that are not contained in the list yet
+func appendMissing(list []string, strs ...string) []string {
+	for _, s := range strs {
+		if !containsString(list, s) {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAuthority returns the x/wasm module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, source, builder string, codeHash []byte, authZ AuthorizationPolicy) (codeID uint64, checksum []byte, err error) {
 	if creator == nil {
 		return 0, checksum, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot be nil")
 	}
@@ -201,14 +316,20 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	}
 
 	if ioutils.IsGzip(wasmCode) {
-		ctx.GasMeter().ConsumeGas(k.gasRegister.UncompressCosts(len(wasmCode)), "Uncompress gzip bytecode")
+		ctx.GasMeter().ConsumeGas(k.gasRegister.UncompressCosts(ctx, len(wasmCode)), "Uncompress gzip bytecode")
 		wasmCode, err = ioutils.Uncompress(wasmCode, uint64(types.MaxWasmSize))
 		if err != nil {
 			return 0, checksum, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 		}
 	}
 
-	ctx.GasMeter().ConsumeGas(k.gasRegister.CompileCosts(len(wasmCode)), "Compiling wasm bytecode")
+	// verify the code hash before the code is compiled and persisted by the wasm VM
+	if len(codeHash) != 0 {
+		if hash := sha256.Sum256(wasmCode); !bytes.Equal(hash[:], codeHash) {
+			return 0, checksum, sdkerrors.Wrapf(types.ErrInvalid, "code hash %X does not match checksum %X", codeHash, hash)
+		}
+	}
+	ctx.GasMeter().ConsumeGas(k.gasRegister.CompileCosts(ctx, len(wasmCode)), "Compiling wasm bytecode")
 	checksum, err = k.wasmVM.Create(wasmCode)
 	if err != nil {
 		return 0, checksum, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
@@ -220,7 +341,10 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	codeID = k.autoIncrementID(ctx, types.KeyLastCodeID)
 	k.Logger(ctx).Debug("storing new contract", "capabilities", report.RequiredCapabilities, "code_id", codeID)
 	codeInfo := types.NewCodeInfo(checksum, creator, *instantiateAccess)
+	codeInfo.Source = source
+	codeInfo.Builder = builder
 	k.storeCodeInfo(ctx, codeID, codeInfo)
+	k.addToCodeChecksumSecondaryIndex(ctx, checksum, codeID)
 
 	evt := sdk.NewEvent(
 		types.EventTypeStoreCode,
@@ -264,9 +388,45 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(key, k.cdc.MustMarshal(&codeInfo))
+	k.addToCodeChecksumSecondaryIndex(ctx, codeInfo.CodeHash, codeID)
 	return nil
 }
 
+// addToCodeChecksumSecondaryIndex adds element to the index for code-ids-by-checksum queries
+func (k Keeper) addToCodeChecksumSecondaryIndex(ctx sdk.Context, checksum []byte, codeID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	// store 1 byte to not run into `nil` debugging issues
+	store.Set(types.GetCodeIDByChecksumSecondaryIndexKey(checksum, codeID), []byte{1})
+}
+
+// removeFromCodeChecksumSecondaryIndex removes element from the index for code-ids-by-checksum queries
+func (k Keeper) removeFromCodeChecksumSecondaryIndex(ctx sdk.Context, checksum []byte, codeID uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetCodeIDByChecksumSecondaryIndexKey(checksum, codeID))
+}
+
+// IterateCodeIDsByChecksum iterates over all code ids with the given checksum ASC on code id.
+func (k Keeper) IterateCodeIDsByChecksum(ctx sdk.Context, checksum []byte, cb func(codeID uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCodeIDsByChecksumPrefix(checksum))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.BigEndianToUint64(iter.Key())) {
+			return
+		}
+	}
+}
+
+// hasCodeWithChecksum returns true when any code id references the given checksum
+func (k Keeper) hasCodeWithChecksum(ctx sdk.Context, checksum []byte) bool {
+	var found bool
+	k.IterateCodeIDsByChecksum(ctx, checksum, func(uint64) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
 func (k Keeper) instantiate(
 	ctx sdk.Context,
 	codeID uint64,
@@ -276,13 +436,13 @@ func (k Keeper) instantiate(
 	deposit sdk.Coins,
 	addressGenerator AddressGenerator,
 	authPolicy AuthorizationPolicy,
-) (sdk.AccAddress, []byte, error) {
+) (_ sdk.AccAddress, _ []byte, err error) {
 	defer func(begin time.Time) { k.metrics.InstantiateElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
 
 	if creator == nil {
 		return nil, nil, types.ErrEmpty.Wrap("creator")
 	}
-	instanceCosts := k.gasRegister.NewContractInstanceCosts(k.IsPinnedCode(ctx, codeID), len(initMsg))
+	instanceCosts := k.gasRegister.NewContractInstanceCosts(ctx, k.IsPinnedCode(ctx, codeID), len(initMsg))
 	ctx.GasMeter().ConsumeGas(instanceCosts, "Loading CosmWasm module: instantiate")
 
 	// get contact info
@@ -295,9 +455,13 @@ func (k Keeper) instantiate(
 	}
 
 	contractAddress := addressGenerator(ctx, codeID, codeInfo.CodeHash)
+	defer traceFrame(ctx, contractTraceFrame(EntryPointInstantiate, contractAddress, codeID))(&err)
 	if k.HasContractInfo(ctx, contractAddress) {
 		return nil, nil, types.ErrDuplicate.Wrap("instance with this code id, sender and label exists: try a different label")
 	}
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddress, codeID, EntryPointInstantiate); err != nil {
+		return nil, nil, err
+	}
 
 	// check account
 	// every cosmos module can define custom account types when needed. The cosmos-sdk comes with extension points
@@ -344,19 +508,21 @@ func (k Keeper) instantiate(
 
 	// create prefixed data store
 	// 0x03 | BuildContractAddressClassic (sdk.AccAddress)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	prefixStore := k.contractStore(ctx, contractAddress, codeID)
 
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 
 	// instantiate wasm contract
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, prefixStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if err != nil {
 		return nil, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return nil, nil, err
+	}
 
 	// persist instance first
 	createdAt := types.NewAbsoluteTxPosition(ctx)
@@ -379,6 +545,7 @@ func (k Keeper) instantiate(
 	// store contract before dispatch so that contract could be called back
 	historyEntry := contractInfo.InitialHistory(initMsg)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
+	k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.storeContractInfo(ctx, contractAddress, &contractInfo)
 
@@ -397,14 +564,18 @@ func (k Keeper) instantiate(
 }
 
 // Execute executes the contract instance
-func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (_ []byte, err error) {
 	defer func(begin time.Time) { k.metrics.ExecuteElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	defer traceFrame(ctx, contractTraceFrame(EntryPointExecute, contractAddress, contractInfo.CodeID))(&err)
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddress, contractInfo.CodeID, EntryPointExecute); err != nil {
+		return nil, err
+	}
 
-	executeCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
+	executeCosts := k.gasRegister.InstantiateContractCosts(ctx, k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(executeCosts, "Loading CosmWasm module: execute")
 
 	// add more funds
@@ -420,11 +591,14 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeExecute,
@@ -439,23 +613,126 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	return data, nil
 }
 
-func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) ([]byte, error) {
+var _ types.SimulationKeeper = Keeper{}
+
+// SimulateExecute executes the contract like execute but on a cache context whose writes are never committed.
+// The gas is limited by the node's simulation gas limit, the max block gas or the smart query gas limit
+// when none of them is set, and by the remaining gas of the context. The gas used is charged to the context.
+// The simulation depends on the node's config and is therefore rejected in the deliver tx path.
+// With trace set, the call tree of the execution is recorded and a failed execution is returned with the
+// unredacted error in the response instead of an error result.
+func (k Keeper) SimulateExecute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, trace bool) (*types.QuerySimulateExecuteContractResponse, error) {
+	if !ctx.IsCheckTx() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "simulation is not allowed in the deliver tx path")
+	}
+	gasLimit := k.simulationGasLimitFor(ctx)
+	if parentLimit := ctx.GasMeter().Limit(); parentLimit > 0 {
+		if remaining := parentLimit - ctx.GasMeter().GasConsumedToLimit(); remaining < gasLimit {
+			gasLimit = remaining
+		}
+	}
+	cacheCtx, _ := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)).
+		WithEventManager(sdk.NewEventManager()).
+		CacheContext()
+	// make sure we charge the caller even on panic
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "simulate execute")
+	}()
+	var tracer *types.CallTreeTracer
+	if trace {
+		tracer = types.NewCallTreeTracer()
+		cacheCtx = types.WithTracer(cacheCtx, tracer)
+	}
+
+	// record the sub messages of the simulated contract only, nested calls are dispatched with the original keeper
+	recorder := &subMsgRecorder{next: k.wasmVMResponseHandler}
+	simulator := k
+	simulator.wasmVMResponseHandler = recorder
+
+	data, err := simulator.execute(cacheCtx, contractAddress, caller, msg, coins)
+	switch {
+	case err != nil && !trace:
+		return nil, err
+	case err != nil:
+		return &types.QuerySimulateExecuteContractResponse{
+			GasUsed: cacheCtx.GasMeter().GasConsumed(),
+			Trace:   tracer.Frames(),
+			Error:   err.Error(),
+		}, nil
+	}
+	rsp := &types.QuerySimulateExecuteContractResponse{
+		Data:     data,
+		Events:   cacheCtx.EventManager().ABCIEvents(),
+		GasUsed:  cacheCtx.GasMeter().GasConsumed(),
+		Messages: recorder.messages,
+	}
+	if trace {
+		rsp.Trace = tracer.Frames()
+	}
+	return rsp, nil
+}
+
+func (k Keeper) simulationGasLimitFor(ctx sdk.Context) sdk.Gas {
+	if k.simulationGasLimit != nil {
+		return *k.simulationGasLimit
+	}
+	if maxGas := ctx.ConsensusParams().GetBlock().GetMaxGas(); maxGas > 0 {
+		return sdk.Gas(maxGas)
+	}
+	return k.queryGasLimit
+}
+
+// subMsgRecorder records the sub messages that are passed to the response handler
+type subMsgRecorder struct {
+	next     WasmVMResponseHandler
+	messages []types.DispatchedSubMsg
+}
+
+func (r *subMsgRecorder) Handle(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, messages []wasmvmtypes.SubMsg, origRspData []byte) ([]byte, error) {
+	for _, m := range messages {
+		bz, err := json.Marshal(m.Msg)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		var gasLimit uint64
+		if m.GasLimit != nil {
+			gasLimit = *m.GasLimit
+		}
+		r.messages = append(r.messages, types.DispatchedSubMsg{
+			ID:       m.ID,
+			ReplyOn:  m.ReplyOn.String(),
+			GasLimit: gasLimit,
+			Msg:      bz,
+		})
+	}
+	return r.next.Handle(ctx, contractAddr, ibcPort, messages, origRspData)
+}
+
+func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (_ []byte, err error) {
 	defer func(begin time.Time) { k.metrics.MigrateElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
-	migrateSetupCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, newCodeID), len(msg))
+	migrateSetupCosts := k.gasRegister.InstantiateContractCosts(ctx, k.IsPinnedCode(ctx, newCodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(migrateSetupCosts, "Loading CosmWasm module: migrate")
 
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
 	if contractInfo == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
 	}
+	defer traceFrame(ctx, contractTraceFrame(EntryPointMigrate, contractAddress, newCodeID))(&err)
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not migrate")
 	}
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddress, contractInfo.CodeID, EntryPointMigrate); err != nil {
+		return nil, err
+	}
 
 	newCodeInfo := k.GetCodeInfo(ctx, newCodeID)
 	if newCodeInfo == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
 	}
+	// the contract is brought onto the new code like a new instance of it
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddress, newCodeID, EntryPointInstantiate); err != nil {
+		return nil, err
+	}
 
 	if !authZ.CanInstantiateContract(newCodeInfo.InstantiateConfig, caller) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "to use new code")
@@ -482,14 +759,16 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	prefixStore := k.contractStore(ctx, contractAddress, newCodeID)
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, &prefixStore, cosmwasmAPI, &querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, prefixStore, k.cosmwasmAPI(ctx), &querier, k.gasMeter(ctx), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return nil, err
+	}
 
 	// delete old secondary index entry
 	k.removeFromContractCodeSecondaryIndex(ctx, contractAddress, k.getLastContractHistoryEntry(ctx, contractAddress))
@@ -516,14 +795,18 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 // Sudo allows priviledged access to a contract. This can never be called by an external tx, but only by
 // another native Go module directly, or on-chain governance (if sudo proposals are enabled). Thus, the keeper doesn't
 // place any access controls on it, that is the responsibility or the app developer (who passes the wasm.Keeper in app.go)
-func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) (_ []byte, err error) {
 	defer func(begin time.Time) { k.metrics.SudoElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	defer traceFrame(ctx, contractTraceFrame(EntryPointSudo, contractAddress, contractInfo.CodeID))(&err)
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddress, contractInfo.CodeID, EntryPointSudo); err != nil {
+		return nil, err
+	}
 
-	sudoSetupCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
+	sudoSetupCosts := k.gasRegister.InstantiateContractCosts(ctx, k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(sudoSetupCosts, "Loading CosmWasm module: sudo")
 
 	env := types.NewEnv(ctx, contractAddress)
@@ -531,11 +814,14 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, prefixStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeSudo,
@@ -551,14 +837,18 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 }
 
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
-func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) (_ []byte, err error) {
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	defer traceFrame(ctx, contractTraceFrame(EntryPointReply, contractAddress, contractInfo.CodeID))(&err)
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddress, contractInfo.CodeID, EntryPointReply); err != nil {
+		return nil, err
+	}
 
 	// always consider this pinned
-	replyCosts := k.gasRegister.ReplyCosts(true, reply)
+	replyCosts := k.gasRegister.ReplyCosts(ctx, true, reply)
 	ctx.GasMeter().ConsumeGas(replyCosts, "Loading CosmWasm module: reply")
 
 	env := types.NewEnv(ctx, contractAddress)
@@ -567,11 +857,14 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	querier := k.newQueryHandler(ctx, contractAddress)
 	gas := k.runtimeGasForContract(ctx)
 
-	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeReply,
@@ -611,6 +904,26 @@ func (k Keeper) IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(a
 	}
 }
 
+// addToContractCreatorSecondaryIndex adds element to the index for contracts-by-creator queries
+func (k Keeper) addToContractCreatorSecondaryIndex(ctx sdk.Context, creatorAddress sdk.AccAddress, position *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractByCreatorSecondaryIndexKey(creatorAddress, position.Bytes(), contractAddress), []byte{})
+}
+
+// IterateContractsByCreator iterates over all contracts with given creator address ASC on creation time.
+func (k Keeper) IterateContractsByCreator(ctx sdk.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByCreatorPrefix(creator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if cb(key[types.AbsoluteTxPositionLen:]) {
+			return
+		}
+	}
+}
+
 func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error {
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
 	if contractInfo == nil {
@@ -680,11 +993,11 @@ func (k Keeper) getLastContractHistoryEntry(ctx sdk.Context, contractAddr sdk.Ac
 }
 
 // QuerySmart queries the smart contract itself.
-func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) (_ []byte, err error) {
 	defer func(begin time.Time) { k.metrics.QuerySmartElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
 
 	// checks and increase query stack size
-	ctx, err := checkAndIncreaseQueryStackSize(ctx, k.maxQueryStackSize)
+	ctx, err = checkAndIncreaseQueryStackSize(ctx, k.maxQueryStackSize)
 	if err != nil {
 		return nil, err
 	}
@@ -693,15 +1006,19 @@ func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []b
 	if err != nil {
 		return nil, err
 	}
+	defer traceFrame(ctx, contractTraceFrame(EntryPointQuery, contractAddr, contractInfo.CodeID))(&err)
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddr, contractInfo.CodeID, EntryPointQuery); err != nil {
+		return nil, err
+	}
 
-	smartQuerySetupCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(req))
+	smartQuerySetupCosts := k.gasRegister.InstantiateContractCosts(ctx, k.IsPinnedCode(ctx, contractInfo.CodeID), len(req))
 	ctx.GasMeter().ConsumeGas(smartQuerySetupCosts, "Loading CosmWasm module: query")
 
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	env := types.NewEnv(ctx, contractAddr)
-	queryResult, gasUsed, qErr := k.wasmVM.Query(codeInfo.CodeHash, env, req, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), k.runtimeGasForContract(ctx), costJSONDeserialization)
+	queryResult, gasUsed, qErr := k.wasmVM.Query(codeInfo.CodeHash, env, req, prefixStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), k.runtimeGasForContract(ctx), k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if qErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrQueryFailed, qErr.Error())
@@ -744,25 +1061,23 @@ func (k Keeper) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []
 	return prefixStore.Get(key)
 }
 
-func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, prefix.Store, error) {
+func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, storageUsageStore, error) {
 	store := ctx.KVStore(k.storeKey)
 
 	contractBz := store.Get(types.GetContractAddressKey(contractAddress))
 	if contractBz == nil {
-		return types.ContractInfo{}, types.CodeInfo{}, prefix.Store{}, sdkerrors.Wrap(types.ErrNotFound, "contract")
+		return types.ContractInfo{}, types.CodeInfo{}, storageUsageStore{}, sdkerrors.Wrap(types.ErrNotFound, "contract")
 	}
 	var contractInfo types.ContractInfo
 	k.cdc.MustUnmarshal(contractBz, &contractInfo)
 
 	codeInfoBz := store.Get(types.GetCodeKey(contractInfo.CodeID))
 	if codeInfoBz == nil {
-		return contractInfo, types.CodeInfo{}, prefix.Store{}, sdkerrors.Wrap(types.ErrNotFound, "code info")
+		return contractInfo, types.CodeInfo{}, storageUsageStore{}, sdkerrors.Wrap(types.ErrNotFound, "code info")
 	}
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshal(codeInfoBz, &codeInfo)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	return contractInfo, codeInfo, prefixStore, nil
+	return contractInfo, codeInfo, k.contractStore(ctx, contractAddress, contractInfo.CodeID), nil
 }
 
 func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
@@ -818,18 +1133,24 @@ func (k Keeper) IterateContractState(ctx sdk.Context, contractAddress sdk.AccAdd
 }
 
 func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) error {
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	// imported state is not limited by the storage quota
+	prefixStore := k.newStorageUsageStore(ctx, contractAddress, 0)
+	var err error
 	for _, model := range models {
 		if model.Value == nil {
 			model.Value = []byte{}
 		}
 		if prefixStore.Has(model.Key) {
-			return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate key: %x", model.Key)
+			err = sdkerrors.Wrapf(types.ErrDuplicate, "duplicate key: %x", model.Key)
+			break
 		}
 		prefixStore.Set(model.Key, model.Value)
 	}
-	return nil
+	// the counters match the imported entries also when the import failed
+	if commitErr := prefixStore.commitUsage(); commitErr != nil {
+		return commitErr
+	}
+	return err
 }
 
 func (k Keeper) GetCodeInfo(ctx sdk.Context, codeID uint64) *types.CodeInfo {
@@ -978,6 +1299,68 @@ func (k Keeper) setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAd
 	return nil
 }
 
+// removeCode deletes the code info of a code id that is neither pinned nor used by any contract.
+// The checksum is released in the wasmvm at the end of the block when no other code id
+// shares it.
+func (k Keeper) removeCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, authz AuthorizationPolicy) error {
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	if !authz.CanRemoveCode(sdk.MustAccAddressFromBech32(info.Creator), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not remove code")
+	}
+	if k.IsPinnedCode(ctx, codeID) {
+		return sdkerrors.Wrap(types.ErrInvalid, "code is pinned")
+	}
+	var inUse bool
+	k.IterateContractsByCode(ctx, codeID, func(sdk.AccAddress) bool {
+		inUse = true
+		return true
+	})
+	if inUse {
+		return sdkerrors.Wrap(types.ErrInvalid, "code is used by contracts")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCodeKey(codeID))
+	k.removeFromCodeChecksumSecondaryIndex(ctx, info.CodeHash, codeID)
+	k.removeCodeStorageQuota(ctx, codeID)
+	// store 1 byte to not run into `nil` debugging issues
+	store.Set(types.GetPendingCodeRemovalKey(info.CodeHash), []byte{1})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(info.CodeHash)),
+	))
+	return nil
+}
+
+// PruneRemovedCodes releases all checksums in the wasmvm that were scheduled for removal and are
+// not referenced by any code id anymore. This is not done within the transaction of the remove
+// operation as the wasmvm changes can not be reverted with the state.
+func (k Keeper) PruneRemovedCodes(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingCodeRemovalPrefix)
+	iter := store.Iterator(nil, nil)
+	var checksums [][]byte
+	for ; iter.Valid(); iter.Next() {
+		checksums = append(checksums, iter.Key())
+	}
+	iter.Close()
+
+	for _, checksum := range checksums {
+		store.Delete(checksum)
+		if k.hasCodeWithChecksum(ctx, checksum) {
+			continue
+		}
+		if err := k.wasmVM.RemoveCode(checksum); err != nil {
+			// the artifacts are not part of the consensus state so that a failure must not halt the chain
+			k.Logger(ctx).Error("failed to release wasm code", "checksum", hex.EncodeToString(checksum), "error", err)
+		}
+	}
+}
+
 // handleContractResponse processes the contract response data by emitting events and sending sub-/messages.
 func (k *Keeper) handleContractResponse(
 	ctx sdk.Context,
@@ -988,7 +1371,7 @@ func (k *Keeper) handleContractResponse(
 	data []byte,
 	evts wasmvmtypes.Events,
 ) ([]byte, error) {
-	attributeGasCost := k.gasRegister.EventCosts(attrs, evts)
+	attributeGasCost := k.gasRegister.EventCosts(ctx, attrs, evts)
 	ctx.GasMeter().ConsumeGas(attributeGasCost, "Custom contract event attributes")
 	// emit all events from this contract itself
 	if len(attrs) != 0 {
@@ -1016,11 +1399,11 @@ func (k Keeper) runtimeGasForContract(ctx sdk.Context) uint64 {
 	if meter.Limit() == 0 { // infinite gas meter with limit=0 and not out of gas
 		return math.MaxUint64
 	}
-	return k.gasRegister.ToWasmVMGas(meter.Limit() - meter.GasConsumedToLimit())
+	return k.gasRegister.ToWasmVMGas(ctx, meter.Limit()-meter.GasConsumedToLimit())
 }
 
 func (k Keeper) consumeRuntimeGas(ctx sdk.Context, gas uint64) {
-	consumed := k.gasRegister.FromWasmVMGas(gas)
+	consumed := k.gasRegister.FromWasmVMGas(ctx, gas)
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
@@ -1073,6 +1456,11 @@ func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *
 	k.appendToContractHistory(ctx, contractAddr, historyEntry)
 	k.storeContractInfo(ctx, contractAddr, c)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddr, historyEntry)
+	creatorAddr, err := sdk.AccAddressFromBech32(c.Creator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	k.addToContractCreatorSecondaryIndex(ctx, creatorAddr, historyEntry.Updated, contractAddr)
 	return k.importContractState(ctx, contractAddr, state)
 }
 
@@ -1084,20 +1472,22 @@ func (k Keeper) newQueryHandler(ctx sdk.Context, contractAddress sdk.AccAddress)
 type MultipliedGasMeter struct {
 	originalMeter sdk.GasMeter
 	GasRegister   GasRegister
+	// ctx is used by the GasRegister to resolve the current costs
+	ctx sdk.Context
 }
 
-func NewMultipliedGasMeter(originalMeter sdk.GasMeter, gr GasRegister) MultipliedGasMeter {
-	return MultipliedGasMeter{originalMeter: originalMeter, GasRegister: gr}
+func NewMultipliedGasMeter(originalMeter sdk.GasMeter, gr GasRegister, ctx sdk.Context) MultipliedGasMeter {
+	return MultipliedGasMeter{originalMeter: originalMeter, GasRegister: gr, ctx: ctx}
 }
 
 var _ wasmvm.GasMeter = MultipliedGasMeter{}
 
 func (m MultipliedGasMeter) GasConsumed() sdk.Gas {
-	return m.GasRegister.ToWasmVMGas(m.originalMeter.GasConsumed())
+	return m.GasRegister.ToWasmVMGas(m.ctx, m.originalMeter.GasConsumed())
 }
 
 func (k Keeper) gasMeter(ctx sdk.Context) MultipliedGasMeter {
-	return NewMultipliedGasMeter(ctx.GasMeter(), k.gasRegister)
+	return NewMultipliedGasMeter(ctx.GasMeter(), k.gasRegister, ctx)
 }
 
 // Logger returns a module-specific logger.
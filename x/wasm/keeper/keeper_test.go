@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"bytes"
+	"crypto/sha256"
 	_ "embed"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -75,6 +77,68 @@ func TestCreateSuccess(t *testing.T) {
 	assert.Equal(t, exp, em.Events())
 }
 
+func TestCreateWithVerificationInfo(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(parentCtx, deposit...)
+	checksum := sha256.Sum256(hackatomWasm)
+	gzippedWasm, err := os.ReadFile("./testdata/hackatom.wasm.gzip")
+	require.NoError(t, err)
+	const (
+		source  = "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom"
+		builder = "cosmwasm/rust-optimizer:0.12.6"
+	)
+
+	specs := map[string]struct {
+		srcCode  []byte
+		codeHash []byte
+		expErr   *sdkerrors.Error
+	}{
+		"code hash matches": {
+			srcCode:  hackatomWasm,
+			codeHash: checksum[:],
+		},
+		"code hash matches gzipped code": {
+			srcCode:  gzippedWasm,
+			codeHash: checksum[:],
+		},
+		"without code hash": {
+			srcCode: hackatomWasm,
+		},
+		"code hash mismatch": {
+			srcCode:  hackatomWasm,
+			codeHash: bytes.Repeat([]byte{0x1}, sha256.Size),
+			expErr:   types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			codeID, gotChecksum, err := keepers.ContractKeeper.CreateWithVerificationInfo(ctx, creator, spec.srcCode, nil, source, builder, spec.codeHash)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %+v", err)
+				assert.Nil(t, keepers.WasmKeeper.GetCodeInfo(ctx, 1))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, checksum[:], gotChecksum)
+			codeInfo := keepers.WasmKeeper.GetCodeInfo(ctx, codeID)
+			require.NotNil(t, codeInfo)
+			assert.Equal(t, source, codeInfo.Source)
+			assert.Equal(t, builder, codeInfo.Builder)
+		})
+	}
+}
+
+func TestCreateWithCodeHashMismatchSkipsCompile(t *testing.T) {
+	// the mock panics when the code is compiled
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&wasmtesting.MockWasmer{}))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+
+	_, _, err := keepers.ContractKeeper.CreateWithVerificationInfo(ctx, creator, hackatomWasm, nil, "", "", bytes.Repeat([]byte{0x1}, sha256.Size))
+	assert.ErrorIs(t, err, types.ErrInvalid)
+}
+
 func TestCreateNilCreatorAddress(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 
@@ -134,6 +198,7 @@ func TestCreateStoresInstantiatePermission(t *testing.T) {
 			keepers.WasmKeeper.SetParams(ctx, types.Params{
 				CodeUploadAccess:             types.AllowEverybody,
 				InstantiateDefaultPermission: spec.srcPermission,
+				GasRegister:                  types.DefaultGasRegisterParams(),
 			})
 			fundAccounts(t, ctx, accKeeper, bankKeeper, myAddr, deposit)
 
@@ -282,10 +347,18 @@ func TestCreateDuplicate(t *testing.T) {
 	require.Equal(t, uint64(1), contractID)
 
 	// create second copy
-	duplicateID, _, err := keeper.Create(ctx, creator, hackatomWasm, nil)
+	duplicateID, checksum, err := keeper.Create(ctx, creator, hackatomWasm, nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(2), duplicateID)
 
+	// and both are indexed by checksum
+	var gotCodeIDs []uint64
+	keepers.WasmKeeper.IterateCodeIDsByChecksum(ctx, checksum, func(codeID uint64) bool {
+		gotCodeIDs = append(gotCodeIDs, codeID)
+		return false
+	})
+	assert.Equal(t, []uint64{contractID, duplicateID}, gotCodeIDs)
+
 	// and verify both content is proper
 	storedCode, err := keepers.WasmKeeper.GetByteCode(ctx, contractID)
 	require.NoError(t, err)
@@ -411,7 +484,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x194a1), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x1baf9), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -2164,6 +2237,157 @@ func TestSetAccessConfig(t *testing.T) {
 	}
 }
 
+func TestRemoveCode(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	nonCreatorAddr := RandomAccountAddress(t)
+	const codeID = 1
+	checksum := []byte("myCodeHash")
+
+	specs := map[string]struct {
+		authz   AuthorizationPolicy
+		caller  sdk.AccAddress
+		setup   func(ctx sdk.Context)
+		expErr  *sdkerrors.Error
+		expEvts map[string]string
+	}{
+		"creator": {
+			authz:  DefaultAuthorizationPolicy{},
+			caller: creatorAddr,
+			expEvts: map[string]string{
+				"code_id":       "1",
+				"code_checksum": hex.EncodeToString(checksum),
+			},
+		},
+		"different actor": {
+			authz:  DefaultAuthorizationPolicy{},
+			caller: nonCreatorAddr,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"gov": {
+			authz:  GovAuthorizationPolicy{},
+			caller: nonCreatorAddr,
+			expEvts: map[string]string{
+				"code_id":       "1",
+				"code_checksum": hex.EncodeToString(checksum),
+			},
+		},
+		"unknown code": {
+			authz:  GovAuthorizationPolicy{},
+			caller: creatorAddr,
+			setup: func(ctx sdk.Context) {
+				ctx.KVStore(k.storeKey).Delete(types.GetCodeKey(codeID))
+			},
+			expErr: types.ErrNotFound,
+		},
+		"pinned code": {
+			authz:  GovAuthorizationPolicy{},
+			caller: creatorAddr,
+			setup: func(ctx sdk.Context) {
+				ctx.KVStore(k.storeKey).Set(types.GetPinnedCodeIndexPrefix(codeID), []byte{1})
+			},
+			expErr: types.ErrInvalid,
+		},
+		"code used by a contract": {
+			authz:  GovAuthorizationPolicy{},
+			caller: creatorAddr,
+			setup: func(ctx sdk.Context) {
+				k.addToContractCodeSecondaryIndex(ctx, RandomAccountAddress(t), types.ContractCodeHistoryEntry{CodeID: codeID, Updated: types.NewAbsoluteTxPosition(ctx)})
+			},
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			k.storeCodeInfo(ctx, codeID, types.NewCodeInfo(checksum, creatorAddr, types.AllowEverybody))
+			k.addToCodeChecksumSecondaryIndex(ctx, checksum, codeID)
+			if spec.setup != nil {
+				spec.setup(ctx)
+			}
+			// when
+			gotErr := k.removeCode(ctx, codeID, spec.caller, spec.authz)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetPendingCodeRemovalKey(checksum)))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetCodeInfo(ctx, codeID))
+			assert.False(t, k.hasCodeWithChecksum(ctx, checksum))
+			assert.True(t, ctx.KVStore(k.storeKey).Has(types.GetPendingCodeRemovalKey(checksum)))
+			// and event emitted
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, "remove_code", em.Events()[0].Type)
+			assert.Equal(t, spec.expEvts, attrsToStringMap(em.Events()[0].Attributes))
+		})
+	}
+}
+
+func TestRemoveCodeDropsStorageQuotaOverride(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	for _, codeID := range []uint64{1, 2} {
+		checksum := []byte(fmt.Sprintf("myCodeHash%d", codeID))
+		k.storeCodeInfo(ctx, codeID, types.NewCodeInfo(checksum, creatorAddr, types.AllowEverybody))
+		k.addToCodeChecksumSecondaryIndex(ctx, checksum, codeID)
+	}
+	params := k.GetParams(ctx)
+	params.StorageQuota = types.StorageQuotaParams{
+		MaxContractBytes: 6,
+		CodeOverrides:    []types.CodeStorageQuota{{CodeID: 1, MaxContractBytes: 9}, {CodeID: 2, MaxContractBytes: 12}},
+	}
+	k.SetParams(ctx, params)
+
+	// when
+	require.NoError(t, k.removeCode(ctx, 1, creatorAddr, DefaultAuthorizationPolicy{}))
+
+	// then
+	exp := types.StorageQuotaParams{
+		MaxContractBytes: 6,
+		CodeOverrides:    []types.CodeStorageQuota{{CodeID: 2, MaxContractBytes: 12}},
+	}
+	assert.Equal(t, exp, k.GetParams(ctx).StorageQuota)
+}
+
+func TestPruneRemovedCodes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+
+	var capturedChecksums []wasmvm.Checksum
+	mock := wasmtesting.MockWasmer{RemoveCodeFn: func(checksum wasmvm.Checksum) error {
+		capturedChecksums = append(capturedChecksums, checksum)
+		return nil
+	}}
+	k.wasmVM = &mock
+
+	for codeID, checksum := range map[uint64][]byte{1: []byte("removed"), 2: []byte("shared"), 3: []byte("shared")} {
+		k.storeCodeInfo(ctx, codeID, types.NewCodeInfo(checksum, creatorAddr, types.AllowEverybody))
+		k.addToCodeChecksumSecondaryIndex(ctx, checksum, codeID)
+	}
+	require.NoError(t, k.removeCode(ctx, 1, creatorAddr, DefaultAuthorizationPolicy{}))
+	require.NoError(t, k.removeCode(ctx, 2, creatorAddr, DefaultAuthorizationPolicy{}))
+
+	// when
+	k.PruneRemovedCodes(ctx)
+
+	// then only the checksum that is not referenced anymore is removed
+	assert.Equal(t, []wasmvm.Checksum{[]byte("removed")}, capturedChecksums)
+	assert.NotNil(t, k.GetCodeInfo(ctx, 3))
+	// and the queue is drained
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetPendingCodeRemovalKey([]byte("removed"))))
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetPendingCodeRemovalKey([]byte("shared"))))
+	capturedChecksums = nil
+	k.PruneRemovedCodes(ctx)
+	assert.Empty(t, capturedChecksums)
+}
+
 func TestAppendToContractHistory(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	var contractAddr sdk.AccAddress = rand.Bytes(types.ContractAddrLen)
@@ -2352,3 +2576,110 @@ func attrsToStringMap(attrs []abci.EventAttribute) map[string]string {
 	}
 	return r
 }
+
+type contractAccessCheckerFn func(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64, entryPoint EntryPoint) error
+
+func (f contractAccessCheckerFn) CheckContractAccess(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64, entryPoint EntryPoint) error {
+	return f(ctx, contractAddress, codeID, entryPoint)
+}
+
+func TestContractAccessChecker(t *testing.T) {
+	var (
+		capturedEntryPoints []EntryPoint
+		checkerErr          error
+	)
+	checker := contractAccessCheckerFn(func(_ sdk.Context, _ sdk.AccAddress, _ uint64, entryPoint EntryPoint) error {
+		capturedEntryPoints = append(capturedEntryPoints, entryPoint)
+		return checkerErr
+	})
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithContractAccessChecker(checker))
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	assert.Equal(t, []EntryPoint{EntryPointInstantiate}, capturedEntryPoints)
+
+	// when access is granted
+	capturedEntryPoints = nil
+	_, err := keepers.WasmKeeper.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+	_, err = keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+	_, err = keepers.WasmKeeper.Sudo(ctx, example.Contract, []byte(`{"steal_funds":{"recipient":"`+example.VerifierAddr.String()+`","amount":[]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []EntryPoint{EntryPointQuery, EntryPointExecute, EntryPointSudo}, capturedEntryPoints)
+
+	// when access is rejected
+	checkerErr = sdkerrors.ErrUnauthorized
+	capturedEntryPoints = nil
+	_, err = keepers.WasmKeeper.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, example.CodeID, []byte(`{"verifier":"`+example.VerifierAddr.String()+`"}`))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, []byte(`{}`), "other", nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	assert.Equal(t, []EntryPoint{EntryPointQuery, EntryPointExecute, EntryPointMigrate, EntryPointInstantiate}, capturedEntryPoints)
+}
+
+func TestGetStargateMsgFilter(t *testing.T) {
+	wiring := types.StargateMsgFilter{AcceptList: []string{"/cosmos.bank.v1beta1.MsgSend"}, MaxMsgSize: 100}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithStargateMsgFilter(wiring))
+	keeper := keepers.WasmKeeper
+
+	specs := map[string]struct {
+		params types.StargateMsgFilter
+		exp    types.StargateMsgFilter
+	}{
+		"app wiring filter": {
+			exp: wiring,
+		},
+		"params max size keeps app wiring lists": {
+			params: types.StargateMsgFilter{MaxMsgSize: 1},
+			exp:    types.StargateMsgFilter{AcceptList: []string{"/cosmos.bank.v1beta1.MsgSend"}, MaxMsgSize: 1},
+		},
+		"params deny list is merged with app wiring accept list": {
+			params: types.StargateMsgFilter{DenyList: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+			exp: types.StargateMsgFilter{
+				AcceptList: []string{"/cosmos.bank.v1beta1.MsgSend"},
+				DenyList:   []string{"/cosmos.gov.v1beta1.MsgVote"},
+				MaxMsgSize: 100,
+			},
+		},
+		"params accept list restricts app wiring accept list": {
+			params: types.StargateMsgFilter{AcceptList: []string{"/cosmos.gov.v1beta1.MsgVote"}, MaxMsgSize: 1},
+			exp: types.StargateMsgFilter{
+				AcceptList: []string{"/cosmos.bank.v1beta1.MsgSend"},
+				DenyList:   []string{"/cosmos.bank.v1beta1.MsgSend"},
+				MaxMsgSize: 1,
+			},
+		},
+		"params accept list keeps accepted app wiring type urls": {
+			params: types.StargateMsgFilter{AcceptList: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.gov.v1beta1.MsgVote"}},
+			exp:    types.StargateMsgFilter{AcceptList: []string{"/cosmos.bank.v1beta1.MsgSend"}, MaxMsgSize: 100},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			params := keeper.GetParams(xCtx)
+			params.StargateMsgFilter = spec.params
+			keeper.SetParams(xCtx, params)
+
+			assert.Equal(t, spec.exp, keeper.GetStargateMsgFilter(xCtx))
+		})
+	}
+}
+
+func TestGetStargateMsgFilterMergesDenyLists(t *testing.T) {
+	wiring := types.StargateMsgFilter{DenyList: []string{"/cosmos.bank.v1beta1.MsgSend"}}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithStargateMsgFilter(wiring))
+	keeper := keepers.WasmKeeper
+
+	params := keeper.GetParams(ctx)
+	params.StargateMsgFilter = types.StargateMsgFilter{AcceptList: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.gov.v1beta1.MsgVote"}}
+	keeper.SetParams(ctx, params)
+
+	filter := keeper.GetStargateMsgFilter(ctx)
+	assert.ErrorIs(t, filter.Accept("/cosmos.bank.v1beta1.MsgSend", 1), types.ErrStargateMsgNotAllowed)
+	assert.NoError(t, filter.Accept("/cosmos.gov.v1beta1.MsgVote", 1))
+	assert.ErrorIs(t, filter.Accept("/cosmos.staking.v1beta1.MsgDelegate", 1), types.ErrStargateMsgNotAllowed)
+}
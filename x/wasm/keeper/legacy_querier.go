@@ -135,6 +135,8 @@ func queryCodeList(ctx sdk.Context, keeper types.ViewKeeper) ([]types.CodeInfoRe
 			Creator:               res.Creator,
 			DataHash:              res.CodeHash,
 			InstantiatePermission: res.InstantiateConfig,
+			Source:                res.Source,
+			Builder:               res.Builder,
 		})
 		return false
 	})
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -15,6 +17,60 @@ func NewMigrator(keeper Keeper) Migrator {
 }
 
 // Migrate1to2 migrates from version 1 to 2.
+// It builds the secondary index of contracts by creator for all existing contracts.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		creator := sdk.MustAccAddressFromBech32(contractInfo.Creator)
+		m.keeper.addToContractCreatorSecondaryIndex(ctx, creator, contractInfo.Created, contractAddr)
+		return false
+	})
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It builds the secondary index of code ids by checksum for all existing codes.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		m.keeper.addToCodeChecksumSecondaryIndex(ctx, info.CodeHash, codeID)
+		return false
+	})
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// It sets the default gas register params that were compile time constants before.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyGasRegister, types.DefaultGasRegisterParams())
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+// It backfills the storage usage counters of all existing contracts.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, _ types.ContractInfo) bool {
+		m.keeper.setContractStorageUsage(ctx, contractAddr, m.keeper.computeContractStorageUsage(ctx, contractAddr))
+		return false
+	})
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+// It sets the default storage quota params that do not limit the state size of the contracts.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyStorageQuota, types.DefaultStorageQuotaParams())
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+// It sets the empty stargate query accept list that rejects all stargate queries as before.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyStargateQueryAcceptList, types.DefaultStargateQueryAcceptList())
+	return nil
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+// It sets the empty stargate msg filter so that the filter of the app wiring is used.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyStargateMsgFilter, types.DefaultStargateMsgFilter())
 	return nil
 }
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+
+	"github.com/Finschia/wasmd/x/wasm/keeper/testdata"
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestMigrate1To2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	otherCreator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+
+	instantiate := func(creator sdk.AccAddress) sdk.AccAddress {
+		// new block so that the contracts are sorted by creation
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		_, _, verifier := keyPubAddr()
+		_, _, beneficiary := keyPubAddr()
+		initMsg := HackatomExampleInitMsg{Verifier: verifier, Beneficiary: beneficiary}
+		contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsg.GetBytes(t), "demo contract", nil)
+		require.NoError(t, err)
+		return contractAddr
+	}
+	var expContracts []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		expContracts = append(expContracts, instantiate(creator))
+	}
+	otherContract := instantiate(otherCreator)
+
+	// remove the index to simulate a state of the previous version
+	store := prefix.NewStore(ctx.KVStore(wasmKeeper.storeKey), types.ContractsByCreatorPrefix)
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	require.Len(t, keys, 4)
+	for _, k := range keys {
+		store.Delete(k)
+	}
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate1to2(ctx)
+
+	// then
+	require.NoError(t, err)
+	var gotContracts []sdk.AccAddress
+	wasmKeeper.IterateContractsByCreator(ctx, creator, func(address sdk.AccAddress) bool {
+		gotContracts = append(gotContracts, address)
+		return false
+	})
+	assert.Equal(t, expContracts, gotContracts)
+
+	gotContracts = nil
+	wasmKeeper.IterateContractsByCreator(ctx, otherCreator, func(address sdk.AccAddress) bool {
+		gotContracts = append(gotContracts, address)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{otherContract}, gotContracts)
+}
+
+func TestMigrate2To3(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	firstCodeID, checksum, err := keepers.ContractKeeper.Create(ctx, creator, hackatomWasm, nil)
+	require.NoError(t, err)
+	duplicateCodeID, _, err := keepers.ContractKeeper.Create(ctx, creator, hackatomWasm, nil)
+	require.NoError(t, err)
+	otherCodeID, otherChecksum, err := keepers.ContractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+
+	// remove the index to simulate a state of the previous version
+	store := prefix.NewStore(ctx.KVStore(wasmKeeper.storeKey), types.CodeIDsByChecksumPrefix)
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	require.Len(t, keys, 3)
+	for _, k := range keys {
+		store.Delete(k)
+	}
+
+	// when
+	err = NewMigrator(*wasmKeeper).Migrate2to3(ctx)
+
+	// then
+	require.NoError(t, err)
+	var gotCodeIDs []uint64
+	wasmKeeper.IterateCodeIDsByChecksum(ctx, checksum, func(codeID uint64) bool {
+		gotCodeIDs = append(gotCodeIDs, codeID)
+		return false
+	})
+	assert.Equal(t, []uint64{firstCodeID, duplicateCodeID}, gotCodeIDs)
+
+	gotCodeIDs = nil
+	wasmKeeper.IterateCodeIDsByChecksum(ctx, otherChecksum, func(codeID uint64) bool {
+		gotCodeIDs = append(gotCodeIDs, codeID)
+		return false
+	})
+	assert.Equal(t, []uint64{otherCodeID}, gotCodeIDs)
+}
+
+func TestMigrate3To4(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	params := wasmKeeper.GetParams(ctx)
+	params.GasRegister.GasMultiplier = 1
+	params.GasRegister.InstanceCost = 1
+	wasmKeeper.SetParams(ctx, params)
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate3to4(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultGasRegisterParams(), wasmKeeper.GetParams(ctx).GasRegister)
+	assert.Equal(t, params.CodeUploadAccess, wasmKeeper.GetParams(ctx).CodeUploadAccess)
+}
+
+func TestMigrate4To5(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherContract := RandomAccountAddress(t)
+	wasmKeeper.storeContractInfo(ctx, otherContract, &types.ContractInfo{CodeID: example.CodeID, Creator: example.CreatorAddr.String()})
+	require.NoError(t, wasmKeeper.importContractState(ctx, otherContract, []types.Model{
+		{Key: []byte("foo"), Value: []byte("bar")},
+		{Key: []byte("a"), Value: []byte("b")},
+	}))
+	expUsage := wasmKeeper.GetContractStorageUsage(ctx, example.Contract)
+	require.NotZero(t, expUsage.Keys)
+
+	// remove the counters to simulate a state of the previous version
+	store := prefix.NewStore(ctx.KVStore(wasmKeeper.storeKey), types.ContractStorageUsagePrefix)
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	require.Len(t, keys, 2)
+	for _, k := range keys {
+		store.Delete(k)
+	}
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate4to5(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, expUsage, wasmKeeper.GetContractStorageUsage(ctx, example.Contract))
+	assert.Equal(t, types.ContractStorageUsage{Bytes: 8, Keys: 2}, wasmKeeper.GetContractStorageUsage(ctx, otherContract))
+}
+
+func TestMigrate5To6(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	params := wasmKeeper.GetParams(ctx)
+	params.StorageQuota = types.StorageQuotaParams{MaxContractBytes: 1}
+	wasmKeeper.SetParams(ctx, params)
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate5to6(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultStorageQuotaParams(), wasmKeeper.GetParams(ctx).StorageQuota)
+	assert.Equal(t, params.GasRegister, wasmKeeper.GetParams(ctx).GasRegister)
+}
+
+func TestMigrate6To7(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	params := wasmKeeper.GetParams(ctx)
+	params.StargateQueryAcceptList = []types.AcceptedStargateQuery{
+		{Path: "/cosmos.auth.v1beta1.Query/Account", ResponseTypeURL: "/cosmos.auth.v1beta1.QueryAccountResponse"},
+	}
+	wasmKeeper.SetParams(ctx, params)
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate6to7(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, wasmKeeper.GetStargateQueryAcceptList(ctx))
+	assert.Equal(t, params.StorageQuota, wasmKeeper.GetParams(ctx).StorageQuota)
+}
+
+func TestMigrate7To8(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	params := wasmKeeper.GetParams(ctx)
+	params.StargateMsgFilter = types.StargateMsgFilter{DenyList: []string{"/cosmos.bank.v1beta1.MsgSend"}}
+	wasmKeeper.SetParams(ctx, params)
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate7to8(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultStargateMsgFilter(), wasmKeeper.GetParams(ctx).StargateMsgFilter)
+	assert.Equal(t, params.StargateQueryAcceptList, wasmKeeper.GetParams(ctx).StargateQueryAcceptList)
+}
@@ -76,6 +76,15 @@ func (d MessageDispatcher) dispatchMsgWithGasLimit(ctx sdk.Context, contractAddr
 	return events, data, err
 }
 
+// dispatchSubMsg sends the message of a sub message with the optional gas limit applied
+func (d MessageDispatcher) dispatchSubMsg(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msg wasmvmtypes.SubMsg, limitGas bool) (events []sdk.Event, data [][]byte, err error) {
+	defer traceFrame(ctx, subMsgTraceFrame(contractAddr, msg))(&err)
+	if limitGas {
+		return d.dispatchMsgWithGasLimit(ctx, contractAddr, ibcPort, msg.Msg, *msg.GasLimit)
+	}
+	return d.messenger.DispatchMsg(ctx, contractAddr, ibcPort, msg.Msg)
+}
+
 // DispatchSubmessages builds a sandbox to execute these messages and returns the execution result to the contract
 // that dispatched them, both on success as well as failure
 func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []wasmvmtypes.SubMsg) ([]byte, error) {
@@ -95,14 +104,7 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		gasRemaining := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
 		limitGas := msg.GasLimit != nil && (*msg.GasLimit < gasRemaining)
 
-		var err error
-		var events []sdk.Event
-		var data [][]byte
-		if limitGas {
-			events, data, err = d.dispatchMsgWithGasLimit(subCtx, contractAddr, ibcPort, msg.Msg, *msg.GasLimit)
-		} else {
-			events, data, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg.Msg)
-		}
+		events, data, err := d.dispatchSubMsg(subCtx, contractAddr, ibcPort, msg, limitGas)
 
 		// if it succeeds, commit state changes from submessage, and pass on events to Event Manager
 		var filteredEvents []sdk.Event
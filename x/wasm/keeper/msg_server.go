@@ -29,7 +29,7 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 		return nil, sdkerrors.Wrap(err, "sender")
 	}
 
-	codeID, checksum, err := m.keeper.Create(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission)
+	codeID, checksum, err := m.keeper.CreateWithVerificationInfo(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission, msg.Source, msg.Builder, msg.CodeHash)
 	if err != nil {
 		return nil, err
 	}
@@ -195,3 +195,172 @@ func (m msgServer) ClearAdmin(goCtx context.Context, msg *types.MsgClearAdmin) (
 
 	return &types.MsgClearAdminResponse{}, nil
 }
+
+// UpdateInstantiateConfig updates the instantiate config of a code. Only the code creator
+// can narrow the config without governance.
+func (m msgServer) UpdateInstantiateConfig(goCtx context.Context, msg *types.MsgUpdateInstantiateConfig) (*types.MsgUpdateInstantiateConfigResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	if err := m.keeper.SetAccessConfig(ctx, msg.CodeID, senderAddr, *msg.NewInstantiatePermission); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateInstantiateConfigResponse{}, nil
+}
+
+// UpdateParams updates the module parameters
+func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.keeper.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SudoContract calls sudo on a contract.
+func (m msgServer) SudoContract(goCtx context.Context, req *types.MsgSudoContract) (*types.MsgSudoContractResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	data, err := m.keeper.Sudo(ctx, contractAddr, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSudoContractResponse{Data: data}, nil
+}
+
+// PinCodes pins a set of code ids in the wasmvm cache.
+func (m msgServer) PinCodes(goCtx context.Context, req *types.MsgPinCodes) (*types.MsgPinCodesResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, codeID := range req.CodeIDs {
+		if err := m.keeper.PinCode(ctx, codeID); err != nil {
+			return nil, sdkerrors.Wrapf(err, "code id: %d", codeID)
+		}
+	}
+
+	return &types.MsgPinCodesResponse{}, nil
+}
+
+// UnpinCodes unpins a set of code ids in the wasmvm cache.
+func (m msgServer) UnpinCodes(goCtx context.Context, req *types.MsgUnpinCodes) (*types.MsgUnpinCodesResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, codeID := range req.CodeIDs {
+		if err := m.keeper.UnpinCode(ctx, codeID); err != nil {
+			return nil, sdkerrors.Wrapf(err, "code id: %d", codeID)
+		}
+	}
+
+	return &types.MsgUnpinCodesResponse{}, nil
+}
+
+// StoreAndInstantiateContract stores and instantiates the contract.
+func (m msgServer) StoreAndInstantiateContract(goCtx context.Context, req *types.MsgStoreAndInstantiateContract) (*types.MsgStoreAndInstantiateContractResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+	authorityAddr, err := sdk.AccAddressFromBech32(req.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "authority")
+	}
+	var adminAddr sdk.AccAddress
+	if req.Admin != "" {
+		if adminAddr, err = sdk.AccAddressFromBech32(req.Admin); err != nil {
+			return nil, sdkerrors.Wrap(err, "admin")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// the authority was verified above, so the code and the contract are not restricted by the access configs
+	govKeeper := m.keeper.WithGovAuthorizationPolicy()
+	codeID, _, err := govKeeper.Create(ctx, authorityAddr, req.WASMByteCode, req.InstantiatePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.UnpinCode {
+		if err := govKeeper.PinCode(ctx, codeID); err != nil {
+			return nil, err
+		}
+	}
+
+	contractAddr, data, err := govKeeper.Instantiate(ctx, codeID, authorityAddr, adminAddr, req.Msg, req.Label, req.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStoreAndInstantiateContractResponse{
+		CodeID:  codeID,
+		Address: contractAddr.String(),
+		Data:    data,
+	}, nil
+}
+
+// RemoveCode deletes a stored code that is not used by any contract
+func (m msgServer) RemoveCode(goCtx context.Context, msg *types.MsgRemoveCode) (*types.MsgRemoveCodeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	if err := m.keeper.RemoveCode(ctx, msg.CodeID, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveCodeResponse{}, nil
+}
+
+// validateAuthority ensures that the given address is the authority of the keeper
+func (m msgServer) validateAuthority(authority string) error {
+	if expected := m.keeper.GetAuthority(); expected != authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", expected, authority)
+	}
+	return nil
+}
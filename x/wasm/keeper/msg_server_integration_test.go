@@ -3,7 +3,9 @@ package keeper_test
 import (
 	"crypto/sha256"
 	_ "embed"
+	"encoding/hex"
 	"encoding/json"
+	"strconv"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 
 	"github.com/Finschia/finschia-sdk/testutil/testdata"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/authz"
 
 	"github.com/Finschia/wasmd/app"
 	"github.com/Finschia/wasmd/x/wasm/keeper"
@@ -691,3 +694,613 @@ func TestClearAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateParams(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		src                types.MsgUpdateParams
+		expUploadConfig    types.AccessConfig
+		expInstantiateType types.AccessType
+		expErr             bool
+	}{
+		"update upload permission param": {
+			src: types.MsgUpdateParams{
+				Authority: authority,
+				Params: types.Params{
+					CodeUploadAccess:             types.AllowNobody,
+					InstantiateDefaultPermission: types.AccessTypeEverybody,
+					GasRegister:                  types.DefaultGasRegisterParams(),
+				},
+			},
+			expUploadConfig:    types.AllowNobody,
+			expInstantiateType: types.AccessTypeEverybody,
+		},
+		"update upload permission param with address": {
+			src: types.MsgUpdateParams{
+				Authority: authority,
+				Params: types.Params{
+					CodeUploadAccess:             types.AccessTypeOnlyAddress.With(myAddress),
+					InstantiateDefaultPermission: types.AccessTypeEverybody,
+					GasRegister:                  types.DefaultGasRegisterParams(),
+				},
+			},
+			expUploadConfig:    types.AccessTypeOnlyAddress.With(myAddress),
+			expInstantiateType: types.AccessTypeEverybody,
+		},
+		"update instantiate param": {
+			src: types.MsgUpdateParams{
+				Authority: authority,
+				Params: types.Params{
+					CodeUploadAccess:             types.AllowEverybody,
+					InstantiateDefaultPermission: types.AccessTypeNobody,
+					GasRegister:                  types.DefaultGasRegisterParams(),
+				},
+			},
+			expUploadConfig:    types.AllowEverybody,
+			expInstantiateType: types.AccessTypeNobody,
+		},
+		"invalid authority": {
+			src: types.MsgUpdateParams{
+				Authority: myAddress.String(),
+				Params: types.Params{
+					CodeUploadAccess:             types.AllowNobody,
+					InstantiateDefaultPermission: types.AccessTypeNobody,
+					GasRegister:                  types.DefaultGasRegisterParams(),
+				},
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// when
+			rsp, err := wasmApp.MsgServiceRouter().Handler(&spec.src)(xCtx, &spec.src)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, rsp)
+			assert.Equal(t, spec.expUploadConfig, wasmApp.WasmKeeper.GetParams(xCtx).CodeUploadAccess)
+			assert.Equal(t, spec.expInstantiateType, wasmApp.WasmKeeper.GetParams(xCtx).InstantiateDefaultPermission)
+		})
+	}
+}
+
+func TestPinCodes(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"authority can pin codes": {
+			addr:   authority,
+			expErr: false,
+		},
+		"other address cannot pin codes": {
+			addr:   myAddress.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// setup
+			_, _, sender := testdata.KeyTestPubAddr()
+			storeMsg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = sender.String()
+			})
+			rsp, err := wasmApp.MsgServiceRouter().Handler(storeMsg)(xCtx, storeMsg)
+			require.NoError(t, err)
+			var result types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+			require.False(t, wasmApp.WasmKeeper.IsPinnedCode(xCtx, result.CodeID))
+
+			// when
+			msgPinCodes := &types.MsgPinCodes{
+				Authority: spec.addr,
+				CodeIDs:   []uint64{result.CodeID},
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgPinCodes)(xCtx, msgPinCodes)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.False(t, wasmApp.WasmKeeper.IsPinnedCode(xCtx, result.CodeID))
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, wasmApp.WasmKeeper.IsPinnedCode(xCtx, result.CodeID))
+		})
+	}
+}
+
+func TestUnpinCodes(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"authority can unpin codes": {
+			addr:   authority,
+			expErr: false,
+		},
+		"other address cannot unpin codes": {
+			addr:   myAddress.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// setup
+			_, _, sender := testdata.KeyTestPubAddr()
+			storeMsg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = sender.String()
+			})
+			rsp, err := wasmApp.MsgServiceRouter().Handler(storeMsg)(xCtx, storeMsg)
+			require.NoError(t, err)
+			var result types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+
+			// pin code
+			msgPin := &types.MsgPinCodes{
+				Authority: authority,
+				CodeIDs:   []uint64{result.CodeID},
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgPin)(xCtx, msgPin)
+			require.NoError(t, err)
+			require.True(t, wasmApp.WasmKeeper.IsPinnedCode(xCtx, result.CodeID))
+
+			// when
+			msgUnpinCodes := &types.MsgUnpinCodes{
+				Authority: spec.addr,
+				CodeIDs:   []uint64{result.CodeID},
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgUnpinCodes)(xCtx, msgUnpinCodes)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.True(t, wasmApp.WasmKeeper.IsPinnedCode(xCtx, result.CodeID))
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, wasmApp.WasmKeeper.IsPinnedCode(xCtx, result.CodeID))
+		})
+	}
+}
+
+func TestSudoContract(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	type StealMsg struct {
+		Recipient string     `json:"recipient"`
+		Amount    []sdk.Coin `json:"amount"`
+	}
+
+	stealMsg := struct {
+		Steal StealMsg `json:"steal_funds"`
+	}{Steal: StealMsg{
+		Recipient: myAddress.String(),
+		Amount:    []sdk.Coin{sdk.NewInt64Coin("denom", 1)},
+	}}
+
+	stealMsgBz, err := json.Marshal(stealMsg)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		addr      string
+		expErr    bool
+		expEvents []abci.Event
+	}{
+		"authority can call sudo on a contract": {
+			addr:   authority,
+			expErr: false,
+			expEvents: []abci.Event{
+				{
+					Type: "sudo",
+					Attributes: []abci.EventAttribute{
+						{
+							Key:   []byte("_contract_address"),
+							Value: []byte("link14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sgf2vn8"),
+							Index: false,
+						},
+					},
+				},
+			},
+		},
+		"other address cannot call sudo on a contract": {
+			addr:   myAddress.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// setup
+			_, _, sender := testdata.KeyTestPubAddr()
+			msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = hackatomContract
+				m.Sender = sender.String()
+			})
+
+			// store code
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(xCtx, msg)
+			require.NoError(t, err)
+			var storeCodeResponse types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeCodeResponse))
+
+			// instantiate contract
+			initMsg := keeper.HackatomExampleInitMsg{
+				Verifier:    sender,
+				Beneficiary: myAddress,
+			}
+			initMsgBz, err := json.Marshal(initMsg)
+			require.NoError(t, err)
+
+			msgInstantiate := &types.MsgInstantiateContract{
+				Sender: sender.String(),
+				Admin:  myAddress.String(),
+				CodeID: storeCodeResponse.CodeID,
+				Label:  "test",
+				Msg:    initMsgBz,
+				Funds:  sdk.Coins{},
+			}
+			rsp, err = wasmApp.MsgServiceRouter().Handler(msgInstantiate)(xCtx, msgInstantiate)
+			require.NoError(t, err)
+			var instantiateResponse types.MsgInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &instantiateResponse))
+
+			// fund the contract so that funds can be stolen
+			contractAddr, err := sdk.AccAddressFromBech32(instantiateResponse.Address)
+			require.NoError(t, err)
+			require.NoError(t, app.FundAccount(wasmApp.BankKeeper, xCtx, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("denom", 1))))
+
+			// when
+			msgSudoContract := &types.MsgSudoContract{
+				Authority: spec.addr,
+				Msg:       stealMsgBz,
+				Contract:  instantiateResponse.Address,
+			}
+			rsp, err = wasmApp.MsgServiceRouter().Handler(msgSudoContract)(xCtx, msgSudoContract)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			// check event
+			assert.Equal(t, spec.expEvents, rsp.Events[:1])
+			assert.Equal(t, sdk.NewInt64Coin("denom", 1), wasmApp.BankKeeper.GetBalance(xCtx, myAddress, "denom"))
+		})
+	}
+}
+
+func TestStoreAndInstantiateContract(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		addr         string
+		unpinCode    bool
+		uploadAccess *types.AccessConfig
+		expErr       bool
+	}{
+		"authority can store and instantiate a pinned contract": {
+			addr: authority,
+		},
+		"authority can store and instantiate an unpinned contract": {
+			addr:      authority,
+			unpinCode: true,
+		},
+		"authority can store and instantiate when code uploads are disabled": {
+			addr:         authority,
+			uploadAccess: &types.AllowNobody,
+		},
+		"other address cannot store and instantiate a contract": {
+			addr:   myAddress.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			if spec.uploadAccess != nil {
+				params := wasmApp.WasmKeeper.GetParams(xCtx)
+				params.CodeUploadAccess = *spec.uploadAccess
+				wasmApp.WasmKeeper.SetParams(xCtx, params)
+			}
+			// when
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             spec.addr,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				UnpinCode:             spec.unpinCode,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(xCtx, msg)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var result types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+			assert.Equal(t, uint64(1), result.CodeID)
+			assert.Equal(t, !spec.unpinCode, wasmApp.WasmKeeper.IsPinnedCode(xCtx, result.CodeID))
+
+			contractAddr, err := sdk.AccAddressFromBech32(result.Address)
+			require.NoError(t, err)
+			info := wasmApp.WasmKeeper.GetContractInfo(xCtx, contractAddr)
+			require.NotNil(t, info)
+			assert.Equal(t, authority, info.Creator)
+			assert.Equal(t, myAddress.String(), info.Admin)
+		})
+	}
+}
+
+func TestUpdateInstantiateConfig(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		creator         sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr       string
+		permission *types.AccessConfig
+		expErr     bool
+		expConfig  types.AccessConfig
+	}{
+		"creator can narrow the instantiate config": {
+			addr:       creator.String(),
+			permission: &types.AllowNobody,
+			expConfig:  types.AllowNobody,
+		},
+		"creator can restrict to addresses": {
+			addr:       creator.String(),
+			permission: &types.AccessConfig{Permission: types.AccessTypeAnyOfAddresses, Addresses: []string{otherAddr.String()}},
+			expConfig:  types.AccessConfig{Permission: types.AccessTypeAnyOfAddresses, Addresses: []string{otherAddr.String()}},
+		},
+		"other address cannot update the instantiate config": {
+			addr:       otherAddr.String(),
+			permission: &types.AllowNobody,
+			expErr:     true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// setup
+			storeMsg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = creator.String()
+				m.InstantiatePermission = &types.AllowEverybody
+			})
+			rsp, err := wasmApp.MsgServiceRouter().Handler(storeMsg)(xCtx, storeMsg)
+			require.NoError(t, err)
+			var result types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+
+			// when
+			msgUpdateInstantiateConfig := &types.MsgUpdateInstantiateConfig{
+				Sender:                   spec.addr,
+				CodeID:                   result.CodeID,
+				NewInstantiatePermission: spec.permission,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgUpdateInstantiateConfig)(xCtx, msgUpdateInstantiateConfig)
+
+			// then
+			info := wasmApp.WasmKeeper.GetCodeInfo(xCtx, result.CodeID)
+			require.NotNil(t, info)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Equal(t, types.AllowEverybody, info.InstantiateConfig)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expConfig, info.InstantiateConfig)
+		})
+	}
+}
+
+func TestRemoveCode(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		creator         sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		setup  func(ctx sdk.Context, codeID uint64)
+		expErr bool
+	}{
+		"creator can remove an unused code": {
+			addr: creator.String(),
+		},
+		"authority cannot remove the code of another creator": {
+			addr:   authority,
+			expErr: true,
+		},
+		"other address cannot remove the code": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+		"pinned code cannot be removed": {
+			addr: creator.String(),
+			setup: func(ctx sdk.Context, codeID uint64) {
+				msg := &types.MsgPinCodes{Authority: authority, CodeIDs: []uint64{codeID}}
+				_, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+				require.NoError(t, err)
+			},
+			expErr: true,
+		},
+		"code used by a contract cannot be removed": {
+			addr: creator.String(),
+			setup: func(ctx sdk.Context, codeID uint64) {
+				msg := types.MsgInstantiateContractFixture(func(m *types.MsgInstantiateContract) {
+					m.Sender = creator.String()
+					m.CodeID = codeID
+					m.Msg = []byte(`{}`)
+					m.Funds = sdk.Coins{}
+				})
+				_, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+				require.NoError(t, err)
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// setup
+			storeMsg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = creator.String()
+			})
+			rsp, err := wasmApp.MsgServiceRouter().Handler(storeMsg)(xCtx, storeMsg)
+			require.NoError(t, err)
+			var result types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+			if spec.setup != nil {
+				spec.setup(xCtx, result.CodeID)
+			}
+
+			// when
+			msgRemoveCode := &types.MsgRemoveCode{
+				Sender: spec.addr,
+				CodeID: result.CodeID,
+			}
+			rsp, err = wasmApp.MsgServiceRouter().Handler(msgRemoveCode)(xCtx, msgRemoveCode)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.NotNil(t, wasmApp.WasmKeeper.GetCodeInfo(xCtx, result.CodeID))
+				return
+			}
+			require.NoError(t, err)
+			assert.Nil(t, wasmApp.WasmKeeper.GetCodeInfo(xCtx, result.CodeID))
+			expEvents := []abci.Event{{
+				Type: "remove_code",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("code_id"), Value: []byte(strconv.FormatUint(result.CodeID, 10))},
+					{Key: []byte("code_checksum"), Value: []byte(hex.EncodeToString(result.Checksum))},
+				},
+			}}
+			assert.Equal(t, expEvents, rsp.Events)
+		})
+	}
+}
+
+func TestExecuteContractWithAuthzGrant(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		_, _, granter     = testdata.KeyTestPubAddr()
+		_, _, grantee     = testdata.KeyTestPubAddr()
+		_, _, beneficiary = testdata.KeyTestPubAddr()
+	)
+
+	// setup
+	msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+		m.WASMByteCode = hackatomContract
+		m.Sender = granter.String()
+	})
+	rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+	var storeCodeResponse types.MsgStoreCodeResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeCodeResponse))
+
+	initMsgBz, err := json.Marshal(keeper.HackatomExampleInitMsg{Verifier: granter, Beneficiary: beneficiary})
+	require.NoError(t, err)
+	msgInstantiate := types.MsgInstantiateContractFixture(func(m *types.MsgInstantiateContract) {
+		m.Sender = granter.String()
+		m.CodeID = storeCodeResponse.CodeID
+		m.Msg = initMsgBz
+		m.Funds = sdk.Coins{}
+	})
+	rsp, err = wasmApp.MsgServiceRouter().Handler(msgInstantiate)(ctx, msgInstantiate)
+	require.NoError(t, err)
+	var instantiateResponse types.MsgInstantiateContractResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &instantiateResponse))
+	contractAddr := sdk.MustAccAddressFromBech32(instantiateResponse.Address)
+	require.NoError(t, app.FundAccount(wasmApp.BankKeeper, ctx, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("denom", 1))))
+
+	// grant a single "release" call to the grantee
+	grant, err := types.NewContractGrant(contractAddr, types.NewMaxCallsLimit(1), types.NewAcceptedMessageKeysFilter("release"))
+	require.NoError(t, err)
+	authorization := types.NewContractExecutionAuthorization(*grant)
+	require.NoError(t, wasmApp.AuthzKeeper.SaveGrant(ctx, grantee, granter, authorization, ctx.BlockTime().Add(time.Hour)))
+
+	execMsg := &types.MsgExecuteContract{
+		Sender:   granter.String(),
+		Contract: contractAddr.String(),
+		Msg:      []byte(`{"release":{}}`),
+		Funds:    sdk.Coins{},
+	}
+
+	// when
+	msgExec := authz.NewMsgExec(grantee, []sdk.Msg{execMsg})
+	_, err = wasmApp.MsgServiceRouter().Handler(&msgExec)(ctx, &msgExec)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)), wasmApp.BankKeeper.GetAllBalances(ctx, beneficiary))
+	// limit is used up and the grant removed
+	got, _ := wasmApp.AuthzKeeper.GetCleanAuthorization(ctx, grantee, granter, authorization.MsgTypeURL())
+	assert.Nil(t, got)
+	_, err = wasmApp.MsgServiceRouter().Handler(&msgExec)(ctx, &msgExec)
+	require.Error(t, err)
+}
@@ -111,29 +111,39 @@ func WithAccountPruner(x AccountPruner) Option {
 	})
 }
 
+// WithContractAccessChecker is an optional constructor parameter to set a custom type that can reject calls into
+// contracts before the wasm VM is invoked
+func WithContractAccessChecker(x ContractAccessChecker) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.accessChecker = x
+	})
+}
+
 func WithVMCacheMetrics(r prometheus.Registerer) Option {
 	return optsFn(func(k *Keeper) {
 		NewWasmVMCacheMetricsCollector(k.wasmVM).Register(r)
 	})
 }
 
-// WithGasRegister set a new gas register to implement custom gas costs.
-// When the "gas multiplier" for wasmvm gas conversion is modified inside the new register,
-// make sure to also use `WithApiCosts` option for non default values
+// WithGasRegister is not supported anymore. The gas costs are read from the gas register params
+// so that they can be changed by governance; a custom register would silently ignore them.
+// The keeper constructor panics when this option is used.
 func WithGasRegister(x GasRegister) Option {
 	if x == nil {
 		panic("must not be nil")
 	}
 	return optsFn(func(k *Keeper) {
-		k.gasRegister = x
+		panic("custom gas register conflicts with the gas register params: change the gas costs with the params instead")
 	})
 }
 
 // WithAPICosts sets custom api costs. Amounts are in cosmwasm gas Not SDK gas.
 func WithAPICosts(human, canonical uint64) Option {
-	return optsFn(func(_ *Keeper) {
-		costHumanize = human
-		costCanonical = canonical
+	return optsFn(func(k *Keeper) {
+		k.apiCosts = &apiCosts{humanize: human, canonical: canonical}
 	})
 }
 
@@ -144,6 +154,17 @@ func WithMaxQueryStackSize(m uint32) Option {
 	})
 }
 
+// WithStargateMsgFilter sets the filter for the stargate messages dispatched by contracts.
+// The filter is used as long as no filter is set in the params. By default, only the message size is limited.
+func WithStargateMsgFilter(f types.StargateMsgFilter) Option {
+	if err := f.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	return optsFn(func(k *Keeper) {
+		k.stargateMsgFilter = f
+	})
+}
+
 // WithAcceptedAccountTypesOnContractInstantiation sets the accepted account types. Account types of this list won't be overwritten or cause a failure
 // when they exist for an address on contract instantiation.
 //
@@ -12,6 +12,7 @@ import (
 	vestingtypes "github.com/Finschia/finschia-sdk/x/auth/vesting/types"
 	bankpluskeeper "github.com/Finschia/finschia-sdk/x/bankplus/keeper"
 	distributionkeeper "github.com/Finschia/finschia-sdk/x/distribution/keeper"
+	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
 	paramtypes "github.com/Finschia/finschia-sdk/x/params/types"
 	stakingkeeper "github.com/Finschia/finschia-sdk/x/staking/keeper"
 
@@ -66,18 +67,10 @@ func TestConstructorOptions(t *testing.T) {
 				assert.IsType(t, &wasmtesting.MockCoinTransferrer{}, k.bank)
 			},
 		},
-		"costs": {
-			srcOpt: WithGasRegister(&wasmtesting.MockGasRegister{}),
-			verify: func(t *testing.T, k Keeper) {
-				assert.IsType(t, &wasmtesting.MockGasRegister{}, k.gasRegister)
-			},
-		},
 		"api costs": {
 			srcOpt: WithAPICosts(1, 2),
 			verify: func(t *testing.T, k Keeper) {
-				t.Cleanup(setApiDefaults)
-				assert.Equal(t, uint64(1), costHumanize)
-				assert.Equal(t, uint64(2), costCanonical)
+				assert.Equal(t, &apiCosts{humanize: 1, canonical: 2}, k.apiCosts)
 			},
 		},
 		"max recursion query limit": {
@@ -86,6 +79,12 @@ func TestConstructorOptions(t *testing.T) {
 				assert.IsType(t, uint32(1), k.maxQueryStackSize)
 			},
 		},
+		"stargate msg filter": {
+			srcOpt: WithStargateMsgFilter(types.StargateMsgFilter{DenyList: []string{"/cosmos.bank.v1beta1.MsgSend"}}),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, types.StargateMsgFilter{DenyList: []string{"/cosmos.bank.v1beta1.MsgSend"}}, k.stargateMsgFilter)
+			},
+		},
 		"accepted account types": {
 			srcOpt: WithAcceptedAccountTypesOnContractInstantiation(&authtypes.BaseAccount{}, &vestingtypes.ContinuousVestingAccount{}),
 			verify: func(t *testing.T, k Keeper) {
@@ -102,16 +101,23 @@ func TestConstructorOptions(t *testing.T) {
 				assert.Equal(t, VestingCoinBurner{}, k.accountPruner)
 			},
 		},
+		"contract access checker": {
+			srcOpt: WithContractAccessChecker(allowAllContractAccess{}),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, allowAllContractAccess{}, k.accessChecker)
+			},
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			k := NewKeeper(nil, nil, paramtypes.NewSubspace(nil, nil, nil, nil, ""), authkeeper.AccountKeeper{}, bankpluskeeper.BaseKeeper{}, stakingkeeper.Keeper{}, distributionkeeper.Keeper{}, nil, nil, nil, nil, nil, nil, "tempDir", types.DefaultWasmConfig(), AvailableCapabilities, spec.srcOpt)
+			k := NewKeeper(nil, nil, paramtypes.NewSubspace(nil, nil, nil, nil, ""), authkeeper.AccountKeeper{}, bankpluskeeper.BaseKeeper{}, stakingkeeper.Keeper{}, distributionkeeper.Keeper{}, nil, nil, nil, nil, nil, nil, "tempDir", types.DefaultWasmConfig(), AvailableCapabilities, authtypes.NewModuleAddress(govtypes.ModuleName).String(), spec.srcOpt)
 			spec.verify(t, k)
 		})
 	}
 }
 
-func setApiDefaults() {
-	costHumanize = DefaultGasCostHumanAddress * DefaultGasMultiplier
-	costCanonical = DefaultGasCostCanonicalAddress * DefaultGasMultiplier
+func TestWithGasRegisterConflictsWithParams(t *testing.T) {
+	assert.Panics(t, func() {
+		NewKeeper(nil, nil, paramtypes.NewSubspace(nil, nil, nil, nil, ""), authkeeper.AccountKeeper{}, bankpluskeeper.BaseKeeper{}, stakingkeeper.Keeper{}, distributionkeeper.Keeper{}, nil, nil, nil, nil, nil, nil, "tempDir", types.DefaultWasmConfig(), AvailableCapabilities, authtypes.NewModuleAddress(govtypes.ModuleName).String(), WithGasRegister(&wasmtesting.MockGasRegister{}))
+	})
 }
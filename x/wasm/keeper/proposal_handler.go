@@ -49,6 +49,12 @@ func NewWasmProposalHandlerX(k types.ContractOpsKeeper, enabledProposalTypes []t
 			return handleUnpinCodesProposal(ctx, k, *c)
 		case *types.UpdateInstantiateConfigProposal:
 			return handleUpdateInstantiateConfigProposal(ctx, k, *c)
+		case *types.SetContractStorageQuotaProposal:
+			return handleSetContractStorageQuotaProposal(ctx, k, *c)
+		case *types.StoreAndInstantiateContractProposal:
+			return handleStoreAndInstantiateContractProposal(ctx, k, *c)
+		case *types.UpdateParamsProposal:
+			return handleUpdateParamsProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -64,7 +70,7 @@ func handleStoreCodeProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types
 	if err != nil {
 		return sdkerrors.Wrap(err, "run as address")
 	}
-	codeID, _, err := k.Create(ctx, runAsAddr, p.WASMByteCode, p.InstantiatePermission)
+	codeID, _, err := k.CreateWithVerificationInfo(ctx, runAsAddr, p.WASMByteCode, p.InstantiatePermission, p.Source, p.Builder, p.CodeHash)
 	if err != nil {
 		return err
 	}
@@ -131,18 +137,19 @@ func handleSudoProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.Sudo
 		return err
 	}
 
-	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
-	if err != nil {
-		return sdkerrors.Wrap(err, "contract")
+	msg := &types.MsgSudoContract{
+		Authority: k.GetAuthority(),
+		Contract:  p.Contract,
+		Msg:       p.Msg,
 	}
-	data, err := k.Sudo(ctx, contractAddr, p.Msg)
+	res, err := NewMsgServerImpl(k).SudoContract(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
 		return err
 	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeGovContractResult,
-		sdk.NewAttribute(types.AttributeKeyResultDataHex, hex.EncodeToString(data)),
+		sdk.NewAttribute(types.AttributeKeyResultDataHex, hex.EncodeToString(res.Data)),
 	))
 	return nil
 }
@@ -207,24 +214,26 @@ func handlePinCodesProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.
 	if err := p.ValidateBasic(); err != nil {
 		return err
 	}
-	for _, v := range p.CodeIDs {
-		if err := k.PinCode(ctx, v); err != nil {
-			return sdkerrors.Wrapf(err, "code id: %d", v)
-		}
+
+	msg := &types.MsgPinCodes{
+		Authority: k.GetAuthority(),
+		CodeIDs:   p.CodeIDs,
 	}
-	return nil
+	_, err := NewMsgServerImpl(k).PinCodes(sdk.WrapSDKContext(ctx), msg)
+	return err
 }
 
 func handleUnpinCodesProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.UnpinCodesProposal) error {
 	if err := p.ValidateBasic(); err != nil {
 		return err
 	}
-	for _, v := range p.CodeIDs {
-		if err := k.UnpinCode(ctx, v); err != nil {
-			return sdkerrors.Wrapf(err, "code id: %d", v)
-		}
+
+	msg := &types.MsgUnpinCodes{
+		Authority: k.GetAuthority(),
+		CodeIDs:   p.CodeIDs,
 	}
-	return nil
+	_, err := NewMsgServerImpl(k).UnpinCodes(sdk.WrapSDKContext(ctx), msg)
+	return err
 }
 
 func handleUpdateInstantiateConfigProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.UpdateInstantiateConfigProposal) error {
@@ -232,11 +241,74 @@ func handleUpdateInstantiateConfigProposal(ctx sdk.Context, k types.ContractOpsK
 		return err
 	}
 
-	var emptyCaller sdk.AccAddress
+	msgServer := NewMsgServerImpl(k)
 	for _, accessConfigUpdate := range p.AccessConfigUpdates {
-		if err := k.SetAccessConfig(ctx, accessConfigUpdate.CodeID, emptyCaller, accessConfigUpdate.InstantiatePermission); err != nil {
+		msg := &types.MsgUpdateInstantiateConfig{
+			Sender:                   k.GetAuthority(),
+			CodeID:                   accessConfigUpdate.CodeID,
+			NewInstantiatePermission: &accessConfigUpdate.InstantiatePermission,
+		}
+		if _, err := msgServer.UpdateInstantiateConfig(sdk.WrapSDKContext(ctx), msg); err != nil {
 			return sdkerrors.Wrapf(err, "code id: %d", accessConfigUpdate.CodeID)
 		}
 	}
 	return nil
 }
+
+func handleSetContractStorageQuotaProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.SetContractStorageQuotaProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	quota := types.StorageQuota{MaxContractBytes: p.MaxContractBytes}
+	for _, contract := range p.Contracts {
+		contractAddr, err := sdk.AccAddressFromBech32(contract)
+		if err != nil {
+			return sdkerrors.Wrap(err, "contract")
+		}
+		if err := k.SetContractStorageQuota(ctx, contractAddr, quota); err != nil {
+			return sdkerrors.Wrapf(err, "contract: %s", contract)
+		}
+	}
+	return nil
+}
+
+func handleStoreAndInstantiateContractProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.StoreAndInstantiateContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msg := &types.MsgStoreAndInstantiateContract{
+		Authority:             k.GetAuthority(),
+		WASMByteCode:          p.WASMByteCode,
+		InstantiatePermission: p.InstantiatePermission,
+		UnpinCode:             p.UnpinCode,
+		Admin:                 p.Admin,
+		Label:                 p.Label,
+		Msg:                   p.Msg,
+		Funds:                 p.Funds,
+	}
+	res, err := NewMsgServerImpl(k).StoreAndInstantiateContract(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGovContractResult,
+		sdk.NewAttribute(types.AttributeKeyResultDataHex, hex.EncodeToString(res.Data)),
+	))
+	return nil
+}
+
+func handleUpdateParamsProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.UpdateParamsProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msg := &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    p.Params,
+	}
+	_, err := NewMsgServerImpl(k).UpdateParams(sdk.WrapSDKContext(ctx), msg)
+	return err
+}
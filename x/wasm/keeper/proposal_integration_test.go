@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -27,13 +28,24 @@ func TestStoreCodeProposal(t *testing.T) {
 	wasmKeeper.SetParams(parentCtx, types.Params{
 		CodeUploadAccess:             types.AllowNobody,
 		InstantiateDefaultPermission: types.AccessTypeNobody,
+		GasRegister:                  types.DefaultGasRegisterParams(),
 	})
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
+	checksum := sha256.Sum256(wasmCode)
+	const (
+		source  = "https://github.com/CosmWasm/wasmd/blob/main/x/wasm/keeper/testdata/hackatom.wasm"
+		builder = "cosmwasm/workspace-optimizer:v0.12.8"
+	)
+
 	specs := map[string]struct {
 		codeID    int64
 		unpinCode bool
+		source    string
+		builder   string
+		codeHash  []byte
+		expErr    bool
 	}{
 		"upload with pinning (default)": {
 			unpinCode: false,
@@ -41,6 +53,17 @@ func TestStoreCodeProposal(t *testing.T) {
 		"upload with code unpin": {
 			unpinCode: true,
 		},
+		"upload with verification info": {
+			source:   source,
+			builder:  builder,
+			codeHash: checksum[:],
+		},
+		"upload with code hash mismatch": {
+			source:   source,
+			builder:  builder,
+			codeHash: bytes.Repeat([]byte{0x1}, sha256.Size),
+			expErr:   true,
+		},
 	}
 
 	for msg, spec := range specs {
@@ -52,10 +75,17 @@ func TestStoreCodeProposal(t *testing.T) {
 				p.RunAs = myActorAddress
 				p.WASMByteCode = wasmCode
 				p.UnpinCode = spec.unpinCode
+				p.Source = spec.source
+				p.Builder = spec.builder
+				p.CodeHash = spec.codeHash
 			})
 
 			// when stored
 			storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
 			require.NoError(t, err)
 
 			// and proposal execute
@@ -68,6 +98,8 @@ func TestStoreCodeProposal(t *testing.T) {
 			require.NotNil(t, cInfo)
 			assert.Equal(t, myActorAddress, cInfo.Creator)
 			assert.Equal(t, !spec.unpinCode, wasmKeeper.IsPinnedCode(ctx, 1))
+			assert.Equal(t, spec.source, cInfo.Source)
+			assert.Equal(t, spec.builder, cInfo.Builder)
 
 			storedCode, err := wasmKeeper.GetByteCode(ctx, 1)
 			require.NoError(t, err)
@@ -82,6 +114,7 @@ func TestInstantiateProposal(t *testing.T) {
 	wasmKeeper.SetParams(ctx, types.Params{
 		CodeUploadAccess:             types.AllowNobody,
 		InstantiateDefaultPermission: types.AccessTypeNobody,
+		GasRegister:                  types.DefaultGasRegisterParams(),
 	})
 
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
@@ -147,6 +180,7 @@ func TestInstantiateProposal_NoAdmin(t *testing.T) {
 	wasmKeeper.SetParams(ctx, types.Params{
 		CodeUploadAccess:             types.AllowNobody,
 		InstantiateDefaultPermission: types.AccessTypeNobody,
+		GasRegister:                  types.DefaultGasRegisterParams(),
 	})
 
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
@@ -219,6 +253,7 @@ func TestMigrateProposal(t *testing.T) {
 	wasmKeeper.SetParams(ctx, types.Params{
 		CodeUploadAccess:             types.AllowNobody,
 		InstantiateDefaultPermission: types.AccessTypeNobody,
+		GasRegister:                  types.DefaultGasRegisterParams(),
 	})
 
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
@@ -470,6 +505,7 @@ func TestAdminProposals(t *testing.T) {
 			wasmKeeper.SetParams(ctx, types.Params{
 				CodeUploadAccess:             types.AllowNobody,
 				InstantiateDefaultPermission: types.AccessTypeNobody,
+				GasRegister:                  types.DefaultGasRegisterParams(),
 			})
 
 			codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
@@ -859,3 +895,180 @@ func TestUpdateInstantiateConfigProposal(t *testing.T) {
 		})
 	}
 }
+
+func TestSetContractStorageQuotaProposal(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	otherExample := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+
+	specs := map[string]struct {
+		srcContracts []sdk.AccAddress
+		expErr       bool
+	}{
+		"one contract": {
+			srcContracts: []sdk.AccAddress{example.Contract},
+		},
+		"multiple contracts": {
+			srcContracts: []sdk.AccAddress{example.Contract, otherExample.Contract},
+		},
+		"non existing contract": {
+			srcContracts: []sdk.AccAddress{example.Contract, RandomAccountAddress(t)},
+			expErr:       true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			contracts := make([]string, len(spec.srcContracts))
+			for i, c := range spec.srcContracts {
+				contracts[i] = c.String()
+			}
+			proposal := types.SetContractStorageQuotaProposal{
+				Title:            "Foo",
+				Description:      "Bar",
+				Contracts:        contracts,
+				MaxContractBytes: 1024,
+			}
+
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, &proposal)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			gotErr = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, gotErr)
+
+			// then
+			for _, c := range spec.srcContracts {
+				quota, found := wasmKeeper.GetContractStorageQuota(ctx, c)
+				require.True(t, found)
+				assert.Equal(t, types.StorageQuota{MaxContractBytes: 1024}, quota)
+			}
+		})
+	}
+}
+
+func TestStoreAndInstantiateContractProposal(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	wasmKeeper.SetParams(parentCtx, types.Params{
+		CodeUploadAccess:             types.AllowNobody,
+		InstantiateDefaultPermission: types.AccessTypeNobody,
+		GasRegister:                  types.DefaultGasRegisterParams(),
+	})
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	var (
+		oneAddress   sdk.AccAddress = bytes.Repeat([]byte{0x1}, types.ContractAddrLen)
+		otherAddress sdk.AccAddress = bytes.Repeat([]byte{0x2}, types.ContractAddrLen)
+	)
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: oneAddress, Beneficiary: otherAddress})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		unpinCode bool
+	}{
+		"store and instantiate with pinning (default)": {
+			unpinCode: false,
+		},
+		"store and instantiate with code unpin": {
+			unpinCode: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			src := types.StoreAndInstantiateContractProposalFixture(func(p *types.StoreAndInstantiateContractProposal) {
+				p.WASMByteCode = wasmCode
+				p.UnpinCode = spec.unpinCode
+				p.Admin = otherAddress.String()
+				p.Msg = initMsgBz
+			})
+			em := sdk.NewEventManager()
+
+			// when stored
+			storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+			require.NoError(t, err)
+
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			err = handler(ctx.WithEventManager(em), storedProposal.GetContent())
+			require.NoError(t, err)
+
+			// then
+			cInfo := wasmKeeper.GetCodeInfo(ctx, 1)
+			require.NotNil(t, cInfo)
+			assert.Equal(t, wasmKeeper.GetAuthority(), cInfo.Creator)
+			assert.Equal(t, !spec.unpinCode, wasmKeeper.IsPinnedCode(ctx, 1))
+
+			var contractAddr sdk.AccAddress
+			wasmKeeper.IterateContractsByCode(ctx, 1, func(addr sdk.AccAddress) bool {
+				contractAddr = addr
+				return true
+			})
+			require.NotNil(t, contractAddr)
+			contractInfo := wasmKeeper.GetContractInfo(ctx, contractAddr)
+			require.NotNil(t, contractInfo)
+			assert.Equal(t, wasmKeeper.GetAuthority(), contractInfo.Creator)
+			assert.Equal(t, otherAddress.String(), contractInfo.Admin)
+			assert.Equal(t, "testing", contractInfo.Label)
+			// and event
+			require.Equal(t, types.EventTypeGovContractResult, em.Events()[len(em.Events())-1].Type)
+		})
+	}
+}
+
+func TestUpdateWasmParamsProposal(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	var myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+
+	specs := map[string]struct {
+		src    types.Params
+		expErr bool
+	}{
+		"update all params": {
+			src: types.Params{
+				CodeUploadAccess:             types.AccessTypeOnlyAddress.With(myAddress),
+				InstantiateDefaultPermission: types.AccessTypeNobody,
+				GasRegister:                  types.DefaultGasRegisterParams(),
+			},
+		},
+		"invalid params": {
+			src:    types.Params{},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			src := types.UpdateParamsProposalFixture(func(p *types.UpdateParamsProposal) {
+				p.Params = spec.src
+			})
+
+			// when stored
+			storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			err = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, spec.src, wasmKeeper.GetParams(ctx))
+		})
+	}
+}
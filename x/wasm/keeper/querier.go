@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
 	"runtime/debug"
 
 	"google.golang.org/grpc/codes"
@@ -122,9 +125,23 @@ func (q grpcQuerier) AllContractState(c context.Context, req *types.QueryAllCont
 		return nil, types.ErrNotFound
 	}
 
+	if len(req.StartKey) != 0 && len(req.EndKey) != 0 && bytes.Compare(req.StartKey, req.EndKey) > 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "start key must not be greater than end key")
+	}
+	pageReq := req.Pagination
+	if req.Reverse {
+		var reversed query.PageRequest
+		if pageReq != nil {
+			reversed = *pageReq
+		}
+		reversed.Reverse = true
+		pageReq = &reversed
+	}
+
 	r := make([]types.Model, 0)
 	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractStorePrefix(contractAddr))
-	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+	rangeStore := newKeyRangeStore(prefixStore, req.Prefix, req.StartKey, req.EndKey)
+	pageRes, err := query.FilteredPaginate(rangeStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		if accumulate {
 			r = append(r, types.Model{
 				Key:   key,
@@ -142,6 +159,24 @@ func (q grpcQuerier) AllContractState(c context.Context, req *types.QueryAllCont
 	}, nil
 }
 
+func (q grpcQuerier) ContractStorageUsage(c context.Context, req *types.QueryContractStorageUsageRequest) (*types.QueryContractStorageUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryContractStorageUsageResponse{
+		Address:      req.Address,
+		StorageUsage: q.keeper.GetContractStorageUsage(ctx, contractAddr),
+	}, nil
+}
+
 func (q grpcQuerier) RawContractState(c context.Context, req *types.QueryRawContractStateRequest) (*types.QueryRawContractStateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -160,10 +195,90 @@ func (q grpcQuerier) RawContractState(c context.Context, req *types.QueryRawCont
 	return &types.QueryRawContractStateResponse{Data: rsp}, nil
 }
 
-func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (rsp *types.QuerySmartContractStateResponse, err error) {
+// maxRawContractStatesKeys is the maximum number of keys of a single RawContractStates query
+const maxRawContractStatesKeys = 100
+
+func (q grpcQuerier) RawContractStates(c context.Context, req *types.QueryRawContractStatesRequest) (*types.QueryRawContractStatesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
+	switch n := len(req.Keys); {
+	case n == 0:
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "keys")
+	case n > maxRawContractStatesKeys:
+		return nil, sdkerrors.Wrapf(types.ErrLimit, "max %d keys", maxRawContractStatesKeys)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+	r := make([]types.Model, len(req.Keys))
+	for i, key := range req.Keys {
+		r[i] = types.Model{
+			Key:   key,
+			Value: q.keeper.QueryRaw(ctx, contractAddr, key),
+		}
+	}
+	return &types.QueryRawContractStatesResponse{Models: r}, nil
+}
+
+func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (*types.QuerySmartContractStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.queryGasLimit))
+	bz, err := q.smartQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySmartContractStateResponse{Data: bz}, nil
+}
+
+// maxBatchSmartQueries is the maximum number of queries of a single BatchSmartContractState query
+const maxBatchSmartQueries = 50
+
+// BatchSmartContractState runs the smart queries on the same context. All queries share the gas limit of a single
+// smart query. A failing query does not abort the batch but is reported in its result.
+func (q grpcQuerier) BatchSmartContractState(c context.Context, req *types.QueryBatchSmartContractStateRequest) (*types.QueryBatchSmartContractStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	switch n := len(req.Queries); {
+	case n == 0:
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "queries")
+	case n > maxBatchSmartQueries:
+		return nil, sdkerrors.Wrapf(types.ErrLimit, "max %d queries", maxBatchSmartQueries)
+	}
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.queryGasLimit))
+
+	results := make([]types.SmartContractStateResult, len(req.Queries))
+	for i := range req.Queries {
+		results[i].Address = req.Queries[i].Address
+		if ctx.GasMeter().IsOutOfGas() {
+			results[i].Error = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "gas budget of the batch exhausted").Error()
+			continue
+		}
+		bz, err := q.smartQuery(ctx, &req.Queries[i])
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Data = bz
+	}
+	return &types.QueryBatchSmartContractStateResponse{
+		Results: results,
+		GasUsed: ctx.GasMeter().GasConsumedToLimit(),
+	}, nil
+}
+
+// smartQuery runs a smart query with the gas meter of the context and recovers from an out of gas panic
+func (q grpcQuerier) smartQuery(ctx sdk.Context, req *types.QuerySmartContractStateRequest) (bz []byte, err error) {
 	if err := req.QueryData.ValidateBasic(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid query data")
 	}
@@ -171,7 +286,6 @@ func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 	if err != nil {
 		return nil, err
 	}
-	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.queryGasLimit))
 	// recover from out-of-gas panic
 	defer func() {
 		if r := recover(); r != nil {
@@ -184,7 +298,7 @@ func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 			default:
 				err = sdkerrors.ErrPanic
 			}
-			rsp = nil
+			bz = nil
 			moduleLogger(ctx).
 				Debug("smart query contract",
 					"error", "recovering panic",
@@ -193,14 +307,59 @@ func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 		}
 	}()
 
-	bz, err := q.keeper.QuerySmart(ctx, contractAddr, req.QueryData)
+	bz, err = q.keeper.QuerySmart(ctx, contractAddr, req.QueryData)
 	switch {
 	case err != nil:
 		return nil, err
 	case bz == nil:
 		return nil, types.ErrNotFound
 	}
-	return &types.QuerySmartContractStateResponse{Data: bz}, nil
+	return bz, nil
+}
+
+func (q grpcQuerier) SimulateExecuteContract(c context.Context, req *types.QuerySimulateExecuteContractRequest) (rsp *types.QuerySimulateExecuteContractResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	// the view keeper is read only, the simulation runs contract code
+	simulator, ok := q.keeper.(types.SimulationKeeper)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "simulation not supported")
+	}
+	if err := req.Msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid msg")
+	}
+	if !req.Funds.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid funds")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	// recover from out-of-gas panic
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", rType.Descriptor)
+			default:
+				err = sdkerrors.ErrPanic
+			}
+			rsp = nil
+			moduleLogger(ctx).
+				Debug("simulate execute contract",
+					"error", "recovering panic",
+					"contract-address", req.Address,
+					"stacktrace", string(debug.Stack()))
+		}
+	}()
+
+	return simulator.SimulateExecute(ctx, contractAddr, senderAddr, req.Msg, req.Funds, req.Trace)
 }
 
 func (q grpcQuerier) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
@@ -241,6 +400,8 @@ func (q grpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 				Creator:               c.Creator,
 				DataHash:              c.CodeHash,
 				InstantiatePermission: c.InstantiateConfig,
+				Source:                c.Source,
+				Builder:               c.Builder,
 			})
 		}
 		return true, nil
@@ -259,6 +420,7 @@ func queryContractInfo(ctx sdk.Context, addr sdk.AccAddress, keeper types.ViewKe
 	return &types.QueryContractInfoResponse{
 		Address:      addr.String(),
 		ContractInfo: *info,
+		StorageUsage: keeper.GetContractStorageUsage(ctx, addr),
 	}, nil
 }
 
@@ -276,6 +438,8 @@ func queryCode(ctx sdk.Context, codeID uint64, keeper types.ViewKeeper) (*types.
 		Creator:               res.Creator,
 		DataHash:              res.CodeHash,
 		InstantiatePermission: res.InstantiateConfig,
+		Source:                res.Source,
+		Builder:               res.Builder,
 	}
 
 	code, err := keeper.GetByteCode(ctx, codeID)
@@ -315,3 +479,132 @@ func (q grpcQuerier) Params(c context.Context, req *types.QueryParamsRequest) (*
 	params := q.keeper.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+func (q grpcQuerier) StargateQueryAcceptList(c context.Context, req *types.QueryStargateQueryAcceptListRequest) (*types.QueryStargateQueryAcceptListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryStargateQueryAcceptListResponse{
+		Entries: q.keeper.GetStargateQueryAcceptList(ctx),
+	}, nil
+}
+
+func (q grpcQuerier) StargateMsgFilter(c context.Context, req *types.QueryStargateMsgFilterRequest) (*types.QueryStargateMsgFilterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryStargateMsgFilterResponse{
+		Filter: q.keeper.GetStargateMsgFilter(ctx),
+	}, nil
+}
+
+func (q grpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContractsByCreatorRequest) (*types.QueryContractsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]string, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractsByCreatorPrefix(creatorAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var contractAddr sdk.AccAddress = key[types.AbsoluteTxPositionLen:]
+			r = append(r, contractAddr.String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractsByCreatorResponse{
+		ContractAddresses: r,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q grpcQuerier) CodeInfoByChecksum(c context.Context, req *types.QueryCodeInfoByChecksumRequest) (*types.QueryCodeInfoByChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil || len(checksum) != sha256.Size {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "checksum")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.CodeInfoResponse, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetCodeIDsByChecksumPrefix(checksum))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		codeID := binary.BigEndian.Uint64(iter.Key())
+		info := q.keeper.GetCodeInfo(ctx, codeID)
+		if info == nil {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "code info for code id %d", codeID)
+		}
+		r = append(r, types.CodeInfoResponse{
+			CodeID:                codeID,
+			Creator:               info.Creator,
+			DataHash:              info.CodeHash,
+			InstantiatePermission: info.InstantiateConfig,
+			Source:                info.Source,
+			Builder:               info.Builder,
+		})
+	}
+	if len(r) == 0 {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryCodeInfoByChecksumResponse{CodeInfos: r}, nil
+}
+
+// keyRangeStore limits the iterators of the parent store to the keys with the given prefix and to the
+// range [start, end). Nil bounds are not limited.
+type keyRangeStore struct {
+	sdk.KVStore
+	start, end []byte
+}
+
+func newKeyRangeStore(parent sdk.KVStore, keyPrefix, start, end []byte) keyRangeStore {
+	s := keyRangeStore{KVStore: parent}
+	if len(keyPrefix) != 0 {
+		s.start, s.end = keyPrefix, sdk.PrefixEndBytes(keyPrefix)
+	}
+	s.start, s.end = s.clamp(start, end)
+	return s
+}
+
+// clamp returns the intersection of the given range with the range of the store
+func (s keyRangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if len(start) == 0 {
+		start = nil
+	}
+	if len(end) == 0 {
+		end = nil
+	}
+	if s.start != nil && (start == nil || bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		// empty range
+		start = end
+	}
+	return start, end
+}
+
+func (s keyRangeStore) Iterator(start, end []byte) sdk.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s keyRangeStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
@@ -2,16 +2,19 @@ package keeper
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cosmos/btcutil/bech32"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -138,6 +141,120 @@ func TestQueryAllContractState(t *testing.T) {
 	}
 }
 
+func TestQueryAllContractStateFilters(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract
+	// keys of the cw-storage-plus maps "foo" and "bar"
+	fooKey := func(k string) []byte { return append([]byte{0x0, 0x3, 'f', 'o', 'o'}, k...) }
+	barKey := func(k string) []byte { return append([]byte{0x0, 0x3, 'b', 'a', 'r'}, k...) }
+	require.NoError(t, keeper.importContractState(ctx, contractAddr, []types.Model{
+		{Key: fooKey("a"), Value: []byte(`1`)},
+		{Key: fooKey("b"), Value: []byte(`2`)},
+		{Key: fooKey("c"), Value: []byte(`3`)},
+		{Key: barKey("a"), Value: []byte(`4`)},
+	}))
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery   *types.QueryAllContractStateRequest
+		expKeys    [][]byte
+		expNextKey []byte
+		expErr     error
+	}{
+		"all": {
+			srcQuery: &types.QueryAllContractStateRequest{},
+			expKeys:  [][]byte{barKey("a"), fooKey("a"), fooKey("b"), fooKey("c"), []byte("config")},
+		},
+		"prefix": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey("")},
+			expKeys:  [][]byte{fooKey("a"), fooKey("b"), fooKey("c")},
+		},
+		"prefix reverse": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey(""), Reverse: true},
+			expKeys:  [][]byte{fooKey("c"), fooKey("b"), fooKey("a")},
+		},
+		"prefix without entries": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: []byte("unknown")},
+			expKeys:  [][]byte{},
+		},
+		"start key": {
+			srcQuery: &types.QueryAllContractStateRequest{StartKey: fooKey("b")},
+			expKeys:  [][]byte{fooKey("b"), fooKey("c"), []byte("config")},
+		},
+		"end key": {
+			srcQuery: &types.QueryAllContractStateRequest{EndKey: fooKey("b")},
+			expKeys:  [][]byte{barKey("a"), fooKey("a")},
+		},
+		"start and end key": {
+			srcQuery: &types.QueryAllContractStateRequest{StartKey: fooKey("a"), EndKey: fooKey("c")},
+			expKeys:  [][]byte{fooKey("a"), fooKey("b")},
+		},
+		"start and end key reverse": {
+			srcQuery: &types.QueryAllContractStateRequest{StartKey: fooKey("a"), EndKey: fooKey("c"), Reverse: true},
+			expKeys:  [][]byte{fooKey("b"), fooKey("a")},
+		},
+		"prefix with start key outside of prefix": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey(""), StartKey: barKey("a")},
+			expKeys:  [][]byte{fooKey("a"), fooKey("b"), fooKey("c")},
+		},
+		"prefix with end key": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey(""), EndKey: fooKey("c")},
+			expKeys:  [][]byte{fooKey("a"), fooKey("b")},
+		},
+		"prefix with range outside of prefix": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey(""), StartKey: []byte("config")},
+			expKeys:  [][]byte{},
+		},
+		"prefix with pagination limit": {
+			srcQuery: &types.QueryAllContractStateRequest{
+				Prefix:     fooKey(""),
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expKeys:    [][]byte{fooKey("a"), fooKey("b")},
+			expNextKey: fooKey("c"),
+		},
+		"prefix with pagination next key": {
+			srcQuery: &types.QueryAllContractStateRequest{
+				Prefix:     fooKey(""),
+				Pagination: &query.PageRequest{Key: fooKey("c")},
+			},
+			expKeys: [][]byte{fooKey("c")},
+		},
+		"prefix reverse with pagination next key": {
+			srcQuery: &types.QueryAllContractStateRequest{
+				Prefix:     fooKey(""),
+				Reverse:    true,
+				Pagination: &query.PageRequest{Key: fooKey("b")},
+			},
+			expKeys: [][]byte{fooKey("b"), fooKey("a")},
+		},
+		"start key greater than end key": {
+			srcQuery: &types.QueryAllContractStateRequest{StartKey: fooKey("c"), EndKey: fooKey("a")},
+			expErr:   types.ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			spec.srcQuery.Address = contractAddr.String()
+			got, err := q.AllContractState(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+			gotKeys := make([][]byte, len(got.Models))
+			for i, m := range got.Models {
+				gotKeys[i] = m.Key
+			}
+			assert.Equal(t, spec.expKeys, gotKeys)
+			assert.Equal(t, spec.expNextKey, got.Pagination.NextKey)
+		})
+	}
+}
+
 func TestQuerySmartContractState(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -189,6 +306,112 @@ func TestQuerySmartContractState(t *testing.T) {
 	}
 }
 
+func TestQueryBatchSmartContractState(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr, otherAddr := exampleContract.Contract.String(), otherContract.Contract.String()
+	verifierQuery := func(addr string) types.QuerySmartContractStateRequest {
+		return types.QuerySmartContractStateRequest{Address: addr, QueryData: []byte(`{"verifier":{}}`)}
+	}
+	verifierResult := func(example HackatomExampleInstance) types.SmartContractStateResult {
+		return types.SmartContractStateResult{
+			Address: example.Contract.String(),
+			Data:    []byte(fmt.Sprintf(`{"verifier":"%s"}`, example.VerifierAddr.String())),
+		}
+	}
+	unknownAddr := RandomBech32AccountAddress(t)
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery   *types.QueryBatchSmartContractStateRequest
+		expResults []types.SmartContractStateResult
+		expErr     error
+	}{
+		"query multiple contracts": {
+			srcQuery: &types.QueryBatchSmartContractStateRequest{
+				Queries: []types.QuerySmartContractStateRequest{verifierQuery(otherAddr), verifierQuery(contractAddr)},
+			},
+			expResults: []types.SmartContractStateResult{verifierResult(otherContract), verifierResult(exampleContract)},
+		},
+		"failed queries do not abort the batch": {
+			srcQuery: &types.QueryBatchSmartContractStateRequest{
+				Queries: []types.QuerySmartContractStateRequest{
+					{Address: contractAddr, QueryData: []byte(`{"raw":{"key":"config"}}`)},
+					{Address: contractAddr, QueryData: []byte(`not a json string`)},
+					verifierQuery(unknownAddr),
+					verifierQuery("abcde"),
+					verifierQuery(contractAddr),
+				},
+			},
+			expResults: []types.SmartContractStateResult{
+				{Address: contractAddr, Error: "Error parsing into type hackatom::msg::QueryMsg: unknown variant `raw`, expected one of `verifier`, `other_balance`, `recurse`, `get_int`: query wasm contract failed"},
+				{Address: contractAddr, Error: "rpc error: code = InvalidArgument desc = invalid query data"},
+				{Address: unknownAddr, Error: "contract: not found"},
+				{Address: "abcde", Error: "decoding bech32 failed: invalid bech32 string length 5"},
+				verifierResult(exampleContract),
+			},
+		},
+		"empty queries": {
+			srcQuery: &types.QueryBatchSmartContractStateRequest{},
+			expErr:   types.ErrEmpty,
+		},
+		"too many queries": {
+			srcQuery: &types.QueryBatchSmartContractStateRequest{
+				Queries: make([]types.QuerySmartContractStateRequest, maxBatchSmartQueries+1),
+			},
+			expErr: types.ErrLimit,
+		},
+		"with empty request": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.BatchSmartContractState(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			if spec.expErr != nil {
+				require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResults, got.Results)
+			assert.NotZero(t, got.GasUsed)
+		})
+	}
+}
+
+func TestQueryBatchSmartContractStateGasBudget(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	query := types.QuerySmartContractStateRequest{Address: exampleContract.Contract.String(), QueryData: []byte(`{"verifier":{}}`)}
+	single, err := Querier(keeper).BatchSmartContractState(sdk.WrapSDKContext(ctx), &types.QueryBatchSmartContractStateRequest{
+		Queries: []types.QuerySmartContractStateRequest{query},
+	})
+	require.NoError(t, err)
+	require.Empty(t, single.Results[0].Error)
+
+	// when the budget covers one and a half queries
+	gasLimit := single.GasUsed * 3 / 2
+	q := NewGrpcQuerier(keeper.cdc, keeper.storeKey, keeper, gasLimit)
+	got, err := q.BatchSmartContractState(sdk.WrapSDKContext(ctx), &types.QueryBatchSmartContractStateRequest{
+		Queries: []types.QuerySmartContractStateRequest{query, query, query},
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, got.Results, 3)
+	assert.Equal(t, single.Results[0], got.Results[0])
+	assert.Contains(t, got.Results[1].Error, "out of gas")
+	assert.Nil(t, got.Results[1].Data)
+	assert.Equal(t, "gas budget of the batch exhausted: out of gas", got.Results[2].Error)
+	assert.Equal(t, gasLimit, got.GasUsed)
+}
+
 func TestQuerySmartContractPanics(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	contractAddr := BuildContractAddressClassic(1, 1)
@@ -234,6 +457,285 @@ func TestQuerySmartContractPanics(t *testing.T) {
 	}
 }
 
+func TestQuerySimulateExecuteContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	contractBalance := keepers.BankKeeper.GetAllBalances(ctx, exampleContract.Contract)
+	require.False(t, contractBalance.IsZero())
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcReq  *types.QuerySimulateExecuteContractRequest
+		expData []byte
+		expErr  error
+	}{
+		"release by verifier": {
+			srcReq: &types.QuerySimulateExecuteContractRequest{
+				Address: contractAddr,
+				Sender:  exampleContract.VerifierAddr.String(),
+				Msg:     []byte(`{"release":{}}`),
+			},
+			expData: []byte{0xf0, 0x0b, 0xaa},
+		},
+		"release by other sender": {
+			srcReq: &types.QuerySimulateExecuteContractRequest{
+				Address: contractAddr,
+				Sender:  exampleContract.BeneficiaryAddr.String(),
+				Msg:     []byte(`{"release":{}}`),
+			},
+			expErr: types.ErrExecuteFailed,
+		},
+		"with invalid json": {
+			srcReq: &types.QuerySimulateExecuteContractRequest{
+				Address: contractAddr,
+				Sender:  exampleContract.VerifierAddr.String(),
+				Msg:     []byte(`not a json string`),
+			},
+			expErr: status.Error(codes.InvalidArgument, "invalid msg"),
+		},
+		"with invalid funds": {
+			srcReq: &types.QuerySimulateExecuteContractRequest{
+				Address: contractAddr,
+				Sender:  exampleContract.VerifierAddr.String(),
+				Msg:     []byte(`{"release":{}}`),
+				Funds:   sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdk.ZeroInt()}},
+			},
+			expErr: status.Error(codes.InvalidArgument, "invalid funds"),
+		},
+		"with unknown contract": {
+			srcReq: &types.QuerySimulateExecuteContractRequest{
+				Address: RandomBech32AccountAddress(t),
+				Sender:  exampleContract.VerifierAddr.String(),
+				Msg:     []byte(`{"release":{}}`),
+			},
+			expErr: types.ErrNotFound,
+		},
+		"with invalid sender": {
+			srcReq: &types.QuerySimulateExecuteContractRequest{
+				Address: contractAddr,
+				Sender:  "abcde",
+				Msg:     []byte(`{"release":{}}`),
+			},
+			expErr: bech32.ErrInvalidLength(5),
+		},
+		"with empty request": {
+			srcReq: nil,
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.SimulateExecuteContract(sdk.WrapSDKContext(ctx.WithIsCheckTx(true)), spec.srcReq)
+			if spec.expErr != nil {
+				require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expData, got.Data)
+			assert.NotZero(t, got.GasUsed)
+			expEvent := sdk.NewEvent(types.EventTypeExecute, sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr))
+			assert.Contains(t, got.Events, abci.Event(expEvent))
+			require.Len(t, got.Messages, 1)
+			assert.Equal(t, "never", got.Messages[0].ReplyOn)
+			assert.Contains(t, string(got.Messages[0].Msg), exampleContract.BeneficiaryAddr.String())
+			// and nothing was committed
+			assert.Equal(t, contractBalance, keepers.BankKeeper.GetAllBalances(ctx, exampleContract.Contract))
+		})
+	}
+}
+
+func TestQuerySimulateExecuteContractGasLimit(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	req := &types.QuerySimulateExecuteContractRequest{
+		Address: exampleContract.Contract.String(),
+		Sender:  exampleContract.VerifierAddr.String(),
+		Msg:     []byte(`{"release":{}}`),
+	}
+	gotRsp, err := Querier(keeper).SimulateExecuteContract(sdk.WrapSDKContext(ctx.WithIsCheckTx(true)), req)
+	require.NoError(t, err)
+
+	// when the gas of the context is lower than the gas used
+	parentCtx := ctx.WithIsCheckTx(true).WithGasMeter(sdk.NewGasMeter(gotRsp.GasUsed - 1))
+	_, err = Querier(keeper).SimulateExecuteContract(sdk.WrapSDKContext(parentCtx), req)
+
+	// then
+	require.True(t, sdkErrors.ErrOutOfGas.Is(err), "got error: %+v", err)
+	assert.Equal(t, gotRsp.GasUsed-1, parentCtx.GasMeter().GasConsumed())
+
+	// when the simulation gas limit is lower than the gas used
+	limit := gotRsp.GasUsed - 1
+	keeper.simulationGasLimit = &limit
+	_, err = Querier(keeper).SimulateExecuteContract(sdk.WrapSDKContext(ctx.WithIsCheckTx(true)), req)
+
+	// then
+	require.True(t, sdkErrors.ErrOutOfGas.Is(err), "got error: %+v", err)
+}
+
+func TestQuerySimulateExecuteContractChargesGas(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	req := &types.QuerySimulateExecuteContractRequest{
+		Address: exampleContract.Contract.String(),
+		Sender:  exampleContract.VerifierAddr.String(),
+		Msg:     []byte(`{"release":{}}`),
+	}
+
+	parentCtx := ctx.WithIsCheckTx(true).WithGasMeter(sdk.NewInfiniteGasMeter())
+	gotRsp, err := Querier(keeper).SimulateExecuteContract(sdk.WrapSDKContext(parentCtx), req)
+	require.NoError(t, err)
+	assert.Equal(t, gotRsp.GasUsed, parentCtx.GasMeter().GasConsumed())
+}
+
+func TestQuerySimulateExecuteContractRejectedInDeliverTx(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	req := &types.QuerySimulateExecuteContractRequest{
+		Address: exampleContract.Contract.String(),
+		Sender:  exampleContract.VerifierAddr.String(),
+		Msg:     []byte(`{"release":{}}`),
+	}
+
+	_, err := Querier(keeper).SimulateExecuteContract(sdk.WrapSDKContext(ctx.WithIsCheckTx(false)), req)
+	assert.ErrorIs(t, err, sdkErrors.ErrInvalidRequest)
+}
+
+func TestQuerySimulateExecuteContractWithViewKeeperOnly(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	req := &types.QuerySimulateExecuteContractRequest{
+		Address: exampleContract.Contract.String(),
+		Sender:  exampleContract.VerifierAddr.String(),
+		Msg:     []byte(`{"release":{}}`),
+	}
+	// a view keeper does not provide the simulation
+	viewKeeper := struct{ types.ViewKeeper }{keeper}
+	q := NewGrpcQuerier(keeper.cdc, keeper.storeKey, viewKeeper, keeper.queryGasLimit)
+
+	_, err := q.SimulateExecuteContract(sdk.WrapSDKContext(ctx), req)
+	assert.Equal(t, status.Error(codes.Unimplemented, "simulation not supported"), err)
+}
+
+func TestQuerySimulateExecuteContractTrace(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+
+	specs := map[string]struct {
+		srcCtx    sdk.Context
+		srcSender sdk.AccAddress
+		expErr    bool
+	}{
+		"succeeded execution": {
+			srcCtx:    ctx.WithIsCheckTx(true),
+			srcSender: exampleContract.VerifierAddr,
+		},
+		"failed execution": {
+			srcCtx:    ctx.WithIsCheckTx(true),
+			srcSender: exampleContract.BeneficiaryAddr,
+			expErr:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			req := &types.QuerySimulateExecuteContractRequest{
+				Address: contractAddr,
+				Sender:  spec.srcSender.String(),
+				Msg:     []byte(`{"release":{}}`),
+				Trace:   true,
+			}
+			got, err := Querier(keeper).SimulateExecuteContract(sdk.WrapSDKContext(spec.srcCtx), req)
+			require.NoError(t, err)
+			if spec.expErr {
+				assert.Contains(t, got.Error, "Unauthorized")
+			} else {
+				assert.Empty(t, got.Error)
+			}
+			require.Len(t, got.Trace, 1)
+			root := got.Trace[0]
+			assert.Equal(t, string(EntryPointExecute), root.EntryPoint)
+			assert.Equal(t, contractAddr, root.Contract)
+			assert.Equal(t, exampleContract.CodeID, root.CodeID)
+			assert.Less(t, root.GasBefore, root.GasAfter)
+			if spec.expErr {
+				assert.Contains(t, root.Error, "Unauthorized")
+				assert.Empty(t, root.Children)
+				return
+			}
+			assert.Empty(t, root.Error)
+			// the bank send of the release is dispatched as sub message
+			require.Len(t, root.Children, 1)
+			subMsg := root.Children[0]
+			assert.Equal(t, TraceEntryPointSubMsg, subMsg.EntryPoint)
+			assert.Equal(t, contractAddr, subMsg.Contract)
+			assert.Equal(t, wasmvmtypes.ReplyNever.String(), subMsg.ReplyOn)
+			assert.Empty(t, subMsg.Children)
+		})
+	}
+}
+
+func TestQueryContractStorageUsage(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+
+	// hackatom stores its config in a single entry on instantiation
+	var expBytes uint64
+	keeper.IterateContractState(ctx, exampleContract.Contract, func(key, value []byte) bool {
+		expBytes += uint64(len(key) + len(value))
+		return false
+	})
+	require.NotZero(t, expBytes)
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcReq *types.QueryContractStorageUsageRequest
+		expRsp *types.QueryContractStorageUsageResponse
+		expErr error
+	}{
+		"query storage usage": {
+			srcReq: &types.QueryContractStorageUsageRequest{Address: contractAddr},
+			expRsp: &types.QueryContractStorageUsageResponse{
+				Address:      contractAddr,
+				StorageUsage: types.ContractStorageUsage{Bytes: expBytes, Keys: 1},
+			},
+		},
+		"with unknown contract": {
+			srcReq: &types.QueryContractStorageUsageRequest{Address: RandomBech32AccountAddress(t)},
+			expErr: types.ErrNotFound,
+		},
+		"with invalid address": {
+			srcReq: &types.QueryContractStorageUsageRequest{Address: "abcde"},
+			expErr: bech32.ErrInvalidLength(5),
+		},
+		"with empty request": {
+			srcReq: nil,
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, err := q.ContractStorageUsage(sdk.WrapSDKContext(ctx), spec.srcReq)
+			if spec.expErr != nil {
+				require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expRsp, got)
+		})
+	}
+}
+
 func TestQueryRawContractState(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -297,6 +799,78 @@ func TestQueryRawContractState(t *testing.T) {
 	}
 }
 
+func TestQueryRawContractStates(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	contractModel := []types.Model{
+		{Key: []byte("foo"), Value: []byte(`"bar"`)},
+		{Key: []byte{0x0, 0x1}, Value: []byte(`{"count":8}`)},
+	}
+	require.NoError(t, keeper.importContractState(ctx, exampleContract.Contract, contractModel))
+
+	tooManyKeys := make([][]byte, maxRawContractStatesKeys+1)
+	for i := range tooManyKeys {
+		tooManyKeys[i] = []byte("foo")
+	}
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery  *types.QueryRawContractStatesRequest
+		expModels []types.Model
+		expErr    error
+	}{
+		"query raw keys": {
+			srcQuery:  &types.QueryRawContractStatesRequest{Address: contractAddr, Keys: [][]byte{{0x0, 0x1}, []byte("foo")}},
+			expModels: []types.Model{contractModel[1], contractModel[0]},
+		},
+		"query with non-existent raw key": {
+			srcQuery: &types.QueryRawContractStatesRequest{Address: contractAddr, Keys: [][]byte{[]byte("foo"), []byte("not existing key")}},
+			expModels: []types.Model{
+				contractModel[0],
+				{Key: []byte("not existing key")},
+			},
+		},
+		"query duplicate raw keys": {
+			srcQuery:  &types.QueryRawContractStatesRequest{Address: contractAddr, Keys: [][]byte{[]byte("foo"), []byte("foo")}},
+			expModels: []types.Model{contractModel[0], contractModel[0]},
+		},
+		"query without keys": {
+			srcQuery: &types.QueryRawContractStatesRequest{Address: contractAddr},
+			expErr:   types.ErrEmpty,
+		},
+		"query with too many keys": {
+			srcQuery: &types.QueryRawContractStatesRequest{Address: contractAddr, Keys: tooManyKeys},
+			expErr:   types.ErrLimit,
+		},
+		"query raw with unknown address": {
+			srcQuery: &types.QueryRawContractStatesRequest{Address: RandomBech32AccountAddress(t), Keys: [][]byte{[]byte("foo")}},
+			expErr:   types.ErrNotFound,
+		},
+		"query raw with invalid address": {
+			srcQuery: &types.QueryRawContractStatesRequest{Address: "abcde", Keys: [][]byte{[]byte("foo")}},
+			expErr:   bech32.ErrInvalidLength(5),
+		},
+		"with empty request": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.RawContractStates(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			if spec.expErr != nil {
+				require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expModels, got.Models)
+		})
+	}
+}
+
 func TestQueryContractsByCode(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -474,6 +1048,97 @@ func TestQueryContractListByCodeOrdering(t *testing.T) {
 	}
 }
 
+func TestQueryContractsByCreatorList(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("denom", 500))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	otherCreator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	anyAddr := keepers.Faucet.NewFundedRandomAccount(ctx, topUp...)
+
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, _, err := keepers.ContractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: anyAddr, Beneficiary: bob})
+	require.NoError(t, err)
+
+	// manage some realistic block settings
+	var h int64 = 10
+	setBlock := func(ctx sdk.Context, height int64) sdk.Context {
+		ctx = ctx.WithBlockHeight(height)
+		meter := sdk.NewGasMeter(1000000)
+		ctx = ctx.WithGasMeter(meter)
+		ctx = ctx.WithBlockGasMeter(meter)
+		return ctx
+	}
+
+	var expContracts []string
+	// create 10 contracts with real block/gas setup, the predictable addresses are not sorted by creation
+	for i := 0; i < 10; i++ {
+		ctx = setBlock(ctx, h)
+		h++
+		var contractAddr sdk.AccAddress
+		if i%2 == 0 {
+			contractAddr, _, err = keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, fmt.Sprintf("contract %d", i), topUp)
+		} else {
+			contractAddr, _, err = keepers.ContractKeeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, fmt.Sprintf("contract %d", i), topUp, []byte{byte(10 - i)}, false)
+		}
+		require.NoError(t, err)
+		expContracts = append(expContracts, contractAddr.String())
+	}
+	otherContract, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, otherCreator, nil, initMsgBz, "other", topUp)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcCreator   string
+		srcPagintion *query.PageRequest
+		expContracts []string
+		expErr       bool
+	}{
+		"query all": {
+			srcCreator:   creator.String(),
+			expContracts: expContracts,
+		},
+		"with pagination offset": {
+			srcCreator:   creator.String(),
+			srcPagintion: &query.PageRequest{Offset: 3, Limit: 4},
+			expContracts: expContracts[3:7],
+		},
+		"other creator": {
+			srcCreator:   otherCreator.String(),
+			expContracts: []string{otherContract.String()},
+		},
+		"unknown creator": {
+			srcCreator:   RandomBech32AccountAddress(t),
+			expContracts: []string{},
+		},
+		"invalid creator": {
+			srcCreator: "invalid",
+			expErr:     true,
+		},
+	}
+	q := Querier(keepers.WasmKeeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{
+				CreatorAddress: spec.srcCreator,
+				Pagination:     spec.srcPagintion,
+			})
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expContracts, got.ContractAddresses)
+		})
+	}
+}
+
 func TestQueryContractHistory(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -873,10 +1538,11 @@ func TestQueryContractInfo(t *testing.T) {
 		info.IBCPortID = "fooPort"
 	}
 	specs := map[string]struct {
-		src    *types.QueryContractInfoRequest
-		stored types.ContractInfo
-		expRsp *types.QueryContractInfoResponse
-		expErr error
+		src         *types.QueryContractInfoRequest
+		stored      types.ContractInfo
+		storedState []types.Model
+		expRsp      *types.QueryContractInfoResponse
+		expErr      error
 	}{
 		"found": {
 			src:    &types.QueryContractInfoRequest{Address: contractAddr.String()},
@@ -902,6 +1568,16 @@ func TestQueryContractInfo(t *testing.T) {
 				ContractInfo: types.ContractInfoFixture(withIBCPort),
 			},
 		},
+		"with storage usage": {
+			src:         &types.QueryContractInfoRequest{Address: contractAddr.String()},
+			stored:      types.ContractInfoFixture(),
+			storedState: []types.Model{{Key: []byte("foo"), Value: []byte(`"bar"`)}},
+			expRsp: &types.QueryContractInfoResponse{
+				Address:      contractAddr.String(),
+				ContractInfo: types.ContractInfoFixture(),
+				StorageUsage: types.ContractStorageUsage{Bytes: 8, Keys: 1},
+			},
+		},
 		"not found": {
 			src:    &types.QueryContractInfoRequest{Address: RandomBech32AccountAddress(t)},
 			stored: types.ContractInfoFixture(),
@@ -920,6 +1596,7 @@ func TestQueryContractInfo(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			xCtx, _ := ctx.CacheContext()
 			k.storeContractInfo(xCtx, contractAddr, &spec.stored)
+			require.NoError(t, k.importContractState(xCtx, contractAddr, spec.storedState))
 			// when
 			gotRsp, gotErr := querier.ContractInfo(sdk.WrapSDKContext(xCtx), spec.src)
 			if spec.expErr != nil {
@@ -1030,10 +1707,12 @@ func TestQueryParams(t *testing.T) {
 			setParams: types.Params{
 				CodeUploadAccess:             types.AllowNobody,
 				InstantiateDefaultPermission: types.AccessTypeNobody,
+				GasRegister:                  types.DefaultGasRegisterParams(),
 			},
 			expParams: types.Params{
 				CodeUploadAccess:             types.AllowNobody,
 				InstantiateDefaultPermission: types.AccessTypeNobody,
+				GasRegister:                  types.DefaultGasRegisterParams(),
 			},
 		},
 	}
@@ -1052,6 +1731,81 @@ func TestQueryParams(t *testing.T) {
 	}
 }
 
+func TestQueryStargateQueryAcceptList(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	q := Querier(keeper)
+
+	specs := map[string]struct {
+		setAcceptList []types.AcceptedStargateQuery
+		expEntries    []types.AcceptedStargateQuery
+	}{
+		"default": {
+			setAcceptList: types.DefaultStargateQueryAcceptList(),
+		},
+		"with entries": {
+			setAcceptList: []types.AcceptedStargateQuery{
+				{Path: "/cosmos.auth.v1beta1.Query/Account", ResponseTypeURL: "/cosmos.auth.v1beta1.QueryAccountResponse"},
+				{Path: "/cosmos.bank.v1beta1.Query/Balance", ResponseTypeURL: "/cosmos.bank.v1beta1.QueryBalanceResponse"},
+			},
+			expEntries: []types.AcceptedStargateQuery{
+				{Path: "/cosmos.auth.v1beta1.Query/Account", ResponseTypeURL: "/cosmos.auth.v1beta1.QueryAccountResponse"},
+				{Path: "/cosmos.bank.v1beta1.Query/Balance", ResponseTypeURL: "/cosmos.bank.v1beta1.QueryBalanceResponse"},
+			},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			params := keeper.GetParams(xCtx)
+			params.StargateQueryAcceptList = spec.setAcceptList
+			keeper.SetParams(xCtx, params)
+
+			got, err := q.StargateQueryAcceptList(sdk.WrapSDKContext(xCtx), &types.QueryStargateQueryAcceptListRequest{})
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, spec.expEntries, got.Entries)
+		})
+	}
+}
+
+func TestQueryStargateMsgFilter(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	q := Querier(keeper)
+
+	specs := map[string]struct {
+		setFilter types.StargateMsgFilter
+		expFilter types.StargateMsgFilter
+	}{
+		"app wiring filter": {
+			setFilter: types.DefaultStargateMsgFilter(),
+			expFilter: types.StargateMsgFilter{MaxMsgSize: types.DefaultMaxStargateMsgSize},
+		},
+		"params filter": {
+			setFilter: types.StargateMsgFilter{DenyList: []string{"/cosmos.bank.v1beta1.MsgSend"}, MaxMsgSize: 1},
+			expFilter: types.StargateMsgFilter{DenyList: []string{"/cosmos.bank.v1beta1.MsgSend"}, MaxMsgSize: 1},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			params := keeper.GetParams(xCtx)
+			params.StargateMsgFilter = spec.setFilter
+			keeper.SetParams(xCtx, params)
+
+			got, err := q.StargateMsgFilter(sdk.WrapSDKContext(xCtx), &types.QueryStargateMsgFilterRequest{})
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, spec.expFilter, got.Filter)
+		})
+	}
+}
+
 func TestQueryCodeInfo(t *testing.T) {
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
@@ -1082,6 +1836,8 @@ func TestQueryCodeInfo(t *testing.T) {
 		t.Run(msg, func(t *testing.T) {
 			codeInfo := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
 			codeInfo.InstantiateConfig = spec.accessConfig
+			codeInfo.Source = "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom"
+			codeInfo.Builder = "cosmwasm/rust-optimizer:0.12.6"
 			require.NoError(t, keeper.importCode(ctx, spec.codeId,
 				codeInfo,
 				wasmCode),
@@ -1098,6 +1854,8 @@ func TestQueryCodeInfo(t *testing.T) {
 					Creator:               codeInfo.Creator,
 					DataHash:              codeInfo.CodeHash,
 					InstantiatePermission: spec.accessConfig,
+					Source:                codeInfo.Source,
+					Builder:               codeInfo.Builder,
 				},
 				Data: wasmCode,
 			}
@@ -1173,6 +1931,71 @@ func TestQueryCodeInfoList(t *testing.T) {
 	assert.EqualValues(t, expPaginationTotal, got.Pagination.Total)
 }
 
+func TestQueryCodeInfoByChecksum(t *testing.T) {
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	codeInfo := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
+	require.NoError(t, keeper.importCode(ctx, 1, codeInfo, wasmCode))
+	otherCodeInfo := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
+	otherCodeInfo.InstantiateConfig = types.AllowNobody
+	require.NoError(t, keeper.importCode(ctx, 10, otherCodeInfo, wasmCode))
+
+	specs := map[string]struct {
+		srcChecksum string
+		expCodes    []types.CodeInfoResponse
+		expErr      error
+	}{
+		"all code ids with checksum": {
+			srcChecksum: hex.EncodeToString(codeInfo.CodeHash),
+			expCodes: []types.CodeInfoResponse{
+				{CodeID: 1, Creator: codeInfo.Creator, DataHash: codeInfo.CodeHash, InstantiatePermission: codeInfo.InstantiateConfig},
+				{CodeID: 10, Creator: otherCodeInfo.Creator, DataHash: otherCodeInfo.CodeHash, InstantiatePermission: types.AllowNobody},
+			},
+		},
+		"upper case hex": {
+			srcChecksum: strings.ToUpper(hex.EncodeToString(codeInfo.CodeHash)),
+			expCodes: []types.CodeInfoResponse{
+				{CodeID: 1, Creator: codeInfo.Creator, DataHash: codeInfo.CodeHash, InstantiatePermission: codeInfo.InstantiateConfig},
+				{CodeID: 10, Creator: otherCodeInfo.Creator, DataHash: otherCodeInfo.CodeHash, InstantiatePermission: types.AllowNobody},
+			},
+		},
+		"unknown checksum": {
+			srcChecksum: hex.EncodeToString(make([]byte, 32)),
+			expErr:      types.ErrNotFound,
+		},
+		"empty checksum": {
+			srcChecksum: "",
+			expErr:      types.ErrInvalid,
+		},
+		"invalid hex": {
+			srcChecksum: "invalid",
+			expErr:      types.ErrInvalid,
+		},
+		"invalid length": {
+			srcChecksum: hex.EncodeToString(codeInfo.CodeHash[:31]),
+			expErr:      types.ErrInvalid,
+		},
+	}
+	q := Querier(keeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.CodeInfoByChecksum(sdk.WrapSDKContext(ctx), &types.QueryCodeInfoByChecksumRequest{
+				Checksum: spec.srcChecksum,
+			})
+			if spec.expErr != nil {
+				assert.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expCodes, got.CodeInfos)
+		})
+	}
+}
+
 func fromBase64(s string) []byte {
 	r, err := base64.StdEncoding.DecodeString(s)
 	if err != nil {
@@ -11,6 +11,8 @@ import (
 	"github.com/Finschia/finschia-sdk/codec"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/types/query"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 	distributiontypes "github.com/Finschia/finschia-sdk/x/distribution/types"
 	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
 	wasmvmtypes "github.com/Finschia/wasmvm/types"
@@ -45,7 +47,7 @@ var _ wasmvmtypes.Querier = QueryHandler{}
 
 func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) ([]byte, error) {
 	// set a limit for a subCtx
-	sdkGas := q.gasRegister.FromWasmVMGas(gasLimit)
+	sdkGas := q.gasRegister.FromWasmVMGas(q.Ctx, gasLimit)
 	// discard all changes/ events in subCtx by not committing the cached context
 	subCtx, _ := q.Ctx.WithGasMeter(sdk.NewGasMeter(sdkGas)).CacheContext()
 
@@ -91,11 +93,16 @@ type contractMetaDataSource interface {
 
 type wasmQueryKeeper interface {
 	contractMetaDataSource
+	stargateAcceptListSource
 	QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
 	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
 }
 
+type stargateAcceptListSource interface {
+	GetStargateQueryAcceptList(ctx sdk.Context) []types.AcceptedStargateQuery
+}
+
 func DefaultQueryPlugins(
 	bank types.BankViewKeeper,
 	staking types.StakingKeeper,
@@ -103,13 +110,14 @@ func DefaultQueryPlugins(
 	channelKeeper types.ChannelKeeper,
 	queryRouter GRPCQueryRouter,
 	wasm wasmQueryKeeper,
+	cdc codec.Codec,
 ) QueryPlugins {
 	return QueryPlugins{
 		Bank:     BankQuerier(bank),
 		Custom:   NoCustomQuerier,
 		IBC:      IBCQuerier(wasm, channelKeeper),
 		Staking:  StakingQuerier(staking, distKeeper),
-		Stargate: RejectStargateQuerier(),
+		Stargate: ParamsStargateQuerier(wasm, queryRouter, cdc),
 		Wasm:     WasmQuerier(wasm),
 	}
 }
@@ -205,10 +213,95 @@ func BankQuerier(bankKeeper types.BankViewKeeper) func(ctx sdk.Context, request
 	}
 }
 
+// maxDenomMetadataPageLimit is the maximum number of denoms of a single AllDenomMetadata query
+const maxDenomMetadataPageLimit = 100
+
+// BankMetadataQuerier handles the custom queries of contracts for the denom metadata of x/bank
+// that are not supported by the wasmvm BankQuery.
+func BankMetadataQuerier(bankKeeper types.BankViewKeeper) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var custom types.BankCustomQuery
+		if err := json.Unmarshal(request, &custom); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		switch {
+		case custom.Bank != nil && custom.Bank.DenomMetadata != nil:
+			metadata, found := bankKeeper.GetDenomMetaData(ctx, custom.Bank.DenomMetadata.Denom)
+			if !found {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "metadata of denom %s", custom.Bank.DenomMetadata.Denom)
+			}
+			res := types.DenomMetadataResponse{
+				Metadata: ConvertSdkDenomMetadataToWasmDenomMetadata(metadata),
+			}
+			return json.Marshal(res)
+		case custom.Bank != nil && custom.Bank.AllDenomMetadata != nil:
+			req := &banktypes.QueryDenomsMetadataRequest{}
+			if p := custom.Bank.AllDenomMetadata.Pagination; p != nil {
+				if p.Limit > maxDenomMetadataPageLimit {
+					return nil, sdkerrors.Wrapf(types.ErrLimit, "max %d denoms", maxDenomMetadataPageLimit)
+				}
+				req.Pagination = &query.PageRequest{Key: p.Key, Limit: uint64(p.Limit), Reverse: p.Reverse}
+			}
+			bankRes, err := bankKeeper.DenomsMetadata(sdk.WrapSDKContext(ctx), req)
+			if err != nil {
+				return nil, err
+			}
+			res := types.AllDenomMetadataResponse{
+				Metadata: make([]types.DenomMetadata, len(bankRes.Metadatas)),
+			}
+			for i, m := range bankRes.Metadatas {
+				res.Metadata[i] = ConvertSdkDenomMetadataToWasmDenomMetadata(m)
+			}
+			if bankRes.Pagination != nil {
+				res.NextKey = bankRes.Pagination.NextKey
+			}
+			return json.Marshal(res)
+		}
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown Custom query variant"}
+	}
+}
+
+// ConvertSdkDenomMetadataToWasmDenomMetadata converts the bank metadata to the wasm type
+func ConvertSdkDenomMetadataToWasmDenomMetadata(metadata banktypes.Metadata) types.DenomMetadata {
+	units := make([]types.DenomUnit, len(metadata.DenomUnits))
+	for i, u := range metadata.DenomUnits {
+		units[i] = types.DenomUnit{
+			Denom:    u.Denom,
+			Exponent: u.Exponent,
+			Aliases:  u.Aliases,
+		}
+	}
+	return types.DenomMetadata{
+		Description: metadata.Description,
+		DenomUnits:  units,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+	}
+}
+
 func NoCustomQuerier(sdk.Context, json.RawMessage) ([]byte, error) {
 	return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
 }
 
+// ChainCustomQueriers combines custom queriers into one. The queriers are called in order until one of them
+// does not fail with an UnsupportedRequest.
+func ChainCustomQueriers(queriers ...CustomQuerier) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var err error = wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		for _, query := range queriers {
+			var res []byte
+			res, err = query(ctx, request)
+			var unsupported wasmvmtypes.UnsupportedRequest
+			if !errors.As(err, &unsupported) {
+				return res, err
+			}
+		}
+		return nil, err
+	}
+}
+
 func IBCQuerier(wasm contractMetaDataSource, channelKeeper types.ChannelKeeper) func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.IBCQuery) ([]byte, error) {
 	return func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.IBCQuery) ([]byte, error) {
 		if request.PortID != nil {
@@ -324,6 +417,55 @@ func AcceptListStargateQuerier(acceptList AcceptedStargateQueries, queryRouter G
 	}
 }
 
+// ParamsStargateQuerier supports the stargate queries of the accept list in the wasm params only.
+// The accept list is managed by governance so that no new binary is required to add or remove a query.
+// The route and the response type are resolved when the query is executed.
+func ParamsStargateQuerier(source stargateAcceptListSource, queryRouter GRPCQueryRouter, cdc codec.Codec) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+		accepted, ok := types.FindAcceptedStargateQuery(source.GetStargateQueryAcceptList(ctx), request.Path)
+		if !ok {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Path)}
+		}
+
+		route := queryRouter.Route(request.Path)
+		if route == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", request.Path)}
+		}
+		protoResponse, err := resolveProtoMessage(cdc, accepted.ResponseTypeURL)
+		if err != nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("Unknown response type '%s'", accepted.ResponseTypeURL)}
+		}
+
+		res, err := route(ctx, abci.RequestQuery{
+			Data: request.Data,
+			Path: request.Path,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return ConvertProtoToJSONMarshal(cdc, protoResponse, res.Value)
+	}
+}
+
+// resolveProtoMessage returns a new instance of the proto message for the type url from the interface registry.
+// The response types must be registered with types.RegisterStargateQueryResponses.
+func resolveProtoMessage(cdc codec.Codec, typeURL string) (codec.ProtoMarshaler, error) {
+	protoCdc, ok := cdc.(codec.ProtoCodecMarshaler)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "codec without interface registry")
+	}
+	msg, err := protoCdc.InterfaceRegistry().Resolve(typeURL)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "type url %s", typeURL)
+	}
+	pm, ok := msg.(codec.ProtoMarshaler)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "type url %s", typeURL)
+	}
+	return pm, nil
+}
+
 func StakingQuerier(keeper types.StakingKeeper, distKeeper types.DistributionKeeper) func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error) {
 		if request.BondedDenom != nil {
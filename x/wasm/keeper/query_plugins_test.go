@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -357,6 +359,207 @@ func TestBankQuerierBalance(t *testing.T) {
 	assert.Equal(t, exp, got)
 }
 
+func TestBankMetadataQuerier(t *testing.T) {
+	myMetadata := banktypes.Metadata{
+		Description: "my token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ualx", Exponent: 0, Aliases: []string{"microalx"}},
+			{Denom: "alx", Exponent: 6},
+		},
+		Base:    "ualx",
+		Display: "alx",
+		Name:    "Alx",
+		Symbol:  "ALX",
+	}
+	expMetadata := types.DenomMetadata{
+		Description: "my token",
+		DenomUnits: []types.DenomUnit{
+			{Denom: "ualx", Exponent: 0, Aliases: []string{"microalx"}},
+			{Denom: "alx", Exponent: 6},
+		},
+		Base:    "ualx",
+		Display: "alx",
+		Name:    "Alx",
+		Symbol:  "ALX",
+	}
+	specs := map[string]struct {
+		src    types.BankCustomQuery
+		mock   bankKeeperMock
+		exp    interface{}
+		expErr error
+	}{
+		"denom metadata": {
+			src: types.BankCustomQuery{Bank: &types.ExtendedBankQuery{DenomMetadata: &types.DenomMetadataQuery{Denom: "ualx"}}},
+			mock: bankKeeperMock{GetDenomMetaDataFn: func(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
+				return myMetadata, denom == "ualx"
+			}},
+			exp: &types.DenomMetadataResponse{Metadata: expMetadata},
+		},
+		"denom metadata not found": {
+			src: types.BankCustomQuery{Bank: &types.ExtendedBankQuery{DenomMetadata: &types.DenomMetadataQuery{Denom: "unknown"}}},
+			mock: bankKeeperMock{GetDenomMetaDataFn: func(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
+				return banktypes.Metadata{}, false
+			}},
+			expErr: sdkerrors.ErrNotFound,
+		},
+		"all denom metadata": {
+			src: types.BankCustomQuery{Bank: &types.ExtendedBankQuery{AllDenomMetadata: &types.AllDenomMetadataQuery{}}},
+			mock: bankKeeperMock{DenomsMetadataFn: func(ctx context.Context, req *banktypes.QueryDenomsMetadataRequest) (*banktypes.QueryDenomsMetadataResponse, error) {
+				assert.Nil(t, req.Pagination)
+				return &banktypes.QueryDenomsMetadataResponse{Metadatas: []banktypes.Metadata{myMetadata}, Pagination: &query.PageResponse{}}, nil
+			}},
+			exp: &types.AllDenomMetadataResponse{Metadata: []types.DenomMetadata{expMetadata}},
+		},
+		"all denom metadata with pagination": {
+			src: types.BankCustomQuery{Bank: &types.ExtendedBankQuery{AllDenomMetadata: &types.AllDenomMetadataQuery{
+				Pagination: &types.PageRequest{Key: []byte("foo"), Limit: 1, Reverse: true},
+			}}},
+			mock: bankKeeperMock{DenomsMetadataFn: func(ctx context.Context, req *banktypes.QueryDenomsMetadataRequest) (*banktypes.QueryDenomsMetadataResponse, error) {
+				assert.Equal(t, &query.PageRequest{Key: []byte("foo"), Limit: 1, Reverse: true}, req.Pagination)
+				return &banktypes.QueryDenomsMetadataResponse{Metadatas: []banktypes.Metadata{myMetadata}, Pagination: &query.PageResponse{NextKey: []byte("bar")}}, nil
+			}},
+			exp: &types.AllDenomMetadataResponse{Metadata: []types.DenomMetadata{expMetadata}, NextKey: []byte("bar")},
+		},
+		"all denom metadata with max limit": {
+			src: types.BankCustomQuery{Bank: &types.ExtendedBankQuery{AllDenomMetadata: &types.AllDenomMetadataQuery{
+				Pagination: &types.PageRequest{Limit: 100},
+			}}},
+			mock: bankKeeperMock{DenomsMetadataFn: func(ctx context.Context, req *banktypes.QueryDenomsMetadataRequest) (*banktypes.QueryDenomsMetadataResponse, error) {
+				assert.Equal(t, uint64(100), req.Pagination.Limit)
+				return &banktypes.QueryDenomsMetadataResponse{}, nil
+			}},
+			exp: &types.AllDenomMetadataResponse{Metadata: []types.DenomMetadata{}},
+		},
+		"all denom metadata exceeds max limit": {
+			src: types.BankCustomQuery{Bank: &types.ExtendedBankQuery{AllDenomMetadata: &types.AllDenomMetadataQuery{
+				Pagination: &types.PageRequest{Limit: 101},
+			}}},
+			expErr: types.ErrLimit,
+		},
+		"all denom metadata empty": {
+			src: types.BankCustomQuery{Bank: &types.ExtendedBankQuery{AllDenomMetadata: &types.AllDenomMetadataQuery{}}},
+			mock: bankKeeperMock{DenomsMetadataFn: func(ctx context.Context, req *banktypes.QueryDenomsMetadataRequest) (*banktypes.QueryDenomsMetadataResponse, error) {
+				return &banktypes.QueryDenomsMetadataResponse{}, nil
+			}},
+			exp: &types.AllDenomMetadataResponse{Metadata: []types.DenomMetadata{}},
+		},
+		"all denom metadata error": {
+			src: types.BankCustomQuery{Bank: &types.ExtendedBankQuery{AllDenomMetadata: &types.AllDenomMetadataQuery{}}},
+			mock: bankKeeperMock{DenomsMetadataFn: func(ctx context.Context, req *banktypes.QueryDenomsMetadataRequest) (*banktypes.QueryDenomsMetadataResponse, error) {
+				return nil, sdkerrors.ErrInvalidRequest
+			}},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		"unknown bank variant": {
+			src:    types.BankCustomQuery{Bank: &types.ExtendedBankQuery{}},
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "unknown Custom query variant"},
+		},
+		"unknown custom variant": {
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "unknown Custom query variant"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background())
+			bz, err := json.Marshal(spec.src)
+			require.NoError(t, err)
+			gotBz, gotErr := keeper.BankMetadataQuerier(spec.mock)(ctx, bz)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			got := reflect.New(reflect.TypeOf(spec.exp).Elem()).Interface()
+			require.NoError(t, json.Unmarshal(gotBz, got))
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestBankMetadataQuerierPagination(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.AvailableCapabilities)
+	for _, denom := range []string{"ualx", "ubob", "ucat"} {
+		keepers.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+			Base:       denom,
+			Display:    denom,
+		})
+	}
+	q := keeper.BankMetadataQuerier(keepers.BankKeeper)
+	queryPage := func(t *testing.T, key []byte) types.AllDenomMetadataResponse {
+		bz, err := json.Marshal(types.BankCustomQuery{Bank: &types.ExtendedBankQuery{AllDenomMetadata: &types.AllDenomMetadataQuery{
+			Pagination: &types.PageRequest{Key: key, Limit: 2},
+		}}})
+		require.NoError(t, err)
+		gotBz, err := q(ctx, bz)
+		require.NoError(t, err)
+		var res types.AllDenomMetadataResponse
+		require.NoError(t, json.Unmarshal(gotBz, &res))
+		return res
+	}
+
+	first := queryPage(t, nil)
+	require.Len(t, first.Metadata, 2)
+	assert.Equal(t, "ualx", first.Metadata[0].Base)
+	assert.Equal(t, "ubob", first.Metadata[1].Base)
+	require.NotEmpty(t, first.NextKey)
+
+	second := queryPage(t, first.NextKey)
+	require.Len(t, second.Metadata, 1)
+	assert.Equal(t, "ucat", second.Metadata[0].Base)
+	assert.Empty(t, second.NextKey)
+}
+
+func TestChainCustomQueriers(t *testing.T) {
+	myErr := sdkerrors.ErrInvalidRequest
+	unsupported := keeper.CustomQuerier(keeper.NoCustomQuerier)
+	returns := func(res string) keeper.CustomQuerier {
+		return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+			return []byte(res), nil
+		}
+	}
+	fails := func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		return nil, myErr
+	}
+
+	specs := map[string]struct {
+		queriers []keeper.CustomQuerier
+		exp      []byte
+		expErr   error
+	}{
+		"first handles": {
+			queriers: []keeper.CustomQuerier{returns(`"a"`), fails},
+			exp:      []byte(`"a"`),
+		},
+		"unsupported falls through": {
+			queriers: []keeper.CustomQuerier{unsupported, returns(`"b"`)},
+			exp:      []byte(`"b"`),
+		},
+		"other error stops": {
+			queriers: []keeper.CustomQuerier{unsupported, fails, returns(`"c"`)},
+			expErr:   myErr,
+		},
+		"all unsupported": {
+			queriers: []keeper.CustomQuerier{unsupported, unsupported},
+			expErr:   wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+		"no queriers": {
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := keeper.ChainCustomQueriers(spec.queriers...)(sdk.Context{}, []byte(`{}`))
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotRes)
+		})
+	}
+}
+
 func TestContractInfoWasmQuerier(t *testing.T) {
 	myValidContractAddr := keeper.RandomBech32AccountAddress(t)
 	myCreatorAddr := keeper.RandomBech32AccountAddress(t)
@@ -554,11 +757,97 @@ func TestAcceptListStargateQuerier(t *testing.T) {
 	}
 }
 
+func TestParamsStargateQuerier(t *testing.T) {
+	wasmApp := app.SetupWithEmptyStore(t)
+	ctx := wasmApp.NewUncachedContext(false, tmproto.Header{ChainID: "foo", Height: 1, Time: time.Now()})
+	wasmApp.StakingKeeper.SetParams(ctx, stakingtypes.DefaultParams())
+
+	addrs := app.AddTestAddrs(wasmApp, ctx, 2, sdk.NewInt(1_000_000))
+	types.RegisterStargateQueryResponses(wasmApp.InterfaceRegistry(), &authtypes.QueryAccountResponse{})
+	acceptList := []types.AcceptedStargateQuery{
+		{Path: "/cosmos.auth.v1beta1.Query/Account", ResponseTypeURL: "/cosmos.auth.v1beta1.QueryAccountResponse"},
+		{Path: "/cosmos.bank.v1beta1.Query/Balance", ResponseTypeURL: "/unknown.Type"},
+		{Path: "/cosmos.bank.v1beta1.Query/SupplyOf", ResponseTypeURL: "/cosmos.bank.v1beta1.QuerySupplyOfResponse"},
+		{Path: "/no/route/to/this", ResponseTypeURL: "/cosmos.auth.v1beta1.QueryAccountResponse"},
+	}
+	source := mockWasmQueryKeeper{AcceptListFn: func(ctx sdk.Context) []types.AcceptedStargateQuery {
+		return acceptList
+	}}
+
+	marshal := func(pb proto.Message) []byte {
+		b, err := proto.Marshal(pb)
+		require.NoError(t, err)
+		return b
+	}
+
+	specs := map[string]struct {
+		req     *wasmvmtypes.StargateQuery
+		expErr  bool
+		expResp string
+	}{
+		"in accept list - success result": {
+			req: &wasmvmtypes.StargateQuery{
+				Path: "/cosmos.auth.v1beta1.Query/Account",
+				Data: marshal(&authtypes.QueryAccountRequest{Address: addrs[0].String()}),
+			},
+			expResp: fmt.Sprintf(`{"account":{"@type":"/cosmos.auth.v1beta1.BaseAccount","address":%q,"pub_key":null,"account_number":"1","sequence":"0"}}`, addrs[0].String()),
+		},
+		"in accept list - error result": {
+			req: &wasmvmtypes.StargateQuery{
+				Path: "/cosmos.auth.v1beta1.Query/Account",
+				Data: marshal(&authtypes.QueryAccountRequest{Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()}),
+			},
+			expErr: true,
+		},
+		"not in accept list": {
+			req: &wasmvmtypes.StargateQuery{
+				Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+				Data: marshal(&banktypes.QueryAllBalancesRequest{Address: addrs[0].String()}),
+			},
+			expErr: true,
+		},
+		"unknown route": {
+			req: &wasmvmtypes.StargateQuery{
+				Path: "/no/route/to/this",
+				Data: marshal(&authtypes.QueryAccountRequest{Address: addrs[0].String()}),
+			},
+			expErr: true,
+		},
+		"unknown response type": {
+			req: &wasmvmtypes.StargateQuery{
+				Path: "/cosmos.bank.v1beta1.Query/Balance",
+				Data: marshal(&banktypes.QueryBalanceRequest{Address: addrs[0].String(), Denom: "stake"}),
+			},
+			expErr: true,
+		},
+		"response type not in interface registry": {
+			req: &wasmvmtypes.StargateQuery{
+				Path: "/cosmos.bank.v1beta1.Query/SupplyOf",
+				Data: marshal(&banktypes.QuerySupplyOfRequest{Denom: "stake"}),
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := keeper.ParamsStargateQuerier(source, wasmApp.GRPCQueryRouter(), wasmApp.AppCodec())
+			gotBz, gotErr := q(ctx, spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.JSONEq(t, spec.expResp, string(gotBz), string(gotBz))
+		})
+	}
+}
+
 type mockWasmQueryKeeper struct {
 	GetContractInfoFn func(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo
 	QueryRawFn        func(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
 	QuerySmartFn      func(ctx sdk.Context, contractAddr sdk.AccAddress, req types.RawContractMessage) ([]byte, error)
 	IsPinnedCodeFn    func(ctx sdk.Context, codeID uint64) bool
+	AcceptListFn      func(ctx sdk.Context) []types.AcceptedStargateQuery
 }
 
 func (m mockWasmQueryKeeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
@@ -590,9 +879,11 @@ func (m mockWasmQueryKeeper) IsPinnedCode(ctx sdk.Context, codeID uint64) bool {
 }
 
 type bankKeeperMock struct {
-	GetSupplyFn      func(ctx sdk.Context, denom string) sdk.Coin
-	GetBalanceFn     func(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
-	GetAllBalancesFn func(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupplyFn        func(ctx sdk.Context, denom string) sdk.Coin
+	GetBalanceFn       func(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalancesFn   func(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetDenomMetaDataFn func(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	DenomsMetadataFn   func(ctx context.Context, req *banktypes.QueryDenomsMetadataRequest) (*banktypes.QueryDenomsMetadataResponse, error)
 }
 
 func (m bankKeeperMock) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
@@ -616,6 +907,20 @@ func (m bankKeeperMock) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk
 	return m.GetAllBalancesFn(ctx, addr)
 }
 
+func (m bankKeeperMock) GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
+	if m.GetDenomMetaDataFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetDenomMetaDataFn(ctx, denom)
+}
+
+func (m bankKeeperMock) DenomsMetadata(ctx context.Context, req *banktypes.QueryDenomsMetadataRequest) (*banktypes.QueryDenomsMetadataResponse, error) {
+	if m.DenomsMetadataFn == nil {
+		panic("not expected to be called")
+	}
+	return m.DenomsMetadataFn(ctx, req)
+}
+
 func TestConvertProtoToJSONMarshal(t *testing.T) {
 	testCases := []struct {
 		name                  string
@@ -741,3 +1046,10 @@ func TestDeterministicJsonMarshal(t *testing.T) {
 		})
 	}
 }
+
+func (m mockWasmQueryKeeper) GetStargateQueryAcceptList(ctx sdk.Context) []types.AcceptedStargateQuery {
+	if m.AcceptListFn == nil {
+		panic("not expected to be called")
+	}
+	return m.AcceptListFn(ctx)
+}
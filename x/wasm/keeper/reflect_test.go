@@ -18,6 +18,7 @@ import (
 	authkeeper "github.com/Finschia/finschia-sdk/x/auth/keeper"
 	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
 	wasmvmtypes "github.com/Finschia/wasmvm/types"
 
 	"github.com/Finschia/wasmd/x/wasm/keeper/testdata"
@@ -209,6 +210,98 @@ func TestReflectCustomMsg(t *testing.T) {
 	checkAccount(t, ctx, accKeeper, bankKeeper, bob, deposit)
 }
 
+func TestReflectGovWeightedVoteAndDeposit(t *testing.T) {
+	encoders := &MessageEncoders{
+		Custom: func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+			var custom reflectCustomMsg
+			if err := json.Unmarshal(msg, &custom); err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+			}
+			return EncodeGovCustomMsg(sender, custom.Raw)
+		},
+	}
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, WithMessageEncoders(encoders))
+	keeper, govKeeper := keepers.ContractKeeper, keepers.GovKeeper
+
+	valAddr := addValidator(t, ctx, keepers.StakingKeeper, keepers.Faucet, sdk.NewInt64Coin("stake", 1000000))
+	ctx = nextBlock(ctx, keepers.StakingKeeper)
+
+	minDeposit := govKeeper.GetDepositParams(ctx).MinDeposit
+	contractStart := minDeposit.Add(sdk.NewInt64Coin("stake", 50000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, contractStart...)
+	codeID, _, err := keeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "reflect contract 1", contractStart)
+	require.NoError(t, err)
+
+	reflect := func(t *testing.T, msgs ...wasmvmtypes.CosmosMsg) error {
+		bz, err := json.Marshal(testdata.ReflectHandleMsg{Reflect: &testdata.ReflectPayload{Msgs: msgs}})
+		require.NoError(t, err)
+		_, err = keeper.Execute(ctx, contractAddr, creator, bz, nil)
+		return err
+	}
+	reflectGov := func(t *testing.T, custom types.GovCustomMsg) error {
+		rawBz, err := json.Marshal(custom)
+		require.NoError(t, err)
+		customBz, err := json.Marshal(reflectCustomMsg{Raw: rawBz})
+		require.NoError(t, err)
+		return reflect(t, wasmvmtypes.CosmosMsg{Custom: customBz})
+	}
+
+	// contract stakes its tokens
+	err = reflect(t, wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{Delegate: &wasmvmtypes.DelegateMsg{
+		Validator: valAddr.String(),
+		Amount:    wasmvmtypes.Coin{Denom: "stake", Amount: "50000"},
+	}}})
+	require.NoError(t, err)
+	ctx = nextBlock(ctx, keepers.StakingKeeper)
+
+	// and funds a new proposal into the voting period
+	proposal, err := govKeeper.SubmitProposal(ctx, govtypes.NewTextProposal("Test", "description"))
+	require.NoError(t, err)
+	err = reflectGov(t, types.GovCustomMsg{Gov: &types.ExtendedGovMsg{Deposit: &types.DepositMsg{
+		ProposalID: proposal.ProposalId,
+		Amount:     wasmvmtypes.Coins{{Denom: minDeposit[0].Denom, Amount: minDeposit[0].Amount.String()}},
+	}}})
+	require.NoError(t, err)
+	gotDeposit, found := govKeeper.GetDeposit(ctx, proposal.ProposalId, contractAddr)
+	require.True(t, found)
+	assert.Equal(t, minDeposit, gotDeposit.Amount)
+	proposal, found = govKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, found)
+	require.Equal(t, govtypes.StatusVotingPeriod, proposal.Status)
+
+	// and splits its voting power
+	err = reflectGov(t, types.GovCustomMsg{Gov: &types.ExtendedGovMsg{VoteWeighted: &types.VoteWeightedMsg{
+		ProposalID: proposal.ProposalId,
+		Options: []types.WeightedVoteOption{
+			{Option: "yes", Weight: "0.6"},
+			{Option: "no", Weight: "0.4"},
+		},
+	}}})
+	require.NoError(t, err)
+	gotVote, found := govKeeper.GetVote(ctx, proposal.ProposalId, contractAddr)
+	require.True(t, found)
+	exp := []govtypes.WeightedVoteOption{
+		{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		{Option: govtypes.OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+	}
+	assert.Equal(t, exp, gotVote.Options)
+
+	// the staked tokens are counted with the weights
+	_, _, tally := govKeeper.Tally(ctx, proposal)
+	assert.Equal(t, sdk.NewInt(30000), tally.Yes)
+	assert.Equal(t, sdk.NewInt(20000), tally.No)
+	assert.Equal(t, sdk.ZeroInt(), tally.Abstain)
+
+	// weights must sum up to 1
+	err = reflectGov(t, types.GovCustomMsg{Gov: &types.ExtendedGovMsg{VoteWeighted: &types.VoteWeightedMsg{
+		ProposalID: proposal.ProposalId,
+		Options:    []types.WeightedVoteOption{{Option: "yes", Weight: "0.5"}},
+	}}})
+	assert.Error(t, err)
+}
+
 func TestMaskReflectCustomQuery(t *testing.T) {
 	cdc := MakeEncodingConfig(t).Marshaler
 	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, WithMessageEncoders(reflectEncoders(cdc)), WithQueryPlugins(reflectPlugins()))
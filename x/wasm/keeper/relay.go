@@ -24,20 +24,26 @@ func (k Keeper) OnOpenChannel(
 	msg wasmvmtypes.IBCChannelOpenMsg,
 ) (string, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
-	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return "", err
 	}
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddr, contractInfo.CodeID, EntryPointIBCChannelOpen); err != nil {
+		return "", err
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return "", sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return "", err
+	}
 	if res != nil {
 		return res.Version, nil
 	}
@@ -61,17 +67,23 @@ func (k Keeper) OnConnectChannel(
 	if err != nil {
 		return err
 	}
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddr, contractInfo.CodeID, EntryPointIBCChannelConnect); err != nil {
+		return err
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
@@ -93,17 +105,23 @@ func (k Keeper) OnCloseChannel(
 	if err != nil {
 		return err
 	}
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddr, contractInfo.CodeID, EntryPointIBCChannelClose); err != nil {
+		return err
+	}
 
 	params := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
@@ -124,17 +142,23 @@ func (k Keeper) OnRecvPacket(
 	if err != nil {
 		return nil, err
 	}
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddr, contractInfo.CodeID, EntryPointIBCPacketReceive); err != nil {
+		return nil, err
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return nil, err
+	}
 	if res.Err != "" { // handle error case as before https://github.com/CosmWasm/wasmvm/commit/c300106fe5c9426a495f8e10821e00a9330c56c6
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, res.Err)
 	}
@@ -147,6 +171,7 @@ func (k Keeper) OnRecvPacket(
 // contract. The use of the standard acknowledgement envelope is recommended: https://github.com/cosmos/ics/tree/master/spec/ics-004-channel-and-packet-semantics#acknowledgement-envelope
 //
 // On application errors the contract can revert an operation like returning tokens as in ibc-transfer.
+// The callback is skipped when the contract access checker rejects it.
 //
 // For more information see: https://github.com/cosmos/ics/tree/master/spec/ics-004-channel-and-packet-semantics#packet-flow--handling
 func (k Keeper) OnAckPacket(
@@ -159,23 +184,32 @@ func (k Keeper) OnAckPacket(
 	if err != nil {
 		return err
 	}
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddr, contractInfo.CodeID, EntryPointIBCPacketAck); err != nil {
+		// the packet commitment must still be deleted, so that an ordered channel does not get stuck
+		k.Logger(ctx).Info("skip the ibc packet ack callback", "contract", contractAddr.String(), "reason", err.Error())
+		return nil
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return err
+	}
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
 
 // OnTimeoutPacket calls the contract to let it know the packet was never received on the destination chain within
 // the timeout boundaries.
-// The contract should handle this on the application level and undo the original operation
+// The contract should handle this on the application level and undo the original operation.
+// The callback is skipped when the contract access checker rejects it.
 func (k Keeper) OnTimeoutPacket(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
@@ -187,17 +221,25 @@ func (k Keeper) OnTimeoutPacket(
 	if err != nil {
 		return err
 	}
+	if err := k.accessChecker.CheckContractAccess(ctx, contractAddr, contractInfo.CodeID, EntryPointIBCPacketTimeout); err != nil {
+		// the packet commitment must still be deleted, so that an ordered channel does not get stuck
+		k.Logger(ctx).Info("skip the ibc packet timeout callback", "contract", contractAddr.String(), "reason", err.Error())
+		return nil
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, k.jsonDeserializationCost(ctx))
 	k.consumeRuntimeGas(ctx, gasUsed)
 
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := prefixStore.commitUsage(); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
@@ -702,3 +702,49 @@ func stripTypes(events sdk.Events) []string {
 	}
 	return r
 }
+
+func TestOnAckAndTimeoutPacketSkippedOnRejectedAccess(t *testing.T) {
+	var m wasmtesting.MockWasmer
+	wasmtesting.MakeIBCInstantiable(&m)
+	var rejected bool
+	checker := contractAccessCheckerFn(func(sdk.Context, sdk.AccAddress, uint64, EntryPoint) error {
+		if rejected {
+			return errors.New("rejected")
+		}
+		return nil
+	})
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithContractAccessChecker(checker))
+	example := SeedNewContractInstance(t, parentCtx, keepers, &m)
+	var called bool
+	m.IBCPacketAckFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCPacketAckMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResponse, uint64, error) {
+		called = true
+		return &wasmvmtypes.IBCBasicResponse{}, 0, nil
+	}
+	m.IBCPacketTimeoutFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCPacketTimeoutMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResponse, uint64, error) {
+		called = true
+		return &wasmvmtypes.IBCBasicResponse{}, 0, nil
+	}
+	rejected = true
+
+	specs := map[string]func(ctx sdk.Context) error{
+		"ack": func(ctx sdk.Context) error {
+			return keepers.WasmKeeper.OnAckPacket(ctx, example.Contract, wasmvmtypes.IBCPacketAckMsg{})
+		},
+		"timeout": func(ctx sdk.Context) error {
+			return keepers.WasmKeeper.OnTimeoutPacket(ctx, example.Contract, wasmvmtypes.IBCPacketTimeoutMsg{})
+		},
+	}
+	for name, callback := range specs {
+		t.Run(name, func(t *testing.T) {
+			called = false
+			ctx, _ := parentCtx.CacheContext()
+
+			// when
+			err := callback(ctx)
+
+			// then the packet lifecycle completes without calling the contract
+			require.NoError(t, err)
+			assert.False(t, called)
+		})
+	}
+}
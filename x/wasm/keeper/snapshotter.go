@@ -108,11 +108,14 @@ func restoreV1(ctx sdk.Context, k *Keeper, compressedCode []byte) error {
 		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
 
-	// FIXME: check which codeIDs the checksum matches??
-	_, err = k.wasmVM.Create(wasmCode)
+	checksum, err := k.wasmVM.Create(wasmCode)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
+	// the store state is restored before the extension items so that the code must be referenced already
+	if !k.hasCodeWithChecksum(ctx, checksum) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "no code id for checksum %X", checksum)
+	}
 	return nil
 }
 
@@ -24,7 +24,8 @@ import (
 
 func TestSnapshotter(t *testing.T) {
 	specs := map[string]struct {
-		wasmFiles []string
+		wasmFiles     []string
+		removeCodeIDs []uint64
 	}{
 		"single contract": {
 			wasmFiles: []string{"./testdata/reflect.wasm"},
@@ -35,6 +36,10 @@ func TestSnapshotter(t *testing.T) {
 		"duplicate contracts": {
 			wasmFiles: []string{"./testdata/reflect.wasm", "./testdata/reflect.wasm"},
 		},
+		"gaps in code ids": {
+			wasmFiles:     []string{"./testdata/reflect.wasm", "./testdata/burner.wasm", "./testdata/reflect.wasm", "./testdata/burner.wasm"},
+			removeCodeIDs: []uint64{1, 2, 4},
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -59,6 +64,10 @@ func TestSnapshotter(t *testing.T) {
 				require.Equal(t, uint64(i+1), codeID)
 				srcCodeIDToChecksum[codeID] = checksum
 			}
+			for _, codeID := range spec.removeCodeIDs {
+				require.NoError(t, contractKeeper.RemoveCode(ctx, codeID, genesisAddr))
+				delete(srcCodeIDToChecksum, codeID)
+			}
 			// create snapshot
 			srcWasmApp.Commit()
 			snapshotHeight := uint64(srcWasmApp.LastBlockHeight())
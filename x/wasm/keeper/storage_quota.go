@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// GetContractStorageQuota returns the storage quota of the contract that was set by governance
+func (k Keeper) GetContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress) (types.StorageQuota, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractStorageQuotaKey(contractAddress))
+	if bz == nil {
+		return types.StorageQuota{}, false
+	}
+	var quota types.StorageQuota
+	k.cdc.MustUnmarshal(bz, &quota)
+	return quota, true
+}
+
+// setContractStorageQuota sets the storage quota of the contract that takes precedence over the params
+func (k Keeper) setContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota types.StorageQuota) error {
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetContractStorageQuotaKey(contractAddress), k.cdc.MustMarshal(&quota))
+	return nil
+}
+
+// contractStorageQuota returns the maximum state size of the contract in bytes, 0 for no limit.
+// The quota set by governance takes precedence over the code override and the default of the params.
+func (k Keeper) contractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64) uint64 {
+	if quota, ok := k.GetContractStorageQuota(ctx, contractAddress); ok {
+		return quota.MaxContractBytes
+	}
+	var params types.StorageQuotaParams
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyStorageQuota, &params)
+	return params.MaxContractBytesFor(codeID)
+}
+
+// removeCodeStorageQuota drops the storage quota override of the code from the params
+func (k Keeper) removeCodeStorageQuota(ctx sdk.Context, codeID uint64) {
+	var params types.StorageQuotaParams
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyStorageQuota, &params)
+	for i, o := range params.CodeOverrides {
+		if o.CodeID == codeID {
+			params.CodeOverrides = append(params.CodeOverrides[:i], params.CodeOverrides[i+1:]...)
+			k.paramSpace.Set(ctx, types.ParamStoreKeyStorageQuota, params)
+			return
+		}
+	}
+}
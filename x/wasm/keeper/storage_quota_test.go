@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestStorageQuotaStore(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+	k.storeContractInfo(ctx, contractAddr, &types.ContractInfo{CodeID: 1, Creator: RandomBech32AccountAddress(t)})
+
+	specs := map[string]struct {
+		params      types.StorageQuotaParams
+		govQuota    *types.StorageQuota
+		src         func(store storageUsageStore)
+		expQuotaErr bool
+	}{
+		"no limit": {
+			src: func(store storageUsageStore) {
+				store.Set([]byte("foo"), []byte("barbaz"))
+			},
+		},
+		"within params quota": {
+			params: types.StorageQuotaParams{MaxContractBytes: 6},
+			src: func(store storageUsageStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+			},
+		},
+		"exceeds params quota": {
+			params: types.StorageQuotaParams{MaxContractBytes: 6},
+			src: func(store storageUsageStore) {
+				store.Set([]byte("foo"), []byte("barbaz"))
+			},
+			expQuotaErr: true,
+		},
+		"exceeds params quota after delete and set": {
+			params: types.StorageQuotaParams{MaxContractBytes: 6},
+			src: func(store storageUsageStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Delete([]byte("foo"))
+				store.Set([]byte("a"), []byte("bcdefgh"))
+			},
+			expQuotaErr: true,
+		},
+		"code override takes precedence over params quota": {
+			params: types.StorageQuotaParams{
+				MaxContractBytes: 6,
+				CodeOverrides:    []types.CodeStorageQuota{{CodeID: 1, MaxContractBytes: 9}},
+			},
+			src: func(store storageUsageStore) {
+				store.Set([]byte("foo"), []byte("barbaz"))
+			},
+		},
+		"code override of other code": {
+			params: types.StorageQuotaParams{
+				CodeOverrides: []types.CodeStorageQuota{{CodeID: 2, MaxContractBytes: 6}},
+			},
+			src: func(store storageUsageStore) {
+				store.Set([]byte("foo"), []byte("barbaz"))
+			},
+		},
+		"governance quota takes precedence over params": {
+			params: types.StorageQuotaParams{
+				MaxContractBytes: 6,
+				CodeOverrides:    []types.CodeStorageQuota{{CodeID: 1, MaxContractBytes: 6}},
+			},
+			govQuota: &types.StorageQuota{MaxContractBytes: 9},
+			src: func(store storageUsageStore) {
+				store.Set([]byte("foo"), []byte("barbaz"))
+			},
+		},
+		"governance quota without limit": {
+			params:   types.StorageQuotaParams{MaxContractBytes: 6},
+			govQuota: &types.StorageQuota{},
+			src: func(store storageUsageStore) {
+				store.Set([]byte("foo"), []byte("barbaz"))
+			},
+		},
+		"exceeds governance quota": {
+			govQuota: &types.StorageQuota{MaxContractBytes: 6},
+			src: func(store storageUsageStore) {
+				store.Set([]byte("foo"), []byte("barbaz"))
+			},
+			expQuotaErr: true,
+		},
+		"shrinking writes above the quota": {
+			src: func(store storageUsageStore) {
+				store.Set([]byte("foo"), []byte("barbaz"))
+				store.quota = 6
+				store.Set([]byte("foo"), []byte("barb"))
+				store.Set([]byte("foo"), []byte("barb"))
+				store.Delete([]byte("foo"))
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			params := k.GetParams(xCtx)
+			params.StorageQuota = spec.params
+			k.SetParams(xCtx, params)
+			if spec.govQuota != nil {
+				require.NoError(t, k.setContractStorageQuota(xCtx, contractAddr, *spec.govQuota))
+			}
+
+			store := k.contractStore(xCtx, contractAddr, 1)
+			spec.src(store)
+
+			// the write is not reverted by the store but fails the execution
+			gotErr := store.commitUsage()
+			if spec.expQuotaErr {
+				assert.ErrorIs(t, gotErr, types.ErrStorageQuotaExceeded)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, k.computeContractStorageUsage(xCtx, contractAddr), k.GetContractStorageUsage(xCtx, contractAddr))
+		})
+	}
+}
+
+func TestSetContractStorageQuota(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	_, found := k.GetContractStorageQuota(ctx, example.Contract)
+	assert.False(t, found)
+
+	require.NoError(t, k.setContractStorageQuota(ctx, example.Contract, types.StorageQuota{MaxContractBytes: 1}))
+	gotQuota, found := k.GetContractStorageQuota(ctx, example.Contract)
+	assert.True(t, found)
+	assert.Equal(t, types.StorageQuota{MaxContractBytes: 1}, gotQuota)
+
+	gotErr := k.setContractStorageQuota(ctx, RandomAccountAddress(t), types.StorageQuota{MaxContractBytes: 1})
+	assert.ErrorIs(t, gotErr, types.ErrNotFound)
+}
+
+func TestStorageQuotaOnInstantiate(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+
+	specs := map[string]struct {
+		params types.StorageQuotaParams
+		expErr error
+	}{
+		"no limit": {},
+		"within quota": {
+			params: types.StorageQuotaParams{MaxContractBytes: 1024},
+		},
+		"exceeds quota": {
+			params: types.StorageQuotaParams{MaxContractBytes: 10},
+			expErr: types.ErrStorageQuotaExceeded,
+		},
+		"code override": {
+			params: types.StorageQuotaParams{
+				MaxContractBytes: 10,
+				CodeOverrides:    []types.CodeStorageQuota{{CodeID: example.CodeID, MaxContractBytes: 1024}},
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			params := k.GetParams(xCtx)
+			params.StorageQuota = spec.params
+			k.SetParams(xCtx, params)
+
+			_, _, gotErr := keepers.ContractKeeper.Instantiate(xCtx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "label", nil)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			assert.NoError(t, gotErr)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	wasmvm "github.com/Finschia/wasmvm"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+var _ wasmvm.KVStore = storageUsageStore{}
+
+// storageUsageStore is the prefix store of a contract that is given to the wasm VM. It maintains the storage usage
+// counters of the contract on every write and records writes that exceed the storage quota.
+// The previous values are read from the metered store so that the accounting is paid by the contract. The counters
+// are kept in memory and written once by commitUsage after the VM call.
+type storageUsageStore struct {
+	prefix.Store
+	k               Keeper
+	ctx             sdk.Context
+	contractAddress sdk.AccAddress
+	// quota is the maximum state size in bytes, 0 for no limit
+	quota uint64
+	// usage is shared by the copies of the store that are given to the wasm VM
+	usage *storageUsage
+}
+
+// storageUsage are the counters of a contract that are changed within a VM call
+type storageUsage struct {
+	types.ContractStorageUsage
+	// loaded is set when the counters were read from the store
+	loaded bool
+	// changed is set when the counters must be written
+	changed bool
+	// quotaErr is set by the first write that exceeded the quota. The wasm VM does not accept errors from the store
+	// so that the execution is failed by the keeper after the VM call.
+	quotaErr error
+}
+
+// contractStore returns the prefix store of the contract that counts the storage usage and enforces the storage
+// quota of the contract
+func (k Keeper) contractStore(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64) storageUsageStore {
+	unmeteredCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	return k.newStorageUsageStore(ctx, contractAddress, k.contractStorageQuota(unmeteredCtx, contractAddress, codeID))
+}
+
+func (k Keeper) newStorageUsageStore(ctx sdk.Context, contractAddress sdk.AccAddress, quota uint64) storageUsageStore {
+	return storageUsageStore{
+		Store:           prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractStorePrefix(contractAddress)),
+		k:               k,
+		ctx:             ctx,
+		contractAddress: contractAddress,
+		quota:           quota,
+		usage:           &storageUsage{},
+	}
+}
+
+// Set stores the value and adds the new entry or the changed value length to the storage usage
+func (s storageUsageStore) Set(key, value []byte) {
+	prev := s.Store.Get(key)
+	s.Store.Set(key, value)
+
+	usage := s.loadUsage()
+	prevBytes := usage.Bytes
+	if prev == nil {
+		usage.Keys++
+		usage.Bytes += uint64(len(key) + len(value))
+	} else {
+		usage.Bytes = usage.Bytes - uint64(len(prev)) + uint64(len(value))
+	}
+	usage.changed = true
+
+	// shrinking writes are accepted so that a contract above a lowered quota can clean up its state
+	if s.quota != 0 && usage.Bytes > s.quota && usage.Bytes > prevBytes && usage.quotaErr == nil {
+		usage.quotaErr = sdkerrors.Wrapf(types.ErrStorageQuotaExceeded, "%d bytes exceed the quota of %d bytes", usage.Bytes, s.quota)
+	}
+}
+
+// Delete removes the entry and subtracts it from the storage usage
+func (s storageUsageStore) Delete(key []byte) {
+	prev := s.Store.Get(key)
+	s.Store.Delete(key)
+	if prev == nil {
+		return
+	}
+
+	usage := s.loadUsage()
+	usage.Keys--
+	usage.Bytes -= uint64(len(key) + len(prev))
+	usage.changed = true
+}
+
+// loadUsage reads the counters on the first write
+func (s storageUsageStore) loadUsage() *storageUsage {
+	if !s.usage.loaded {
+		s.usage.ContractStorageUsage = s.k.GetContractStorageUsage(s.ctx, s.contractAddress)
+		s.usage.loaded = true
+	}
+	return s.usage
+}
+
+// commitUsage writes the changed counters and returns the error of the first write that exceeded the storage quota,
+// if any. It must be called after the VM call and before any message of the contract is dispatched.
+func (s storageUsageStore) commitUsage() error {
+	if s.usage == nil {
+		return nil
+	}
+	if s.usage.quotaErr != nil {
+		return s.usage.quotaErr
+	}
+	if s.usage.changed {
+		s.k.setContractStorageUsage(s.ctx, s.contractAddress, s.usage.ContractStorageUsage)
+		s.usage.changed = false
+	}
+	return nil
+}
+
+// GetContractStorageUsage returns the amount of state held by the contract
+func (k Keeper) GetContractStorageUsage(ctx sdk.Context, contractAddress sdk.AccAddress) types.ContractStorageUsage {
+	var usage types.ContractStorageUsage
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractStorageUsageKey(contractAddress))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+	return usage
+}
+
+func (k Keeper) setContractStorageUsage(ctx sdk.Context, contractAddress sdk.AccAddress, usage types.ContractStorageUsage) {
+	ctx.KVStore(k.storeKey).Set(types.GetContractStorageUsageKey(contractAddress), k.cdc.MustMarshal(&usage))
+}
+
+// computeContractStorageUsage counts the entries of the contract state
+func (k Keeper) computeContractStorageUsage(ctx sdk.Context, contractAddress sdk.AccAddress) types.ContractStorageUsage {
+	var usage types.ContractStorageUsage
+	k.IterateContractState(ctx, contractAddress, func(key, value []byte) bool {
+		usage.Keys++
+		usage.Bytes += uint64(len(key) + len(value))
+		return false
+	})
+	return usage
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	wasmvm "github.com/Finschia/wasmvm"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+func TestStorageUsageStore(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		src      func(store wasmvm.KVStore)
+		expUsage types.ContractStorageUsage
+	}{
+		"set new key": {
+			src: func(store wasmvm.KVStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+			},
+			expUsage: types.ContractStorageUsage{Bytes: 6, Keys: 1},
+		},
+		"overwrite with longer value": {
+			src: func(store wasmvm.KVStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Set([]byte("foo"), []byte("barbaz"))
+			},
+			expUsage: types.ContractStorageUsage{Bytes: 9, Keys: 1},
+		},
+		"overwrite with shorter value": {
+			src: func(store wasmvm.KVStore) {
+				store.Set([]byte("foo"), []byte("barbaz"))
+				store.Set([]byte("foo"), []byte("b"))
+			},
+			expUsage: types.ContractStorageUsage{Bytes: 4, Keys: 1},
+		},
+		"multiple keys": {
+			src: func(store wasmvm.KVStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Set([]byte("a"), []byte("b"))
+			},
+			expUsage: types.ContractStorageUsage{Bytes: 8, Keys: 2},
+		},
+		"delete key": {
+			src: func(store wasmvm.KVStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Set([]byte("a"), []byte("b"))
+				store.Delete([]byte("foo"))
+			},
+			expUsage: types.ContractStorageUsage{Bytes: 2, Keys: 1},
+		},
+		"delete non existing key": {
+			src: func(store wasmvm.KVStore) {
+				store.Set([]byte("foo"), []byte("bar"))
+				store.Delete([]byte("bar"))
+			},
+			expUsage: types.ContractStorageUsage{Bytes: 6, Keys: 1},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			xCtx = xCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			store := k.contractStore(xCtx, contractAddr, 1)
+			spec.src(store)
+			gotGas := xCtx.GasMeter().GasConsumed()
+
+			// the counters are written once after the VM call
+			assert.Equal(t, types.ContractStorageUsage{}, k.GetContractStorageUsage(xCtx, contractAddr))
+			require.NoError(t, store.commitUsage())
+			assert.Equal(t, spec.expUsage, k.GetContractStorageUsage(xCtx, contractAddr))
+			assert.Equal(t, spec.expUsage, k.computeContractStorageUsage(xCtx, contractAddr))
+
+			// the accounting is paid by the contract
+			yCtx, _ := ctx.CacheContext()
+			yCtx = yCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			spec.src(prefix.NewStore(yCtx.KVStore(k.storeKey), types.GetContractStorePrefix(contractAddr)))
+			assert.Greater(t, gotGas, yCtx.GasMeter().GasConsumed())
+		})
+	}
+}
+
+func TestStorageUsageStoreCommitsOnce(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+
+	store := k.contractStore(ctx, contractAddr, 1)
+	store.Set([]byte("foo"), []byte("bar"))
+	store.Set([]byte("a"), []byte("b"))
+	require.NoError(t, store.commitUsage())
+
+	// when nothing changed
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	store = k.contractStore(ctx, contractAddr, 1)
+	store.Delete([]byte("unknown"))
+	before := ctx.GasMeter().GasConsumed()
+	require.NoError(t, store.commitUsage())
+	// then the counters are not written
+	assert.Equal(t, before, ctx.GasMeter().GasConsumed())
+	assert.Equal(t, types.ContractStorageUsage{Bytes: 8, Keys: 2}, k.GetContractStorageUsage(ctx, contractAddr))
+
+	// when the store is copied like by the wasm VM
+	store = k.contractStore(ctx, contractAddr, 1)
+	copied := store
+	copied.Delete([]byte("foo"))
+	require.NoError(t, store.commitUsage())
+	// then the changes are shared
+	assert.Equal(t, types.ContractStorageUsage{Bytes: 2, Keys: 1}, k.GetContractStorageUsage(ctx, contractAddr))
+}
+
+func TestStorageUsageOnInstantiate(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// hackatom stores its config in a single entry
+	gotUsage := k.GetContractStorageUsage(ctx, example.Contract)
+	assert.Equal(t, uint64(1), gotUsage.Keys)
+	assert.Equal(t, k.computeContractStorageUsage(ctx, example.Contract), gotUsage)
+}
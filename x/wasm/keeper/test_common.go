@@ -26,6 +26,7 @@ import (
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	"github.com/Finschia/finschia-sdk/x/auth/vesting"
 	authzkeeper "github.com/Finschia/finschia-sdk/x/authz/keeper"
+	authzmodule "github.com/Finschia/finschia-sdk/x/authz/module"
 	"github.com/Finschia/finschia-sdk/x/bank"
 	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
@@ -43,6 +44,8 @@ import (
 	"github.com/Finschia/finschia-sdk/x/evidence"
 	evidencetypes "github.com/Finschia/finschia-sdk/x/evidence/types"
 	"github.com/Finschia/finschia-sdk/x/feegrant"
+	feegrantkeeper "github.com/Finschia/finschia-sdk/x/feegrant/keeper"
+	feegrantmodule "github.com/Finschia/finschia-sdk/x/feegrant/module"
 	"github.com/Finschia/finschia-sdk/x/gov"
 	govkeeper "github.com/Finschia/finschia-sdk/x/gov/keeper"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
@@ -95,6 +98,8 @@ var moduleBasics = module.NewBasicManager(
 	evidence.AppModuleBasic{},
 	transfer.AppModuleBasic{},
 	vesting.AppModuleBasic{},
+	feegrantmodule.AppModuleBasic{},
+	authzmodule.AppModuleBasic{},
 )
 
 func MakeTestCodec(t testing.TB) codec.Codec {
@@ -178,6 +183,8 @@ type TestKeepers struct {
 	DistKeeper       distributionkeeper.Keeper
 	BankKeeper       bankkeeper.Keeper
 	GovKeeper        govkeeper.Keeper
+	FeeGrantKeeper   feegrantkeeper.Keeper
+	AuthzKeeper      authzkeeper.Keeper
 	ContractKeeper   types.ContractOpsKeeper
 	WasmKeeper       *Keeper
 	IBCKeeper        *ibckeeper.Keeper
@@ -373,6 +380,9 @@ func createTestInput(
 	msgRouter := baseapp.NewMsgServiceRouter()
 	msgRouter.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
 
+	feeGrantKeeper := feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], accountKeeper)
+	authzKeeper := authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, msgRouter)
+
 	cfg := sdk.GetConfig()
 	cfg.SetAddressVerifier(types.VerifyAddressLen())
 
@@ -393,6 +403,7 @@ func createTestInput(
 		tempDir,
 		wasmConfig,
 		availableCapabilities,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		opts...,
 	)
 	keeper.SetParams(ctx, types.DefaultParams())
@@ -404,6 +415,8 @@ func createTestInput(
 		bankplus.NewAppModule(appCodec, bankKeeper, accountKeeper),
 		staking.NewAppModule(appCodec, stakingKeeper, accountKeeper, bankKeeper),
 		distribution.NewAppModule(appCodec, distKeeper, accountKeeper, bankKeeper, stakingKeeper),
+		feegrantmodule.NewAppModule(appCodec, accountKeeper, bankKeeper, feeGrantKeeper, encodingConfig.InterfaceRegistry),
+		authzmodule.NewAppModule(appCodec, authzKeeper, accountKeeper, bankKeeper, encodingConfig.InterfaceRegistry),
 	)
 	am.RegisterServices(module.NewConfigurator(appCodec, msgRouter, querier))
 	types.RegisterMsgServer(msgRouter, NewMsgServerImpl(NewDefaultPermissionKeeper(keeper)))
@@ -429,6 +442,7 @@ func createTestInput(
 	govKeeper.SetDepositParams(ctx, govtypes.DefaultDepositParams())
 	govKeeper.SetVotingParams(ctx, govtypes.DefaultVotingParams())
 	govKeeper.SetTallyParams(ctx, govtypes.DefaultTallyParams())
+	govtypes.RegisterMsgServer(msgRouter, govkeeper.NewMsgServerImpl(govKeeper))
 
 	keepers := TestKeepers{
 		AccountKeeper:    accountKeeper,
@@ -438,6 +452,8 @@ func createTestInput(
 		WasmKeeper:       &keeper,
 		BankKeeper:       bankKeeper,
 		GovKeeper:        govKeeper,
+		FeeGrantKeeper:   feeGrantKeeper,
+		AuthzKeeper:      authzKeeper,
 		IBCKeeper:        ibcKeeper,
 		Router:           router,
 		EncodingConfig:   encodingConfig,
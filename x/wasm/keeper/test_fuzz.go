@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/json"
+	"fmt"
 
 	fuzz "github.com/google/gofuzz"
 
@@ -11,7 +12,7 @@ import (
 	"github.com/Finschia/wasmd/x/wasm/types"
 )
 
-var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory}
+var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory, FuzzAcceptedStargateQuery, FuzzStargateMsgFilter}
 
 func FuzzAddr(m *sdk.AccAddress, c fuzz.Continue) {
 	*m = make([]byte, 20)
@@ -75,3 +76,18 @@ func FuzzAccessConfig(m *types.AccessConfig, c fuzz.Continue) {
 	FuzzAddr(&add, c)
 	*m = m.Permission.With(add)
 }
+
+func FuzzAcceptedStargateQuery(m *types.AcceptedStargateQuery, c fuzz.Continue) {
+	m.Path = fmt.Sprintf("/fuzz.Query/%d", c.Uint64())
+	m.ResponseTypeURL = "/fuzz." + c.RandString()
+}
+
+func FuzzStargateMsgFilter(m *types.StargateMsgFilter, c fuzz.Continue) {
+	// an empty list is decoded as nil
+	m.DenyList = nil
+	for i, n := 0, c.Intn(5); i < n; i++ {
+		m.DenyList = append(m.DenyList, fmt.Sprintf("/fuzz.Msg%d", i))
+	}
+	m.AcceptList = nil
+	m.MaxMsgSize = c.Uint64()
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	wasmvmtypes "github.com/Finschia/wasmvm/types"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+// TraceEntryPointSubMsg is the entry point of the trace frames of dispatched sub messages
+const TraceEntryPointSubMsg = "submsg"
+
+// traceFrame begins a frame with the tracer of the context and returns the function to end it.
+// The function must be deferred with a pointer to the error result so that panics are recorded, too.
+// Frames are not recorded in the consensus execution, where the context is not in check tx mode,
+// to keep the results deterministic.
+func traceFrame(ctx sdk.Context, frame types.TraceFrame) func(err *error) {
+	if !ctx.IsCheckTx() {
+		return func(*error) {}
+	}
+	tracer, ok := types.TracerFromContext(ctx)
+	if !ok {
+		return func(*error) {}
+	}
+	frame.GasBefore = ctx.GasMeter().GasConsumed()
+	tracer.BeginFrame(frame)
+	return func(err *error) {
+		if r := recover(); r != nil {
+			tracer.EndFrame(ctx.GasMeter().GasConsumed(), fmt.Errorf("panic: %v", r))
+			panic(r)
+		}
+		tracer.EndFrame(ctx.GasMeter().GasConsumed(), *err)
+	}
+}
+
+func contractTraceFrame(entryPoint EntryPoint, contractAddress sdk.AccAddress, codeID uint64) types.TraceFrame {
+	return types.TraceFrame{
+		EntryPoint: string(entryPoint),
+		Contract:   contractAddress.String(),
+		CodeID:     codeID,
+	}
+}
+
+func subMsgTraceFrame(contractAddress sdk.AccAddress, msg wasmvmtypes.SubMsg) types.TraceFrame {
+	return types.TraceFrame{
+		EntryPoint: TraceEntryPointSubMsg,
+		Contract:   contractAddress.String(),
+		SubMsgID:   msg.ID,
+		ReplyOn:    msg.ReplyOn.String(),
+	}
+}
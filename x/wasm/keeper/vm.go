@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	wasmvm "github.com/Finschia/wasmvm"
+
+	"github.com/Finschia/wasmd/x/wasm/types"
+)
+
+var _ types.WasmerEngine = &wasmVMEngine{}
+
+// wasmVMEngine extends the wasmvm with the operations that are not supported by the library itself.
+type wasmVMEngine struct {
+	*wasmvm.VM
+}
+
+func newWasmVMEngine(dataDir string, supportedCapabilities string, memoryLimit uint32, printDebug bool, cacheSize uint32) (*wasmVMEngine, error) {
+	vm, err := wasmvm.NewVM(dataDir, supportedCapabilities, memoryLimit, printDebug, cacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &wasmVMEngine{VM: vm}, nil
+}
+
+// RemoveCode releases the given checksum from the pinned memory cache. The wasmvm version in go.mod
+// does not provide an API to delete the original code and the compiled modules, so that the files
+// are kept in the wasmvm file system cache until such an API is available. They are not deleted
+// here as the private file layout of the wasmvm can change and a file system change done before
+// the block is committed can not be reverted with the state.
+func (e *wasmVMEngine) RemoveCode(checksum wasmvm.Checksum) error {
+	return e.Unpin(checksum)
+}
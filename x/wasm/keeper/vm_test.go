@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWasmVMEngineRemoveCode(t *testing.T) {
+	engine, err := newWasmVMEngine(t.TempDir(), AvailableCapabilities, contractMemoryLimit, false, 0)
+	require.NoError(t, err)
+	t.Cleanup(engine.Cleanup)
+
+	checksum, err := engine.Create(hackatomWasm)
+	require.NoError(t, err)
+	require.NoError(t, engine.Pin(checksum))
+
+	// when
+	require.NoError(t, engine.RemoveCode(checksum))
+
+	// then
+	metrics, err := engine.GetMetrics()
+	require.NoError(t, err)
+	assert.Zero(t, metrics.ElementsPinnedMemoryCache)
+	// and the files are kept
+	_, err = engine.GetCode(checksum)
+	assert.NoError(t, err)
+	// and is idempotent
+	require.NoError(t, engine.RemoveCode(checksum))
+	// and the code can be stored again
+	got, err := engine.Create(hackatomWasm)
+	require.NoError(t, err)
+	assert.Equal(t, checksum, got)
+}
@@ -18,56 +18,56 @@ type MockGasRegister struct {
 	UncompressCostsFn         func(byteLength int) sdk.Gas
 }
 
-func (m MockGasRegister) NewContractInstanceCosts(pinned bool, msgLen int) sdk.Gas {
+func (m MockGasRegister) NewContractInstanceCosts(_ sdk.Context, pinned bool, msgLen int) sdk.Gas {
 	if m.NewContractInstanceCostFn == nil {
 		panic("not expected to be called")
 	}
 	return m.NewContractInstanceCostFn(pinned, msgLen)
 }
 
-func (m MockGasRegister) CompileCosts(byteLength int) sdk.Gas {
+func (m MockGasRegister) CompileCosts(_ sdk.Context, byteLength int) sdk.Gas {
 	if m.CompileCostFn == nil {
 		panic("not expected to be called")
 	}
 	return m.CompileCostFn(byteLength)
 }
 
-func (m MockGasRegister) UncompressCosts(byteLength int) sdk.Gas {
+func (m MockGasRegister) UncompressCosts(_ sdk.Context, byteLength int) sdk.Gas {
 	if m.UncompressCostsFn == nil {
 		panic("not expected to be called")
 	}
 	return m.UncompressCostsFn(byteLength)
 }
 
-func (m MockGasRegister) InstantiateContractCosts(pinned bool, msgLen int) sdk.Gas {
+func (m MockGasRegister) InstantiateContractCosts(_ sdk.Context, pinned bool, msgLen int) sdk.Gas {
 	if m.InstantiateContractCostFn == nil {
 		panic("not expected to be called")
 	}
 	return m.InstantiateContractCostFn(pinned, msgLen)
 }
 
-func (m MockGasRegister) ReplyCosts(pinned bool, reply wasmvmtypes.Reply) sdk.Gas {
+func (m MockGasRegister) ReplyCosts(_ sdk.Context, pinned bool, reply wasmvmtypes.Reply) sdk.Gas {
 	if m.ReplyCostFn == nil {
 		panic("not expected to be called")
 	}
 	return m.ReplyCostFn(pinned, reply)
 }
 
-func (m MockGasRegister) EventCosts(evts []wasmvmtypes.EventAttribute, events wasmvmtypes.Events) sdk.Gas {
+func (m MockGasRegister) EventCosts(_ sdk.Context, evts []wasmvmtypes.EventAttribute, events wasmvmtypes.Events) sdk.Gas {
 	if m.EventCostsFn == nil {
 		panic("not expected to be called")
 	}
 	return m.EventCostsFn(evts)
 }
 
-func (m MockGasRegister) ToWasmVMGas(source sdk.Gas) uint64 {
+func (m MockGasRegister) ToWasmVMGas(_ sdk.Context, source sdk.Gas) uint64 {
 	if m.ToWasmVMGasFn == nil {
 		panic("not expected to be called")
 	}
 	return m.ToWasmVMGasFn(source)
 }
 
-func (m MockGasRegister) FromWasmVMGas(source uint64) sdk.Gas {
+func (m MockGasRegister) FromWasmVMGas(_ sdk.Context, source uint64) sdk.Gas {
 	if m.FromWasmVMGasFn == nil {
 		panic("not expected to be called")
 	}
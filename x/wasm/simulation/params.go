@@ -38,5 +38,6 @@ func RandomParams(r *rand.Rand) types.Params {
 	return types.Params{
 		CodeUploadAccess:             accessConfig,
 		InstantiateDefaultPermission: accessConfig.Permission,
+		GasRegister:                  types.DefaultGasRegisterParams(),
 	}
 }
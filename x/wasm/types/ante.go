@@ -9,6 +9,7 @@ type contextKey int
 const (
 	// private type creates an interface key for Context that cannot be accessed by any other package
 	contextKeyTXCount contextKey = iota
+	contextKeyTracer
 )
 
 // WithTXCounter stores a transaction counter value in the context
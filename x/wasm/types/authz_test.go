@@ -0,0 +1,747 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdctypes "github.com/Finschia/finschia-sdk/codec/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authztypes "github.com/Finschia/finschia-sdk/x/authz"
+)
+
+func TestContractAuthzFilterValidate(t *testing.T) {
+	specs := map[string]struct {
+		src    ContractAuthzFilterX
+		expErr bool
+	}{
+		"allow all": {
+			src: &AllowAllMessagesFilter{},
+		},
+		"allow keys - single": {
+			src: NewAcceptedMessageKeysFilter("foo"),
+		},
+		"allow keys - multi": {
+			src: NewAcceptedMessageKeysFilter("foo", "bar"),
+		},
+		"allow keys - empty": {
+			src:    NewAcceptedMessageKeysFilter(),
+			expErr: true,
+		},
+		"allow keys - duplicates": {
+			src:    NewAcceptedMessageKeysFilter("foo", "foo"),
+			expErr: true,
+		},
+		"allow keys - whitespaces": {
+			src:    NewAcceptedMessageKeysFilter(" foo"),
+			expErr: true,
+		},
+		"allow keys - empty key": {
+			src:    NewAcceptedMessageKeysFilter("", "bar"),
+			expErr: true,
+		},
+		"allow message - single": {
+			src: NewAcceptedMessagesFilter([]byte(`{}`)),
+		},
+		"allow message - multiple": {
+			src: NewAcceptedMessagesFilter([]byte(`{}`), []byte(`{"foo":"bar"}`)),
+		},
+		"allow message - multiple with empty": {
+			src:    NewAcceptedMessagesFilter([]byte(`{}`), nil),
+			expErr: true,
+		},
+		"allow message - duplicate": {
+			src:    NewAcceptedMessagesFilter([]byte(`{}`), []byte(`{}`)),
+			expErr: true,
+		},
+		"allow message - non json": {
+			src:    NewAcceptedMessagesFilter([]byte("non-json")),
+			expErr: true,
+		},
+		"allow message - empty": {
+			src:    NewAcceptedMessagesFilter(),
+			expErr: true,
+		},
+		"allow all message - always valid": {
+			src: NewAllowAllMessagesFilter(),
+		},
+		"undefined - always invalid": {
+			src:    &UndefinedFilter{},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestContractAuthzFilterAccept(t *testing.T) {
+	specs := map[string]struct {
+		filter         ContractAuthzFilterX
+		src            RawContractMessage
+		exp            bool
+		expGasConsumed sdk.Gas
+		expErr         bool
+	}{
+		"allow all - accepts json obj": {
+			filter: &AllowAllMessagesFilter{},
+			src:    []byte(`{}`),
+			exp:    true,
+		},
+		"allow all - accepts json array": {
+			filter: &AllowAllMessagesFilter{},
+			src:    []byte(`[{},{}]`),
+			exp:    true,
+		},
+		"allow all - rejects non json msg": {
+			filter: &AllowAllMessagesFilter{},
+			src:    []byte(``),
+			expErr: true,
+		},
+		"allowed key - single": {
+			filter:         NewAcceptedMessageKeysFilter("foo"),
+			src:            []byte(`{"foo": "bar"}`),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(`{"foo": "bar"}`)),
+		},
+		"allowed key - multiple": {
+			filter:         NewAcceptedMessageKeysFilter("foo", "other"),
+			src:            []byte(`{"other": "value"}`),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(`{"other": "value"}`)),
+		},
+		"allowed key - non accepted key": {
+			filter:         NewAcceptedMessageKeysFilter("foo"),
+			src:            []byte(`{"bar": "value"}`),
+			exp:            false,
+			expGasConsumed: sdk.Gas(len(`{"bar": "value"}`)),
+		},
+		"allowed key - unsupported array msg": {
+			filter:         NewAcceptedMessageKeysFilter("foo", "other"),
+			src:            []byte(`[{"foo":"bar"}]`),
+			expErr:         true,
+			expGasConsumed: sdk.Gas(len(`[{"foo":"bar"}]`)),
+		},
+		"allowed key - multiple top level keys": {
+			filter:         NewAcceptedMessageKeysFilter("foo", "other"),
+			src:            []byte(`{"foo":"bar", "other":"value"}`),
+			expErr:         true,
+			expGasConsumed: sdk.Gas(len(`{"foo":"bar", "other":"value"}`)),
+		},
+		"allowed key - empty msg": {
+			filter: NewAcceptedMessageKeysFilter("foo", "other"),
+			src:    []byte(``),
+			expErr: true,
+		},
+		"allowed key - invalid msg": {
+			filter:         NewAcceptedMessageKeysFilter("foo", "other"),
+			src:            []byte(`not a json msg`),
+			expErr:         true,
+			expGasConsumed: sdk.Gas(len(`not a json msg`)),
+		},
+		"allow message - single": {
+			filter: NewAcceptedMessagesFilter([]byte(`{}`)),
+			src:    []byte(`{}`),
+			exp:    true,
+		},
+		"allow message - multiple": {
+			filter: NewAcceptedMessagesFilter([]byte(`[{"foo":"bar"}]`), []byte(`{"other":"value"}`)),
+			src:    []byte(`[{"foo":"bar"}]`),
+			exp:    true,
+		},
+		"allow message - no match": {
+			filter: NewAcceptedMessagesFilter([]byte(`{"foo":"bar"}`)),
+			src:    []byte(`{"other":"value"}`),
+			exp:    false,
+		},
+		"allow message - byte equal only": {
+			filter: NewAcceptedMessagesFilter([]byte(`{"foo":"bar"}`)),
+			src:    []byte(`{ "foo" : "bar" }`),
+			exp:    false,
+		},
+		"undefined - always rejects": {
+			filter: &UndefinedFilter{},
+			src:    []byte(`{}`),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gm := sdk.NewGasMeter(1_000_000)
+			allowed, gotErr := spec.filter.Accept(sdk.Context{}.WithGasMeter(gm), spec.src)
+
+			// then
+			assert.Equal(t, spec.expGasConsumed, gm.GasConsumed())
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, allowed)
+		})
+	}
+}
+
+func TestContractAuthzLimitValidate(t *testing.T) {
+	oneToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	specs := map[string]struct {
+		src    ContractAuthzLimitX
+		expErr bool
+	}{
+		"max calls": {
+			src: NewMaxCallsLimit(1),
+		},
+		"max calls - max uint64": {
+			src: NewMaxCallsLimit(math.MaxUint64),
+		},
+		"max calls - empty": {
+			src:    NewMaxCallsLimit(0),
+			expErr: true,
+		},
+		"max funds": {
+			src: NewMaxFundsLimit(oneToken),
+		},
+		"max funds - empty coins": {
+			src:    NewMaxFundsLimit(),
+			expErr: true,
+		},
+		"max funds - duplicates": {
+			src:    &MaxFundsLimit{Amounts: sdk.Coins{oneToken, oneToken}},
+			expErr: true,
+		},
+		"max funds - contains empty value": {
+			src:    &MaxFundsLimit{Amounts: sdk.Coins{oneToken, sdk.NewCoin("other", sdk.ZeroInt())}.Sort()},
+			expErr: true,
+		},
+		"max funds - unsorted": {
+			src:    &MaxFundsLimit{Amounts: sdk.Coins{oneToken, sdk.NewCoin("other", sdk.OneInt())}},
+			expErr: true,
+		},
+		"combined": {
+			src: NewCombinedLimit(1, oneToken),
+		},
+		"combined - empty calls": {
+			src:    NewCombinedLimit(0, oneToken),
+			expErr: true,
+		},
+		"combined - empty amounts": {
+			src:    NewCombinedLimit(1),
+			expErr: true,
+		},
+		"combined - invalid amounts": {
+			src:    &CombinedLimit{CallsRemaining: 1, Amounts: sdk.Coins{oneToken, oneToken}},
+			expErr: true,
+		},
+		"undefined": {
+			src:    &UndefinedLimit{},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestContractAuthzLimitAccept(t *testing.T) {
+	oneToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	otherToken := sdk.NewCoin("other", sdk.OneInt())
+	specs := map[string]struct {
+		limit  ContractAuthzLimitX
+		src    AuthzableWasmMsg
+		exp    *ContractAuthzLimitAcceptResult
+		expErr bool
+	}{
+		"max calls - updated": {
+			limit: NewMaxCallsLimit(2),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewMaxCallsLimit(1)},
+		},
+		"max calls - removed": {
+			limit: NewMaxCallsLimit(1),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"max calls - accepted with zero fund set": {
+			limit: NewMaxCallsLimit(1),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()))},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"max calls - rejected with some fund transfer": {
+			limit: NewMaxCallsLimit(1),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"max calls - invalid": {
+			limit:  &MaxCallsLimit{},
+			src:    &MsgExecuteContract{},
+			expErr: true,
+		},
+		"max funds - single updated": {
+			limit: NewMaxFundsLimit(oneToken.Add(oneToken)),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewMaxFundsLimit(oneToken)},
+		},
+		"max funds - single removed": {
+			limit: NewMaxFundsLimit(oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"max funds - single with unknown token": {
+			limit: NewMaxFundsLimit(oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(otherToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"max funds - single exceeds limit": {
+			limit: NewMaxFundsLimit(oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken.Add(oneToken))},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"max funds - single with additional token send": {
+			limit: NewMaxFundsLimit(oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken, otherToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"max funds - multi with other left": {
+			limit: NewMaxFundsLimit(oneToken, otherToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewMaxFundsLimit(otherToken)},
+		},
+		"max funds - multi with all used": {
+			limit: NewMaxFundsLimit(oneToken, otherToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken, otherToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"max funds - multi with no tokens sent": {
+			limit: NewMaxFundsLimit(oneToken, otherToken),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true},
+		},
+		"combined - within limits": {
+			limit: NewCombinedLimit(2, oneToken.Add(oneToken)),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewCombinedLimit(1, oneToken)},
+		},
+		"combined - calls used up": {
+			limit: NewCombinedLimit(1, oneToken.Add(oneToken)),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"combined - funds used up": {
+			limit: NewCombinedLimit(2, oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"combined - no funds sent": {
+			limit: NewCombinedLimit(2, oneToken),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewCombinedLimit(1, oneToken)},
+		},
+		"combined - exceeds funds": {
+			limit: NewCombinedLimit(2, oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken.Add(oneToken))},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"combined - invalid": {
+			limit:  &CombinedLimit{Amounts: sdk.NewCoins(oneToken)},
+			src:    &MsgExecuteContract{},
+			expErr: true,
+		},
+		"undefined": {
+			limit:  &UndefinedLimit{},
+			src:    &MsgExecuteContract{},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotResult, gotErr := spec.limit.Accept(sdk.Context{}, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResult)
+		})
+	}
+}
+
+func TestValidateContractGrant(t *testing.T) {
+	specs := map[string]struct {
+		setup  func(t *testing.T) ContractGrant
+		expErr bool
+	}{
+		"all good": {
+			setup: func(t *testing.T) ContractGrant {
+				return mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+			},
+		},
+		"invalid address": {
+			setup: func(t *testing.T) ContractGrant {
+				return mustGrant([]byte{}, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+			},
+			expErr: true,
+		},
+		"invalid limit": {
+			setup: func(t *testing.T) ContractGrant {
+				return mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(0), NewAllowAllMessagesFilter())
+			},
+			expErr: true,
+		},
+		"invalid filter ": {
+			setup: func(t *testing.T) ContractGrant {
+				return mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter())
+			},
+			expErr: true,
+		},
+		"empty limit": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(0), NewAllowAllMessagesFilter())
+				r.Limit = nil
+				return r
+			},
+			expErr: true,
+		},
+		"empty filter ": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter())
+				r.Filter = nil
+				return r
+			},
+			expErr: true,
+		},
+		"wrong limit type": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(0), NewAllowAllMessagesFilter())
+				r.Limit = r.Filter
+				return r
+			},
+			expErr: true,
+		},
+		"wrong filter type": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter())
+				r.Filter = r.Limit
+				return r
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.setup(t).ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestValidateContractAuthorization(t *testing.T) {
+	validGrant, err := NewContractGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+	require.NoError(t, err)
+	invalidGrant, err := NewContractGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+	require.NoError(t, err)
+	invalidGrant.Limit = nil
+
+	specs := map[string]struct {
+		setup  func(t *testing.T) authztypes.Authorization
+		expErr bool
+	}{
+		"contract execution": {
+			setup: func(t *testing.T) authztypes.Authorization {
+				return NewContractExecutionAuthorization(*validGrant)
+			},
+		},
+		"contract execution - duplicate grants": {
+			setup: func(t *testing.T) authztypes.Authorization {
+				return NewContractExecutionAuthorization(*validGrant, *validGrant)
+			},
+		},
+		"contract execution - invalid grant": {
+			setup: func(t *testing.T) authztypes.Authorization {
+				return NewContractExecutionAuthorization(*validGrant, *invalidGrant)
+			},
+			expErr: true,
+		},
+		"contract execution - empty grants": {
+			setup: func(t *testing.T) authztypes.Authorization {
+				return NewContractExecutionAuthorization()
+			},
+			expErr: true,
+		},
+		"contract migration": {
+			setup: func(t *testing.T) authztypes.Authorization {
+				return NewContractMigrationAuthorization(*validGrant)
+			},
+		},
+		"contract migration - duplicate grants": {
+			setup: func(t *testing.T) authztypes.Authorization {
+				return NewContractMigrationAuthorization(*validGrant, *validGrant)
+			},
+		},
+		"contract migration - invalid grant": {
+			setup: func(t *testing.T) authztypes.Authorization {
+				return NewContractMigrationAuthorization(*validGrant, *invalidGrant)
+			},
+			expErr: true,
+		},
+		"contract migration - empty grant": {
+			setup: func(t *testing.T) authztypes.Authorization {
+				return NewContractMigrationAuthorization()
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.setup(t).ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestAcceptGrantedMessage(t *testing.T) {
+	myContractAddr := sdk.AccAddress(randBytes(ContractAddrLen))
+	otherContractAddr := sdk.AccAddress(randBytes(ContractAddrLen))
+	specs := map[string]struct {
+		auth      authztypes.Authorization
+		msg       sdk.Msg
+		expResult authztypes.AcceptResponse
+		expErr    *sdkerrors.Error
+	}{
+		"accepted and updated - contract execution": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+		"accepted and not updated - limit not touched": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxFundsLimit(sdk.NewCoin("foo", sdk.OneInt())), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true},
+		},
+		"accepted and removed - single": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"accepted and updated - multi, one removed": {
+			auth: NewContractExecutionAuthorization(
+				mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+				mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+			),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+		"accepted and updated - multi, one updated": {
+			auth: NewContractExecutionAuthorization(
+				mustGrant(otherContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+				mustGrant(myContractAddr, NewMaxFundsLimit(sdk.NewCoin("foo", sdk.NewInt(2))), NewAcceptedMessageKeysFilter("bar")),
+				mustGrant(myContractAddr, NewCombinedLimit(2, sdk.NewCoin("foo", sdk.NewInt(2))), NewAcceptedMessageKeysFilter("foo")),
+			),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Funds:    sdk.NewCoins(sdk.NewCoin("foo", sdk.OneInt())),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept: true,
+				Updated: NewContractExecutionAuthorization(
+					mustGrant(otherContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+					mustGrant(myContractAddr, NewMaxFundsLimit(sdk.NewCoin("foo", sdk.NewInt(2))), NewAcceptedMessageKeysFilter("bar")),
+					mustGrant(myContractAddr, NewCombinedLimit(1, sdk.NewCoin("foo", sdk.NewInt(1))), NewAcceptedMessageKeysFilter("foo")),
+				),
+			},
+		},
+		"not accepted - no matching contract address": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: otherContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"not accepted - max calls but tokens": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Funds:    sdk.NewCoins(sdk.NewCoin("foo", sdk.OneInt())),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"not accepted - funds exceeds limit": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxFundsLimit(sdk.NewCoin("foo", sdk.OneInt())), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Funds:    sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(2))),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"not accepted - no matching filter": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter("other"))),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Funds:    sdk.NewCoins(sdk.NewCoin("foo", sdk.OneInt())),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"invalid msg type - contract execution": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgMigrateContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				CodeID:   1,
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expErr: sdkerrors.ErrInvalidType,
+		},
+		"payload is empty": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+			},
+			expErr: sdkerrors.ErrInvalidType,
+		},
+		"payload is invalid": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`not json`),
+			},
+			expErr: ErrInvalid,
+		},
+		"invalid grant": {
+			auth: NewContractExecutionAuthorization(ContractGrant{Contract: myContractAddr.String()}),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expErr: sdkerrors.ErrNotFound,
+		},
+		"invalid msg type - contract migration": {
+			auth: NewContractMigrationAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Funds:    sdk.NewCoins(sdk.NewCoin("foo", sdk.OneInt())),
+			},
+			expErr: sdkerrors.ErrInvalidType,
+		},
+		"accepted and updated - contract migration": {
+			auth: NewContractMigrationAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg: &MsgMigrateContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				CodeID:   1,
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractMigrationAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			gotResult, gotErr := spec.auth.Accept(ctx, spec.msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expResult, gotResult)
+		})
+	}
+}
+
+func TestContractAuthorizationUnpackInterfaces(t *testing.T) {
+	myContractAddr := sdk.AccAddress(randBytes(ContractAddrLen))
+	src := NewContractExecutionAuthorization(
+		mustGrant(myContractAddr, NewCombinedLimit(1, sdk.NewCoin("foo", sdk.OneInt())), NewAcceptedMessageKeysFilter("foo")),
+	)
+
+	registry := cdctypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	anyAuthz, err := cdctypes.NewAnyWithValue(src)
+	require.NoError(t, err)
+	bz, err := anyAuthz.Marshal()
+	require.NoError(t, err)
+
+	// when
+	var restored cdctypes.Any
+	require.NoError(t, restored.Unmarshal(bz))
+	var gotAuthz authztypes.Authorization
+	require.NoError(t, registry.UnpackAny(&restored, &gotAuthz))
+
+	// then
+	got, ok := gotAuthz.(*ContractExecutionAuthorization)
+	require.True(t, ok)
+	require.Len(t, got.Grants, 1)
+	assert.Equal(t, NewCombinedLimit(1, sdk.NewCoin("foo", sdk.OneInt())), got.Grants[0].GetLimit())
+	assert.Equal(t, NewAcceptedMessageKeysFilter("foo"), got.Grants[0].GetFilter())
+}
+
+func mustGrant(contract sdk.AccAddress, limit ContractAuthzLimitX, filter ContractAuthzFilterX) ContractGrant {
+	g, err := NewContractGrant(contract, limit, filter)
+	if err != nil {
+		panic(err)
+	}
+	return *g
+}
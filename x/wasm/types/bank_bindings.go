@@ -0,0 +1,61 @@
+package types
+
+// BankCustomQuery is the custom query of a contract for the x/bank queries that are not part of the wasmvm BankQuery.
+// See schema/bank_custom_query.json for the JSON schema.
+type BankCustomQuery struct {
+	Bank *ExtendedBankQuery `json:"bank,omitempty"`
+}
+
+// ExtendedBankQuery are the extended x/bank queries. Exactly one must be set.
+type ExtendedBankQuery struct {
+	DenomMetadata    *DenomMetadataQuery    `json:"denom_metadata,omitempty"`
+	AllDenomMetadata *AllDenomMetadataQuery `json:"all_denom_metadata,omitempty"`
+}
+
+// DenomMetadataQuery returns the metadata of the denom
+type DenomMetadataQuery struct {
+	Denom string `json:"denom"`
+}
+
+// DenomMetadataResponse is the response to DenomMetadataQuery
+type DenomMetadataResponse struct {
+	Metadata DenomMetadata `json:"metadata"`
+}
+
+// AllDenomMetadataQuery returns the metadata of all denoms
+type AllDenomMetadataQuery struct {
+	Pagination *PageRequest `json:"pagination,omitempty"`
+}
+
+// AllDenomMetadataResponse is the response to AllDenomMetadataQuery
+type AllDenomMetadataResponse struct {
+	Metadata []DenomMetadata `json:"metadata"`
+	// NextKey is the key to query the next page. Empty when there are no more results.
+	NextKey []byte `json:"next_key,omitempty"`
+}
+
+// PageRequest selects a page of the results
+type PageRequest struct {
+	// Key is the next_key of the previous page. Empty for the first page.
+	Key []byte `json:"key,omitempty"`
+	// Limit is the max number of results, at most 100. Zero means the default limit of 100.
+	Limit   uint32 `json:"limit"`
+	Reverse bool   `json:"reverse"`
+}
+
+// DenomMetadata is the metadata of a denom like the bank Metadata
+type DenomMetadata struct {
+	Description string      `json:"description"`
+	DenomUnits  []DenomUnit `json:"denom_units"`
+	Base        string      `json:"base"`
+	Display     string      `json:"display"`
+	Name        string      `json:"name"`
+	Symbol      string      `json:"symbol"`
+}
+
+// DenomUnit is a representation of the denom with the exponent to the base denom
+type DenomUnit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases"`
+}
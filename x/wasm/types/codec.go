@@ -7,6 +7,7 @@ import (
 	cryptocodec "github.com/Finschia/finschia-sdk/crypto/codec"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/msgservice"
+	"github.com/Finschia/finschia-sdk/x/authz"
 	authzcodec "github.com/Finschia/finschia-sdk/x/authz/codec"
 	govcodec "github.com/Finschia/finschia-sdk/x/gov/codec"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
@@ -21,8 +22,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint:staticcheck
 	legacy.RegisterAminoMsg(cdc, &MsgMigrateContract{}, "wasm/MsgMigrateContract")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin")
 	legacy.RegisterAminoMsg(cdc, &MsgClearAdmin{}, "wasm/MsgClearAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateInstantiateConfig{}, "wasm/MsgUpdateInstantiateConfig")
 	legacy.RegisterAminoMsg(cdc, &MsgIBCSend{}, "wasm/MsgIBCSend")
 	legacy.RegisterAminoMsg(cdc, &MsgIBCCloseChannel{}, "wasm/MsgIBCCloseChannel")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "wasm/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSudoContract{}, "wasm/MsgSudoContract")
+	legacy.RegisterAminoMsg(cdc, &MsgPinCodes{}, "wasm/MsgPinCodes")
+	legacy.RegisterAminoMsg(cdc, &MsgUnpinCodes{}, "wasm/MsgUnpinCodes")
+	legacy.RegisterAminoMsg(cdc, &MsgStoreAndInstantiateContract{}, "wasm/MsgStoreAndInstantiateContract")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveCode{}, "wasm/MsgRemoveCode")
 
 	cdc.RegisterConcrete(&PinCodesProposal{}, "wasm/PinCodesProposal", nil)
 	cdc.RegisterConcrete(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal", nil)
@@ -34,6 +42,22 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint:staticcheck
 	cdc.RegisterConcrete(&UpdateAdminProposal{}, "wasm/UpdateAdminProposal", nil)
 	cdc.RegisterConcrete(&ClearAdminProposal{}, "wasm/ClearAdminProposal", nil)
 	cdc.RegisterConcrete(&UpdateInstantiateConfigProposal{}, "wasm/UpdateInstantiateConfigProposal", nil)
+	cdc.RegisterConcrete(&SetContractStorageQuotaProposal{}, "wasm/SetContractStorageQuotaProposal", nil)
+	cdc.RegisterConcrete(&StoreAndInstantiateContractProposal{}, "wasm/StoreAndInstantiateContractProposal", nil)
+	cdc.RegisterConcrete(&UpdateParamsProposal{}, "wasm/UpdateParamsProposal", nil)
+
+	cdc.RegisterInterface((*ContractAuthzFilterX)(nil), nil)
+	cdc.RegisterConcrete(&AllowAllMessagesFilter{}, "wasm/AllowAllMessagesFilter", nil)
+	cdc.RegisterConcrete(&AcceptedMessageKeysFilter{}, "wasm/AcceptedMessageKeysFilter", nil)
+	cdc.RegisterConcrete(&AcceptedMessagesFilter{}, "wasm/AcceptedMessagesFilter", nil)
+
+	cdc.RegisterInterface((*ContractAuthzLimitX)(nil), nil)
+	cdc.RegisterConcrete(&MaxCallsLimit{}, "wasm/MaxCallsLimit", nil)
+	cdc.RegisterConcrete(&MaxFundsLimit{}, "wasm/MaxFundsLimit", nil)
+	cdc.RegisterConcrete(&CombinedLimit{}, "wasm/CombinedLimit", nil)
+
+	cdc.RegisterConcrete(&ContractExecutionAuthorization{}, "wasm/ContractExecutionAuthorization", nil)
+	cdc.RegisterConcrete(&ContractMigrationAuthorization{}, "wasm/ContractMigrationAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -46,8 +70,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgMigrateContract{},
 		&MsgUpdateAdmin{},
 		&MsgClearAdmin{},
+		&MsgUpdateInstantiateConfig{},
 		&MsgIBCCloseChannel{},
 		&MsgIBCSend{},
+		&MsgUpdateParams{},
+		&MsgSudoContract{},
+		&MsgPinCodes{},
+		&MsgUnpinCodes{},
+		&MsgStoreAndInstantiateContract{},
+		&MsgRemoveCode{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
@@ -61,10 +92,37 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&PinCodesProposal{},
 		&UnpinCodesProposal{},
 		&UpdateInstantiateConfigProposal{},
+		&SetContractStorageQuotaProposal{},
+		&StoreAndInstantiateContractProposal{},
+		&UpdateParamsProposal{},
 	)
 
 	registry.RegisterInterface("ContractInfoExtension", (*ContractInfoExtension)(nil))
 
+	registry.RegisterInterface("StargateQueryResponse", (*StargateQueryResponse)(nil))
+
+	registry.RegisterInterface("ContractAuthzFilterX", (*ContractAuthzFilterX)(nil))
+	registry.RegisterImplementations(
+		(*ContractAuthzFilterX)(nil),
+		&AllowAllMessagesFilter{},
+		&AcceptedMessageKeysFilter{},
+		&AcceptedMessagesFilter{},
+	)
+
+	registry.RegisterInterface("ContractAuthzLimitX", (*ContractAuthzLimitX)(nil))
+	registry.RegisterImplementations(
+		(*ContractAuthzLimitX)(nil),
+		&MaxCallsLimit{},
+		&MaxFundsLimit{},
+		&CombinedLimit{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&ContractExecutionAuthorization{},
+		&ContractMigrationAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -88,6 +88,12 @@ var (
 
 	// ErrExceedMaxQueryStackSize error if max query stack size is exceeded
 	ErrExceedMaxQueryStackSize = sdkErrors.Register(DefaultCodespace, 27, "max query stack size exceeded")
+
+	// ErrStorageQuotaExceeded error if a contract writes more state than its storage quota allows
+	ErrStorageQuotaExceeded = sdkErrors.Register(DefaultCodespace, 28, "storage quota exceeded")
+
+	// ErrStargateMsgNotAllowed error if a stargate message dispatched by a contract is rejected by the filter
+	ErrStargateMsgNotAllowed = sdkErrors.Register(DefaultCodespace, 29, "stargate message not allowed")
 )
 
 type ErrNoSuchContract struct {
@@ -17,6 +17,7 @@ const (
 	EventTypeGovContractResult      = "gov_contract_result"
 	EventTypeUpdateContractAdmin    = "update_contract_admin"
 	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
+	EventTypeRemoveCode             = "remove_code"
 )
 
 // event attributes returned from contract execution
@@ -13,6 +13,7 @@ type ViewKeeper interface {
 	QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
 	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *ContractInfo
+	GetContractStorageUsage(ctx sdk.Context, contractAddress sdk.AccAddress) ContractStorageUsage
 	IterateContractInfo(ctx sdk.Context, cb func(sdk.AccAddress, ContractInfo) bool)
 	IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
 	IterateContractState(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
@@ -21,6 +22,14 @@ type ViewKeeper interface {
 	GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
 	GetParams(ctx sdk.Context) Params
+	GetStargateQueryAcceptList(ctx sdk.Context) []AcceptedStargateQuery
+	GetStargateMsgFilter(ctx sdk.Context) StargateMsgFilter
+}
+
+// SimulationKeeper runs contract code on a cache context that is never committed
+type SimulationKeeper interface {
+	// SimulateExecute executes a contract without committing any state changes
+	SimulateExecute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, trace bool) (*QuerySimulateExecuteContractResponse, error)
 }
 
 // ContractOpsKeeper contains mutable operations on a contract.
@@ -28,6 +37,10 @@ type ContractOpsKeeper interface {
 	// Create uploads and compiles a WASM contract, returning a short identifier for the contract
 	Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *AccessConfig) (codeID uint64, checksum []byte, err error)
 
+	// CreateWithVerificationInfo uploads and compiles a WASM contract like Create. The optional source and builder
+	// are stored with the code info. A code hash, when set, must match the checksum of the stored code.
+	CreateWithVerificationInfo(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *AccessConfig, source, builder string, codeHash []byte) (codeID uint64, checksum []byte, err error)
+
 	// Instantiate creates an instance of a WASM contract using the classic sequence based address generator
 	Instantiate(
 		ctx sdk.Context,
@@ -76,6 +89,22 @@ type ContractOpsKeeper interface {
 
 	// SetAccessConfig updates the access config of a code id.
 	SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig AccessConfig) error
+
+	// RemoveCode deletes a code id that is neither pinned nor used by any contract.
+	RemoveCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress) error
+
+	// WithGovAuthorizationPolicy returns a ContractOpsKeeper that checks all operations against the gov authorization
+	// policy. It must only be used by handlers that already verified the module authority.
+	WithGovAuthorizationPolicy() ContractOpsKeeper
+
+	// SetContractStorageQuota sets the maximum state size of a contract that takes precedence over the params.
+	SetContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota StorageQuota) error
+
+	// SetParams validates and sets all wasm parameters.
+	SetParams(ctx sdk.Context, ps Params) error
+
+	// GetAuthority returns the address that is allowed to execute privileged operations.
+	GetAuthority() string
 }
 
 // IBCContractKeeper IBC lifecycle event handler
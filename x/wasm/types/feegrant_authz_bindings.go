@@ -0,0 +1,99 @@
+package types
+
+import (
+	wasmvmtypes "github.com/Finschia/wasmvm/types"
+)
+
+// FeegrantAuthzMsg is the custom message of a contract for x/feegrant and x/authz.
+// The contract is always the granter of a grant or revocation and the grantee of an exec.
+// See schema/feegrant_authz_msg.json for the JSON schema.
+type FeegrantAuthzMsg struct {
+	Feegrant *FeegrantMsg `json:"feegrant,omitempty"`
+	Authz    *AuthzMsg    `json:"authz,omitempty"`
+}
+
+// FeegrantMsg are the x/feegrant messages. Exactly one must be set.
+type FeegrantMsg struct {
+	GrantAllowance  *GrantAllowanceMsg  `json:"grant_allowance,omitempty"`
+	RevokeAllowance *RevokeAllowanceMsg `json:"revoke_allowance,omitempty"`
+}
+
+// GrantAllowanceMsg grants a fee allowance to the grantee
+type GrantAllowanceMsg struct {
+	Grantee string `json:"grantee"`
+	// SpendLimit is the max amount of fees that can be spent. Empty means no limit.
+	SpendLimit wasmvmtypes.Coins `json:"spend_limit,omitempty"`
+	// Expiration is the time in nanoseconds since epoch when the allowance expires. Zero means no expiration.
+	Expiration uint64 `json:"expiration,string,omitempty"`
+	// AllowedMessages restricts the allowance to these message type urls when not empty
+	AllowedMessages []string `json:"allowed_messages,omitempty"`
+}
+
+// RevokeAllowanceMsg revokes the fee allowance of the grantee
+type RevokeAllowanceMsg struct {
+	Grantee string `json:"grantee"`
+}
+
+// AuthzMsg are the x/authz messages. Exactly one must be set.
+type AuthzMsg struct {
+	Grant  *AuthzGrantMsg  `json:"grant,omitempty"`
+	Revoke *AuthzRevokeMsg `json:"revoke,omitempty"`
+	Exec   *AuthzExecMsg   `json:"exec,omitempty"`
+}
+
+// AuthzGrantMsg grants the grantee a generic authorization for the message type url
+type AuthzGrantMsg struct {
+	Grantee    string `json:"grantee"`
+	MsgTypeURL string `json:"msg_type_url"`
+	// Expiration is the time in nanoseconds since epoch when the grant expires
+	Expiration uint64 `json:"expiration,string"`
+}
+
+// AuthzRevokeMsg revokes the authorization of the grantee for the message type url
+type AuthzRevokeMsg struct {
+	Grantee    string `json:"grantee"`
+	MsgTypeURL string `json:"msg_type_url"`
+}
+
+// AuthzExecMsg executes the proto encoded messages on behalf of their granters
+type AuthzExecMsg struct {
+	Msgs []wasmvmtypes.StargateMsg `json:"msgs"`
+}
+
+// FeegrantAuthzQuery is the custom query of a contract for x/feegrant and x/authz.
+// The responses are the json encoded responses of the gRPC queries. The queries are public and not restricted
+// to the grants of the contract.
+// See schema/feegrant_authz_query.json for the JSON schema.
+type FeegrantAuthzQuery struct {
+	Feegrant *FeegrantQuery `json:"feegrant,omitempty"`
+	Authz    *AuthzQuery    `json:"authz,omitempty"`
+}
+
+// FeegrantQuery are the x/feegrant queries. Exactly one must be set.
+type FeegrantQuery struct {
+	Allowance  *AllowanceQuery  `json:"allowance,omitempty"`
+	Allowances *AllowancesQuery `json:"allowances,omitempty"`
+}
+
+// AllowanceQuery returns the fee allowance of the granter for the grantee
+type AllowanceQuery struct {
+	Granter string `json:"granter"`
+	Grantee string `json:"grantee"`
+}
+
+// AllowancesQuery returns all fee allowances of the grantee
+type AllowancesQuery struct {
+	Grantee string `json:"grantee"`
+}
+
+// AuthzQuery are the x/authz queries. Exactly one must be set.
+type AuthzQuery struct {
+	Grants *AuthzGrantsQuery `json:"grants,omitempty"`
+}
+
+// AuthzGrantsQuery returns the grants of the granter for the grantee, optionally for the message type url only
+type AuthzGrantsQuery struct {
+	Granter    string `json:"granter"`
+	Grantee    string `json:"grantee"`
+	MsgTypeURL string `json:"msg_type_url,omitempty"`
+}
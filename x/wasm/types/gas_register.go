@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+const (
+	// DefaultGasMultiplier is how many CosmWasm gas points = 1 Cosmos SDK gas point.
+	//
+	// CosmWasm gas strategy is documented in https://github.com/CosmWasm/cosmwasm/blob/v1.0.0-beta/docs/GAS.md.
+	// Cosmos SDK reference costs can be found here: https://github.com/cosmos/cosmos-sdk/blob/v0.42.10/store/types/gas.go#L198-L209.
+	//
+	// The original multiplier of 100 up to CosmWasm 0.16 was based on
+	//     "A write at ~3000 gas and ~200us = 10 gas per us (microsecond) cpu/io
+	//     Rough timing have 88k gas at 90us, which is equal to 1k sdk gas... (one read)"
+	// as well as manual Wasmer benchmarks from 2019. This was then multiplied by 150_000
+	// in the 0.16 -> 1.0 upgrade (https://github.com/CosmWasm/cosmwasm/pull/1120).
+	//
+	// The multiplier deserves more reproducible benchmarking and a strategy that allows easy adjustments.
+	// This is tracked in https://github.com/CosmWasm/wasmd/issues/566 and https://github.com/CosmWasm/wasmd/issues/631.
+	// Gas adjustments are consensus breaking but may happen in any release marked as consensus breaking.
+	// Do not make assumptions on how much gas an operation will consume in places that are hard to adjust,
+	// such as hardcoding them in contracts.
+	//
+	// Please note that all gas prices returned to wasmvm should have this multiplied.
+	// Benchmarks and numbers were discussed in: https://github.com/CosmWasm/wasmd/pull/634#issuecomment-938055852
+	DefaultGasMultiplier uint64 = 140_000_000
+	// DefaultInstanceCost is how much SDK gas we charge each time we load a WASM instance.
+	// Creating a new instance is costly, and this helps put a recursion limit to contracts calling contracts.
+	// Benchmarks and numbers were discussed in: https://github.com/CosmWasm/wasmd/pull/634#issuecomment-938056803
+	DefaultInstanceCost uint64 = 60_000
+	// DefaultCompileCost is how much SDK gas is charged *per byte* for compiling WASM code.
+	// Benchmarks and numbers were discussed in: https://github.com/CosmWasm/wasmd/pull/634#issuecomment-938056803
+	DefaultCompileCost uint64 = 3
+	// DefaultEventAttributeDataCost is how much SDK gas is charged *per byte* for attribute data in events.
+	// This is used with len(key) + len(value)
+	DefaultEventAttributeDataCost uint64 = 1
+	// DefaultContractMessageDataCost is how much SDK gas is charged *per byte* of the message that goes to the contract
+	// This is used with len(msg). Note that the message is deserialized in the receiving contract and this is charged
+	// with wasm gas already. The derserialization of results is also charged in wasmvm. I am unsure if we need to add
+	// additional costs here.
+	// Note: also used for error fields on reply, and data on reply. Maybe these should be pulled out to a different (non-zero) field
+	DefaultContractMessageDataCost uint64 = 0
+	// DefaultPerAttributeCost is how much SDK gas we charge per attribute count.
+	DefaultPerAttributeCost uint64 = 10
+	// DefaultPerCustomEventCost is how much SDK gas we charge per event count.
+	DefaultPerCustomEventCost uint64 = 20
+	// DefaultEventAttributeDataFreeTier number of bytes of total attribute data we do not charge.
+	DefaultEventAttributeDataFreeTier = 100
+)
+
+// DefaultPerByteUncompressCost is how much SDK gas we charge per source byte to unpack. default: 0.15 gas.
+// see https://github.com/CosmWasm/wasmd/pull/898#discussion_r937727200
+const (
+	DefaultPerByteUncompressCostNumerator   uint64 = 15
+	DefaultPerByteUncompressCostDenominator uint64 = 100
+)
+
+// DefaultGasRegisterParams returns the default costs of the wasm gas register
+func DefaultGasRegisterParams() GasRegisterParams {
+	return GasRegisterParams{
+		InstanceCost:               DefaultInstanceCost,
+		CompileCost:                DefaultCompileCost,
+		UncompressCostNumerator:    DefaultPerByteUncompressCostNumerator,
+		UncompressCostDenominator:  DefaultPerByteUncompressCostDenominator,
+		GasMultiplier:              DefaultGasMultiplier,
+		EventPerAttributeCost:      DefaultPerAttributeCost,
+		EventAttributeDataCost:     DefaultEventAttributeDataCost,
+		EventAttributeDataFreeTier: DefaultEventAttributeDataFreeTier,
+		ContractMessageDataCost:    DefaultContractMessageDataCost,
+		CustomEventCost:            DefaultPerCustomEventCost,
+	}
+}
+
+// ValidateBasic performs basic validation on the gas register params
+func (p GasRegisterParams) ValidateBasic() error {
+	if p.GasMultiplier == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "gas multiplier can not be 0")
+	}
+	if p.UncompressCostDenominator == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "uncompress cost denominator can not be 0")
+	}
+	return nil
+}
+
+func validateGasRegisterParams(i interface{}) error {
+	p, ok := i.(GasRegisterParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return p.ValidateBasic()
+}
@@ -296,6 +296,8 @@ type Contract struct {
 	ContractAddress string       `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	ContractInfo    ContractInfo `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState   []Model      `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
+	// StorageQuota is the maximum state size set by governance, if any
+	StorageQuota *StorageQuota `protobuf:"bytes,4,opt,name=storage_quota,json=storageQuota,proto3" json:"storage_quota,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -357,6 +359,13 @@ func (m *Contract) GetContractState() []Model {
 	return nil
 }
 
+func (m *Contract) GetStorageQuota() *StorageQuota {
+	if m != nil {
+		return m.StorageQuota
+	}
+	return nil
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -426,48 +435,49 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xe3, 0x26, 0x4e, 0x93, 0x69, 0x7e, 0xbf, 0x56, 0xdb, 0xaa, 0x35, 0x06, 0x9c, 0x28,
-	0xa0, 0x2a, 0x48, 0x28, 0x51, 0x8b, 0xc4, 0x0d, 0x01, 0xa6, 0x85, 0x46, 0x55, 0x25, 0x70, 0xc5,
-	0x05, 0xa9, 0x8a, 0x5c, 0x7b, 0xeb, 0xae, 0xa8, 0xbd, 0x21, 0xbb, 0x29, 0xcd, 0x99, 0x17, 0xe0,
-	0x11, 0xe0, 0x65, 0x50, 0x8f, 0x3d, 0x72, 0x8a, 0x50, 0x7a, 0xe3, 0x29, 0xd0, 0xfe, 0xb1, 0x6b,
-	0x70, 0x7a, 0xb1, 0xb2, 0x33, 0xdf, 0xf9, 0xcc, 0x9f, 0xcc, 0x2e, 0x38, 0x01, 0x65, 0xf1, 0x67,
-	0x9f, 0xc5, 0x3d, 0xf9, 0x39, 0xdf, 0xea, 0x45, 0x38, 0xc1, 0x8c, 0xb0, 0xee, 0x70, 0x44, 0x39,
-	0x45, 0x2b, 0xa9, 0xbf, 0x2b, 0x3f, 0xe7, 0x5b, 0xf6, 0x5a, 0x44, 0x23, 0x2a, 0x9d, 0x3d, 0xf1,
-	0x4b, 0xe9, 0xec, 0x7b, 0x05, 0x0e, 0x9f, 0x0c, 0xb1, 0xa6, 0xd8, 0x77, 0x8a, 0xde, 0x0b, 0xe5,
-	0x6a, 0x7f, 0x33, 0xa1, 0xf1, 0x46, 0xa5, 0x3c, 0xe4, 0x3e, 0xc7, 0xe8, 0x29, 0x54, 0x87, 0xfe,
-	0xc8, 0x8f, 0x99, 0x65, 0xb4, 0x8c, 0xce, 0xd2, 0xb6, 0xd5, 0xfd, 0xb7, 0x84, 0xee, 0x5b, 0xe9,
-	0x77, 0x2b, 0x97, 0xd3, 0x66, 0xc9, 0xd3, 0x6a, 0xb4, 0x0b, 0x66, 0x40, 0x43, 0xcc, 0xac, 0x85,
-	0x56, 0xb9, 0xb3, 0xb4, 0xbd, 0x5e, 0x0c, 0x7b, 0x45, 0x43, 0xec, 0x6e, 0x88, 0xa0, 0xdf, 0xd3,
-	0xe6, 0xb2, 0x14, 0x3f, 0xa6, 0x31, 0xe1, 0x38, 0x1e, 0xf2, 0x89, 0xa7, 0xa2, 0xd1, 0x7b, 0xa8,
-	0x07, 0x34, 0xe1, 0x23, 0x3f, 0xe0, 0xcc, 0x2a, 0x4b, 0x94, 0x3d, 0x0f, 0xa5, 0x24, 0xee, 0x5d,
-	0x8d, 0x5b, 0xcd, 0x82, 0x72, 0xc8, 0x1b, 0x92, 0xc0, 0x32, 0xfc, 0x69, 0x8c, 0x93, 0x00, 0x33,
-	0xab, 0x72, 0x1b, 0xf6, 0x50, 0x4b, 0x6e, 0xb0, 0x59, 0x50, 0x1e, 0x9b, 0x19, 0xd1, 0x11, 0xd4,
-	0x22, 0x9c, 0x0c, 0x62, 0x16, 0x31, 0xcb, 0x94, 0xd4, 0xcd, 0x22, 0x35, 0x3f, 0x5e, 0x71, 0x38,
-	0x60, 0x11, 0x73, 0x6d, 0x9d, 0x01, 0xa5, 0xf1, 0xb9, 0x04, 0x8b, 0x91, 0x12, 0xd9, 0x5f, 0x16,
-	0x60, 0x51, 0x07, 0xa0, 0xe7, 0x00, 0x8c, 0xd3, 0x11, 0x1e, 0x88, 0x39, 0xe9, 0xff, 0xc6, 0x29,
-	0x26, 0x3b, 0x60, 0xd1, 0xa1, 0x90, 0x89, 0x61, 0xef, 0x95, 0xbc, 0x3a, 0x4b, 0x0f, 0xe8, 0x08,
-	0xd6, 0x48, 0xc2, 0xb8, 0x9f, 0x70, 0xe2, 0x73, 0x81, 0x51, 0xb3, 0xb1, 0x16, 0x24, 0xaa, 0x33,
-	0x17, 0xd5, 0xbf, 0x09, 0x48, 0x47, 0xbe, 0x57, 0xf2, 0x56, 0x49, 0xd1, 0x8c, 0xde, 0xc1, 0x0a,
-	0xbe, 0xc0, 0xc1, 0x38, 0x8f, 0x2e, 0x4b, 0xf4, 0xc3, 0xb9, 0xe8, 0x5d, 0x25, 0xce, 0x61, 0x97,
-	0xf1, 0xdf, 0x26, 0xd7, 0x84, 0x32, 0x1b, 0xc7, 0xed, 0xef, 0x06, 0x54, 0x64, 0x07, 0x0f, 0x60,
-	0x51, 0x34, 0x3f, 0x20, 0xa1, 0xec, 0xbf, 0xe2, 0xc2, 0x6c, 0xda, 0xac, 0x0a, 0x57, 0x7f, 0xc7,
-	0xab, 0x0a, 0x57, 0x3f, 0x44, 0xcf, 0xc4, 0x02, 0x09, 0x51, 0x72, 0x42, 0x75, 0x6f, 0xf6, 0xfc,
-	0x5d, 0xec, 0x27, 0x27, 0x54, 0x2f, 0x71, 0x2d, 0xd0, 0x67, 0x74, 0x1f, 0x40, 0x86, 0x1f, 0x4f,
-	0x38, 0x66, 0xb2, 0x81, 0x86, 0x27, 0x81, 0xae, 0x30, 0xa0, 0x75, 0xa8, 0x0e, 0x49, 0x92, 0xe0,
-	0xd0, 0xaa, 0xb4, 0x8c, 0x4e, 0xcd, 0xd3, 0xa7, 0xf6, 0x0f, 0x03, 0x6a, 0xd9, 0x28, 0x1e, 0xc1,
-	0x4a, 0x3a, 0x82, 0x81, 0x1f, 0x86, 0x23, 0xcc, 0xd4, 0x65, 0xaa, 0x7b, 0xcb, 0xa9, 0xfd, 0xa5,
-	0x32, 0xa3, 0x3e, 0xfc, 0x97, 0x49, 0x73, 0x15, 0x3b, 0xb7, 0xaf, 0x7c, 0xae, 0xea, 0x46, 0x90,
-	0xb3, 0xa1, 0x1d, 0xf8, 0x3f, 0x43, 0x31, 0xb1, 0x6b, 0xfa, 0xfa, 0x6c, 0xcc, 0x19, 0x3f, 0x0d,
-	0xf1, 0x99, 0x86, 0x64, 0xf9, 0xe5, 0x7e, 0xb6, 0x5d, 0xa8, 0xa5, 0xb7, 0x00, 0xb5, 0xa0, 0x4a,
-	0xc2, 0xc1, 0x47, 0x3c, 0x91, 0xd5, 0x37, 0xdc, 0xfa, 0x6c, 0xda, 0x34, 0xfb, 0x3b, 0xfb, 0x78,
-	0xe2, 0x99, 0x24, 0xdc, 0xc7, 0x13, 0xb4, 0x06, 0xe6, 0xb9, 0x7f, 0x36, 0xc6, 0xb2, 0xec, 0x8a,
-	0xa7, 0x0e, 0xee, 0x8b, 0xcb, 0x99, 0x63, 0x5c, 0xcd, 0x1c, 0xe3, 0xd7, 0xcc, 0x31, 0xbe, 0x5e,
-	0x3b, 0xa5, 0xab, 0x6b, 0xa7, 0xf4, 0xf3, 0xda, 0x29, 0x7d, 0xd8, 0x8c, 0x08, 0x3f, 0x1d, 0x1f,
-	0x77, 0x03, 0x1a, 0xf7, 0x5e, 0x93, 0x84, 0x05, 0xa7, 0xc4, 0x97, 0x6f, 0x52, 0xd8, 0xbb, 0x50,
-	0x6f, 0x93, 0x7c, 0xb6, 0x8e, 0xab, 0xf2, 0x71, 0x7a, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0x3b,
-	0x92, 0x82, 0x9e, 0x1f, 0x05, 0x00, 0x00,
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4d, 0x4f, 0xdb, 0x4c,
+	0x10, 0xc7, 0xf3, 0x62, 0x87, 0x64, 0x08, 0x0f, 0x68, 0x41, 0xe0, 0xc7, 0x6d, 0x9d, 0x28, 0xad,
+	0x50, 0x2a, 0x55, 0x89, 0xa0, 0x52, 0x6f, 0x55, 0x5b, 0x03, 0x2d, 0x11, 0x42, 0x2a, 0x8e, 0x7a,
+	0xa9, 0x84, 0x2c, 0x63, 0x2f, 0xc6, 0x2a, 0xf6, 0x86, 0xec, 0x86, 0x92, 0x73, 0x8f, 0xbd, 0xf4,
+	0x23, 0xb4, 0xdf, 0x86, 0x23, 0xc7, 0x9e, 0xa2, 0x2a, 0xdc, 0xfa, 0x29, 0xaa, 0x7d, 0xb1, 0x71,
+	0x9b, 0x70, 0x31, 0xcc, 0xcc, 0x7f, 0x7e, 0xb3, 0x33, 0x99, 0x5d, 0xb0, 0x7c, 0x42, 0xe3, 0xcf,
+	0x1e, 0x8d, 0xbb, 0xe2, 0x73, 0xb9, 0xd5, 0x0d, 0x71, 0x82, 0x69, 0x44, 0x3b, 0x83, 0x21, 0x61,
+	0x04, 0xad, 0xa4, 0xf1, 0x8e, 0xf8, 0x5c, 0x6e, 0x99, 0x6b, 0x21, 0x09, 0x89, 0x08, 0x76, 0xf9,
+	0x7f, 0x52, 0x67, 0x3e, 0x9c, 0xe1, 0xb0, 0xf1, 0x00, 0x2b, 0x8a, 0xf9, 0xff, 0x6c, 0xf4, 0x4a,
+	0x86, 0x5a, 0xdf, 0x75, 0xa8, 0xbf, 0x93, 0x25, 0xfb, 0xcc, 0x63, 0x18, 0xbd, 0x80, 0xca, 0xc0,
+	0x1b, 0x7a, 0x31, 0x35, 0x8a, 0xcd, 0x62, 0x7b, 0x71, 0xdb, 0xe8, 0xfc, 0x7b, 0x84, 0xce, 0x7b,
+	0x11, 0xb7, 0xb5, 0xeb, 0x49, 0xa3, 0xe0, 0x28, 0x35, 0xda, 0x03, 0xdd, 0x27, 0x01, 0xa6, 0x46,
+	0xa9, 0x59, 0x6e, 0x2f, 0x6e, 0xaf, 0xcf, 0xa6, 0xed, 0x90, 0x00, 0xdb, 0x1b, 0x3c, 0xe9, 0xf7,
+	0xa4, 0xb1, 0x2c, 0xc4, 0xcf, 0x48, 0x1c, 0x31, 0x1c, 0x0f, 0xd8, 0xd8, 0x91, 0xd9, 0xe8, 0x03,
+	0xd4, 0x7c, 0x92, 0xb0, 0xa1, 0xe7, 0x33, 0x6a, 0x94, 0x05, 0xca, 0x9c, 0x87, 0x92, 0x12, 0xfb,
+	0x81, 0xc2, 0xad, 0x66, 0x49, 0x39, 0xe4, 0x1d, 0x89, 0x63, 0x29, 0xbe, 0x18, 0xe1, 0xc4, 0xc7,
+	0xd4, 0xd0, 0xee, 0xc3, 0xf6, 0x95, 0xe4, 0x0e, 0x9b, 0x25, 0xe5, 0xb1, 0x99, 0x13, 0x1d, 0x43,
+	0x35, 0xc4, 0x89, 0x1b, 0xd3, 0x90, 0x1a, 0xba, 0xa0, 0x6e, 0xce, 0x52, 0xf3, 0xe3, 0xe5, 0xc6,
+	0x21, 0x0d, 0xa9, 0x6d, 0xaa, 0x0a, 0x28, 0xcd, 0xcf, 0x15, 0x58, 0x08, 0xa5, 0xc8, 0xfc, 0x52,
+	0x82, 0x05, 0x95, 0x80, 0x5e, 0x01, 0x50, 0x46, 0x86, 0xd8, 0xe5, 0x73, 0x52, 0xbf, 0x8d, 0x35,
+	0x5b, 0xec, 0x90, 0x86, 0x7d, 0x2e, 0xe3, 0xc3, 0xde, 0x2f, 0x38, 0x35, 0x9a, 0x1a, 0xe8, 0x18,
+	0xd6, 0xa2, 0x84, 0x32, 0x2f, 0x61, 0x91, 0xc7, 0xb0, 0x9b, 0xce, 0xc6, 0x28, 0x09, 0x54, 0x7b,
+	0x2e, 0xaa, 0x77, 0x97, 0x90, 0x8e, 0x7c, 0xbf, 0xe0, 0xac, 0x46, 0xb3, 0x6e, 0x74, 0x04, 0x2b,
+	0xf8, 0x0a, 0xfb, 0xa3, 0x3c, 0xba, 0x2c, 0xd0, 0x4f, 0xe6, 0xa2, 0xf7, 0xa4, 0x38, 0x87, 0x5d,
+	0xc6, 0x7f, 0xbb, 0x6c, 0x1d, 0xca, 0x74, 0x14, 0xb7, 0x7e, 0x14, 0x41, 0x13, 0x1d, 0x3c, 0x86,
+	0x05, 0xde, 0xbc, 0x1b, 0x05, 0xa2, 0x7f, 0xcd, 0x86, 0xe9, 0xa4, 0x51, 0xe1, 0xa1, 0xde, 0xae,
+	0x53, 0xe1, 0xa1, 0x5e, 0x80, 0x5e, 0x42, 0x4d, 0x8a, 0x92, 0x53, 0xa2, 0x7a, 0x33, 0xe7, 0xef,
+	0x62, 0x2f, 0x39, 0x25, 0x6a, 0x89, 0xab, 0xbe, 0xb2, 0xd1, 0x23, 0x00, 0x91, 0x7e, 0x32, 0x66,
+	0x98, 0x8a, 0x06, 0xea, 0x8e, 0x00, 0xda, 0xdc, 0x81, 0xd6, 0xa1, 0x32, 0x88, 0x92, 0x04, 0x07,
+	0x86, 0xd6, 0x2c, 0xb6, 0xab, 0x8e, 0xb2, 0x5a, 0x5f, 0x4b, 0x50, 0xcd, 0x46, 0xf1, 0x14, 0x56,
+	0xd2, 0x11, 0xb8, 0x5e, 0x10, 0x0c, 0x31, 0x95, 0x97, 0xa9, 0xe6, 0x2c, 0xa7, 0xfe, 0x37, 0xd2,
+	0x8d, 0x7a, 0xb0, 0x94, 0x49, 0x73, 0x27, 0xb6, 0xee, 0x5f, 0xf9, 0xdc, 0xa9, 0xeb, 0x7e, 0xce,
+	0x87, 0x76, 0xe1, 0xbf, 0x0c, 0x45, 0xf9, 0xae, 0xa9, 0xeb, 0xb3, 0x31, 0x67, 0xfc, 0x24, 0xc0,
+	0xe7, 0x0a, 0x92, 0xd5, 0x97, 0xd7, 0x7f, 0x07, 0x96, 0xf8, 0xca, 0x78, 0x21, 0x76, 0x2f, 0x46,
+	0x84, 0x79, 0x86, 0x76, 0xdf, 0x81, 0xfa, 0x52, 0x76, 0xc4, 0x55, 0x4e, 0x9d, 0xe6, 0xac, 0x96,
+	0x0d, 0xd5, 0xf4, 0x2a, 0xa1, 0x26, 0x54, 0xa2, 0xc0, 0xfd, 0x84, 0xc7, 0x62, 0x04, 0x75, 0xbb,
+	0x36, 0x9d, 0x34, 0xf4, 0xde, 0xee, 0x01, 0x1e, 0x3b, 0x7a, 0x14, 0x1c, 0xe0, 0x31, 0x5a, 0x03,
+	0xfd, 0xd2, 0x3b, 0x1f, 0x61, 0xd1, 0xbb, 0xe6, 0x48, 0xc3, 0x7e, 0x7d, 0x3d, 0xb5, 0x8a, 0x37,
+	0x53, 0xab, 0xf8, 0x6b, 0x6a, 0x15, 0xbf, 0xdd, 0x5a, 0x85, 0x9b, 0x5b, 0xab, 0xf0, 0xf3, 0xd6,
+	0x2a, 0x7c, 0xdc, 0x0c, 0x23, 0x76, 0x36, 0x3a, 0xe9, 0xf8, 0x24, 0xee, 0xbe, 0x8d, 0x12, 0xea,
+	0x9f, 0x45, 0x9e, 0x78, 0xd8, 0x82, 0xee, 0x95, 0xf8, 0x2b, 0xdf, 0xbe, 0x93, 0x8a, 0x78, 0xe1,
+	0x9e, 0xff, 0x19, 0x00, 0xc0, 0x17, 0x54, 0xfb, 0x64, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -732,6 +742,18 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StorageQuota != nil {
+		{
+			size, err := m.StorageQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ContractState) > 0 {
 		for iNdEx := len(m.ContractState) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -938,6 +960,10 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.StorageQuota != nil {
+		l = m.StorageQuota.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1626,6 +1652,42 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageQuota == nil {
+				m.StorageQuota = &StorageQuota{}
+			}
+			if err := m.StorageQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,39 @@
+package types
+
+import (
+	wasmvmtypes "github.com/Finschia/wasmvm/types"
+)
+
+// GovCustomMsg is the custom message of a contract for the x/gov messages that are not part of the wasmvm GovMsg.
+// The contract is always the voter or depositor.
+// See schema/gov_custom_msg.json for the JSON schema.
+type GovCustomMsg struct {
+	Gov *ExtendedGovMsg `json:"gov,omitempty"`
+}
+
+// ExtendedGovMsg are the extended x/gov messages. Exactly one must be set.
+type ExtendedGovMsg struct {
+	VoteWeighted *VoteWeightedMsg `json:"vote_weighted,omitempty"`
+	Deposit      *DepositMsg      `json:"deposit,omitempty"`
+}
+
+// VoteWeightedMsg splits the voting power of the contract over multiple options
+type VoteWeightedMsg struct {
+	ProposalID uint64 `json:"proposal_id"`
+	// Options must sum up to a weight of 1
+	Options []WeightedVoteOption `json:"options"`
+}
+
+// WeightedVoteOption is a vote option with the share of the voting power
+type WeightedVoteOption struct {
+	// Option is one of "yes", "no", "abstain" or "no_with_veto" like in the wasmvm VoteMsg
+	Option string `json:"option"`
+	// Weight is a decimal string in the range (0, 1]
+	Weight string `json:"weight"`
+}
+
+// DepositMsg deposits the amount from the contract to the proposal
+type DepositMsg struct {
+	ProposalID uint64            `json:"proposal_id"`
+	Amount     wasmvmtypes.Coins `json:"amount"`
+}
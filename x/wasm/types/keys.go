@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/address"
 )
 
 const (
@@ -31,6 +32,11 @@ var (
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x06}
 	PinnedCodeIndexPrefix                          = []byte{0x07}
 	TXCounterPrefix                                = []byte{0x08}
+	ContractsByCreatorPrefix                       = []byte{0x09}
+	PendingCodeRemovalPrefix                       = []byte{0x0a}
+	CodeIDsByChecksumPrefix                        = []byte{0x0b}
+	ContractStorageUsagePrefix                     = []byte{0x0c}
+	ContractStorageQuotaPrefix                     = []byte{0x0d}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
@@ -42,6 +48,28 @@ func GetCodeKey(codeID uint64) []byte {
 	return append(CodeKeyPrefix, contractIDBz...)
 }
 
+// GetCodeIDsByChecksumPrefix returns the prefix for the checksum index: `<prefix><checksumLen><checksum>`
+func GetCodeIDsByChecksumPrefix(checksum []byte) []byte {
+	bz := address.MustLengthPrefix(checksum)
+	return append(CodeIDsByChecksumPrefix, bz...)
+}
+
+// GetCodeIDByChecksumSecondaryIndexKey returns the key for the checksum index: `<prefix><checksumLen><checksum><codeID>`
+func GetCodeIDByChecksumSecondaryIndexKey(checksum []byte, codeID uint64) []byte {
+	prefix := GetCodeIDsByChecksumPrefix(checksum)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+8)
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(codeID))
+	return r
+}
+
+// GetPendingCodeRemovalKey returns the key for a code checksum that is scheduled to be removed from the wasmvm:
+// `<prefix><checksum>`
+func GetPendingCodeRemovalKey(checksum []byte) []byte {
+	return append(PendingCodeRemovalPrefix, checksum...)
+}
+
 // GetContractAddressKey returns the key for the WASM contract instance
 func GetContractAddressKey(addr sdk.AccAddress) []byte {
 	return append(ContractKeyPrefix, addr...)
@@ -52,6 +80,16 @@ func GetContractStorePrefix(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
 }
 
+// GetContractStorageUsageKey returns the key for the storage usage counters of the WASM contract instance
+func GetContractStorageUsageKey(addr sdk.AccAddress) []byte {
+	return append(ContractStorageUsagePrefix, addr...)
+}
+
+// GetContractStorageQuotaKey returns the key for the storage quota of the WASM contract instance set by governance
+func GetContractStorageQuotaKey(addr sdk.AccAddress) []byte {
+	return append(ContractStorageQuotaPrefix, addr...)
+}
+
 // GetContractByCreatedSecondaryIndexKey returns the key for the secondary index:
 // `<prefix><codeID><created/last-migrated><contractAddr>`
 func GetContractByCreatedSecondaryIndexKey(contractAddr sdk.AccAddress, c ContractCodeHistoryEntry) []byte {
@@ -75,6 +113,24 @@ func GetContractByCodeIDSecondaryIndexPrefix(codeID uint64) []byte {
 	return r
 }
 
+// GetContractsByCreatorPrefix returns the prefix for the creator index: `<prefix><creatorAddrLen><creatorAddr>`
+func GetContractsByCreatorPrefix(creator sdk.AccAddress) []byte {
+	bz := address.MustLengthPrefix(creator)
+	return append(ContractsByCreatorPrefix, bz...)
+}
+
+// GetContractByCreatorSecondaryIndexKey returns the key for the creator index:
+// `<prefix><creatorAddrLen><creatorAddr><created><contractAddr>`
+func GetContractByCreatorSecondaryIndexKey(creator sdk.AccAddress, position []byte, contractAddr sdk.AccAddress) []byte {
+	prefix := GetContractsByCreatorPrefix(creator)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+AbsoluteTxPositionLen+len(contractAddr))
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], position)
+	copy(r[prefixLen+AbsoluteTxPositionLen:], contractAddr)
+	return r
+}
+
 // GetContractCodeHistoryElementKey returns the key a contract code history entry: `<prefix><contractAddr><position>`
 func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) []byte {
 	prefix := GetContractCodeHistoryElementPrefix(contractAddr)
@@ -14,8 +14,12 @@ import (
 )
 
 var (
-	ParamStoreKeyUploadAccess      = []byte("uploadAccess")
-	ParamStoreKeyInstantiateAccess = []byte("instantiateAccess")
+	ParamStoreKeyUploadAccess            = []byte("uploadAccess")
+	ParamStoreKeyInstantiateAccess       = []byte("instantiateAccess")
+	ParamStoreKeyGasRegister             = []byte("gasRegister")
+	ParamStoreKeyStorageQuota            = []byte("storageQuota")
+	ParamStoreKeyStargateQueryAcceptList = []byte("stargateQueryAcceptList")
+	ParamStoreKeyStargateMsgFilter       = []byte("stargateMsgFilter")
 )
 
 var AllAccessTypes = []AccessType{
@@ -109,6 +113,10 @@ func DefaultParams() Params {
 	return Params{
 		CodeUploadAccess:             AllowEverybody,
 		InstantiateDefaultPermission: AccessTypeEverybody,
+		GasRegister:                  DefaultGasRegisterParams(),
+		StorageQuota:                 DefaultStorageQuotaParams(),
+		StargateQueryAcceptList:      DefaultStargateQueryAcceptList(),
+		StargateMsgFilter:            DefaultStargateMsgFilter(),
 	}
 }
 
@@ -125,6 +133,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyUploadAccess, &p.CodeUploadAccess, validateAccessConfig),
 		paramtypes.NewParamSetPair(ParamStoreKeyInstantiateAccess, &p.InstantiateDefaultPermission, validateAccessType),
+		paramtypes.NewParamSetPair(ParamStoreKeyGasRegister, &p.GasRegister, validateGasRegisterParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageQuota, &p.StorageQuota, validateStorageQuotaParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyStargateQueryAcceptList, &p.StargateQueryAcceptList, validateStargateQueryAcceptList),
+		paramtypes.NewParamSetPair(ParamStoreKeyStargateMsgFilter, &p.StargateMsgFilter, validateStargateMsgFilter),
 	}
 }
 
@@ -136,6 +148,18 @@ func (p Params) ValidateBasic() error {
 	if err := validateAccessConfig(p.CodeUploadAccess); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if err := p.GasRegister.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "gas register")
+	}
+	if err := p.StorageQuota.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "storage quota")
+	}
+	if err := validateStargateQueryAcceptList(p.StargateQueryAcceptList); err != nil {
+		return errors.Wrap(err, "stargate query accept list")
+	}
+	if err := p.StargateMsgFilter.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "stargate msg filter")
+	}
 	return nil
 }
 
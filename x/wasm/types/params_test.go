@@ -31,30 +31,35 @@ func TestValidateParams(t *testing.T) {
 			src: Params{
 				CodeUploadAccess:             AllowNobody,
 				InstantiateDefaultPermission: AccessTypeNobody,
+				GasRegister:                  DefaultGasRegisterParams(),
 			},
 		},
 		"all good with everybody": {
 			src: Params{
 				CodeUploadAccess:             AllowEverybody,
 				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
 			},
 		},
 		"all good with only address": {
 			src: Params{
 				CodeUploadAccess:             AccessTypeOnlyAddress.With(anyAddress),
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
+				GasRegister:                  DefaultGasRegisterParams(),
 			},
 		},
 		"all good with anyOf address": {
 			src: Params{
 				CodeUploadAccess:             AccessTypeAnyOfAddresses.With(anyAddress),
 				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				GasRegister:                  DefaultGasRegisterParams(),
 			},
 		},
 		"all good with anyOf addresses": {
 			src: Params{
 				CodeUploadAccess:             AccessTypeAnyOfAddresses.With(anyAddress, otherAddress),
 				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				GasRegister:                  DefaultGasRegisterParams(),
 			},
 		},
 		"reject empty type in instantiate permission": {
@@ -139,6 +144,182 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"reject empty gas register": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+			},
+			expErr: true,
+		},
+		"reject zero gas multiplier": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister: func() GasRegisterParams {
+					p := DefaultGasRegisterParams()
+					p.GasMultiplier = 0
+					return p
+				}(),
+			},
+			expErr: true,
+		},
+		"reject zero uncompress cost denominator": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister: func() GasRegisterParams {
+					p := DefaultGasRegisterParams()
+					p.UncompressCostDenominator = 0
+					return p
+				}(),
+			},
+			expErr: true,
+		},
+		"all good with storage quota": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StorageQuota: StorageQuotaParams{
+					MaxContractBytes: 1024,
+					CodeOverrides:    []CodeStorageQuota{{CodeID: 1, MaxContractBytes: 2048}, {CodeID: 2}},
+				},
+			},
+		},
+		"reject empty code id in storage quota override": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StorageQuota: StorageQuotaParams{
+					CodeOverrides: []CodeStorageQuota{{CodeID: 0, MaxContractBytes: 1024}},
+				},
+			},
+			expErr: true,
+		},
+		"reject duplicate code id in storage quota overrides": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StorageQuota: StorageQuotaParams{
+					CodeOverrides: []CodeStorageQuota{{CodeID: 1, MaxContractBytes: 1024}, {CodeID: 1, MaxContractBytes: 2048}},
+				},
+			},
+			expErr: true,
+		},
+		"all good with stargate query accept list": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateQueryAcceptList: []AcceptedStargateQuery{
+					{Path: "/cosmos.auth.v1beta1.Query/Account", ResponseTypeURL: "/cosmos.auth.v1beta1.QueryAccountResponse"},
+				},
+			},
+		},
+		"reject invalid path in stargate query accept list": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateQueryAcceptList: []AcceptedStargateQuery{
+					{Path: "cosmos.auth.v1beta1.Query/Account", ResponseTypeURL: "/cosmos.auth.v1beta1.QueryAccountResponse"},
+				},
+			},
+			expErr: true,
+		},
+		"reject invalid response type url in stargate query accept list": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateQueryAcceptList: []AcceptedStargateQuery{
+					{Path: "/cosmos.auth.v1beta1.Query/Account", ResponseTypeURL: "/"},
+				},
+			},
+			expErr: true,
+		},
+		"reject smart query path in stargate query accept list": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateQueryAcceptList: []AcceptedStargateQuery{
+					{Path: "/cosmwasm.wasm.v1.Query/SmartContractState", ResponseTypeURL: "/cosmwasm.wasm.v1.QuerySmartContractStateResponse"},
+				},
+			},
+			expErr: true,
+		},
+		"reject batch smart query path in stargate query accept list": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateQueryAcceptList: []AcceptedStargateQuery{
+					{Path: "/cosmwasm.wasm.v1.Query/BatchSmartContractState", ResponseTypeURL: "/cosmwasm.wasm.v1.QueryBatchSmartContractStateResponse"},
+				},
+			},
+			expErr: true,
+		},
+		"reject simulate execute path in stargate query accept list": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateQueryAcceptList: []AcceptedStargateQuery{
+					{Path: "/cosmwasm.wasm.v1.Query/SimulateExecuteContract", ResponseTypeURL: "/cosmwasm.wasm.v1.QuerySimulateExecuteContractResponse"},
+				},
+			},
+			expErr: true,
+		},
+		"reject duplicate path in stargate query accept list": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateQueryAcceptList: []AcceptedStargateQuery{
+					{Path: "/cosmos.auth.v1beta1.Query/Account", ResponseTypeURL: "/cosmos.auth.v1beta1.QueryAccountResponse"},
+					{Path: "/cosmos.auth.v1beta1.Query/Account", ResponseTypeURL: "/cosmos.auth.v1beta1.QueryAccountResponse"},
+				},
+			},
+			expErr: true,
+		},
+		"all good with stargate msg filter": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateMsgFilter:            StargateMsgFilter{DenyList: []string{"/cosmos.bank.v1beta1.MsgSend"}, MaxMsgSize: 1024},
+			},
+		},
+		"reject accept list and deny list in stargate msg filter": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateMsgFilter:            StargateMsgFilter{AcceptList: []string{"/cosmos.bank.v1beta1.MsgSend"}, DenyList: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+			},
+			expErr: true,
+		},
+		"reject invalid type url in stargate msg filter": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateMsgFilter:            StargateMsgFilter{AcceptList: []string{"cosmos.bank.v1beta1.MsgSend"}},
+			},
+			expErr: true,
+		},
+		"reject duplicate type url in stargate msg filter": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  DefaultGasRegisterParams(),
+				StargateMsgFilter:            StargateMsgFilter{DenyList: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}},
+			},
+			expErr: true,
+		},
 		"reject wrong field address in any of  addresses": {
 			src: Params{
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses, Address: anyAddress.String(), Addresses: []string{anyAddress.String()}},
@@ -209,7 +390,12 @@ func TestParamsUnmarshalJson(t *testing.T) {
 	}{
 		"defaults": {
 			src: `{"code_upload_access": {"permission": "Everybody"},
-				"instantiate_default_permission": "Everybody"}`,
+				"instantiate_default_permission": "Everybody",
+				"gas_register": {"instance_cost": "60000", "compile_cost": "3",
+					"uncompress_cost_numerator": "15", "uncompress_cost_denominator": "100",
+					"gas_multiplier": "140000000", "event_per_attribute_cost": "10",
+					"event_attribute_data_cost": "1", "event_attribute_data_free_tier": "100",
+					"contract_message_data_cost": "0", "custom_event_cost": "20"}}`,
 			exp: DefaultParams(),
 		},
 	}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -23,6 +24,9 @@ const (
 	ProposalTypePinCodes                ProposalType = "PinCodes"
 	ProposalTypeUnpinCodes              ProposalType = "UnpinCodes"
 	ProposalTypeUpdateInstantiateConfig ProposalType = "UpdateInstantiateConfig"
+	ProposalTypeSetContractStorageQuota ProposalType = "SetContractStorageQuota"
+	ProposalTypeStoreAndInstantiate     ProposalType = "StoreAndInstantiateContract"
+	ProposalTypeUpdateParams            ProposalType = "UpdateParams"
 )
 
 // DisableAllProposals contains no wasm gov types.
@@ -40,6 +44,9 @@ var EnableAllProposals = []ProposalType{
 	ProposalTypePinCodes,
 	ProposalTypeUnpinCodes,
 	ProposalTypeUpdateInstantiateConfig,
+	ProposalTypeSetContractStorageQuota,
+	ProposalTypeStoreAndInstantiate,
+	ProposalTypeUpdateParams,
 }
 
 // ConvertToProposals maps each key to a ProposalType and returns a typed list.
@@ -71,6 +78,9 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalType(string(ProposalTypePinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUnpinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUpdateInstantiateConfig))
+	govtypes.RegisterProposalType(string(ProposalTypeSetContractStorageQuota))
+	govtypes.RegisterProposalType(string(ProposalTypeStoreAndInstantiate))
+	govtypes.RegisterProposalType(string(ProposalTypeUpdateParams))
 }
 
 // ProposalRoute returns the routing key of a parameter change proposal.
@@ -103,6 +113,10 @@ func (p StoreCodeProposal) ValidateBasic() error {
 			return sdkerrors.Wrap(err, "instantiate permission")
 		}
 	}
+
+	if err := ValidateVerificationInfo(p.Source, p.Builder, p.CodeHash); err != nil {
+		return sdkerrors.Wrap(err, "code verification info")
+	}
 	return nil
 }
 
@@ -113,7 +127,10 @@ func (p StoreCodeProposal) String() string {
   Description: %s
   Run as:      %s
   WasmCode:    %X
-`, p.Title, p.Description, p.RunAs, p.WASMByteCode)
+  Source:      %s
+  Builder:     %s
+  Code Hash:   %X
+`, p.Title, p.Description, p.RunAs, p.WASMByteCode, p.Source, p.Builder, p.CodeHash)
 }
 
 // MarshalYAML pretty prints the wasm byte code
@@ -124,12 +141,18 @@ func (p StoreCodeProposal) MarshalYAML() (interface{}, error) {
 		RunAs                 string        `yaml:"run_as"`
 		WASMByteCode          string        `yaml:"wasm_byte_code"`
 		InstantiatePermission *AccessConfig `yaml:"instantiate_permission"`
+		Source                string        `yaml:"source"`
+		Builder               string        `yaml:"builder"`
+		CodeHash              string        `yaml:"code_hash"`
 	}{
 		Title:                 p.Title,
 		Description:           p.Description,
 		RunAs:                 p.RunAs,
 		WASMByteCode:          base64.StdEncoding.EncodeToString(p.WASMByteCode),
 		InstantiatePermission: p.InstantiatePermission,
+		Source:                p.Source,
+		Builder:               p.Builder,
+		CodeHash:              hex.EncodeToString(p.CodeHash),
 	}, nil
 }
 
@@ -593,3 +616,167 @@ func (c AccessConfigUpdate) String() string {
   AccessConfig: %v
 `, c.CodeID, c.InstantiatePermission)
 }
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p SetContractStorageQuotaProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *SetContractStorageQuotaProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p SetContractStorageQuotaProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p SetContractStorageQuotaProposal) ProposalType() string {
+	return string(ProposalTypeSetContractStorageQuota)
+}
+
+// ValidateBasic validates the proposal
+func (p SetContractStorageQuotaProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if len(p.Contracts) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "contracts")
+	}
+	dedup := make(map[string]bool)
+	for _, contract := range p.Contracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return sdkerrors.Wrap(err, "contract")
+		}
+		if dedup[contract] {
+			return sdkerrors.Wrapf(ErrDuplicate, "duplicate contract: %s", contract)
+		}
+		dedup[contract] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SetContractStorageQuotaProposal) String() string {
+	return fmt.Sprintf(`Set Contract Storage Quota Proposal:
+  Title:            %s
+  Description:      %s
+  Contracts:        %v
+  MaxContractBytes: %d
+`, p.Title, p.Description, p.Contracts, p.MaxContractBytes)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p StoreAndInstantiateContractProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *StoreAndInstantiateContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p StoreAndInstantiateContractProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p StoreAndInstantiateContractProposal) ProposalType() string {
+	return string(ProposalTypeStoreAndInstantiate)
+}
+
+// ValidateBasic validates the proposal
+func (p StoreAndInstantiateContractProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+
+	if err := validateWasmCode(p.WASMByteCode); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
+	}
+
+	if p.InstantiatePermission != nil {
+		if err := p.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+
+	if err := ValidateLabel(p.Label); err != nil {
+		return err
+	}
+
+	if !p.Funds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if len(p.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.Admin); err != nil {
+			return err
+		}
+	}
+	if err := p.Msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "payload msg")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p StoreAndInstantiateContractProposal) String() string {
+	return fmt.Sprintf(`Store And Instantiate Contract Proposal:
+  Title:       %s
+  Description: %s
+  WasmCode:    %X
+  Admin:       %s
+  Label:       %s
+  Msg:         %q
+  Funds:       %s
+`, p.Title, p.Description, p.WASMByteCode, p.Admin, p.Label, p.Msg, p.Funds)
+}
+
+// MarshalYAML pretty prints the wasm byte code and the init message
+func (p StoreAndInstantiateContractProposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		Title                 string        `yaml:"title"`
+		Description           string        `yaml:"description"`
+		WASMByteCode          string        `yaml:"wasm_byte_code"`
+		InstantiatePermission *AccessConfig `yaml:"instantiate_permission"`
+		UnpinCode             bool          `yaml:"unpin_code"`
+		Admin                 string        `yaml:"admin"`
+		Label                 string        `yaml:"label"`
+		Msg                   string        `yaml:"msg"`
+		Funds                 sdk.Coins     `yaml:"funds"`
+	}{
+		Title:                 p.Title,
+		Description:           p.Description,
+		WASMByteCode:          base64.StdEncoding.EncodeToString(p.WASMByteCode),
+		InstantiatePermission: p.InstantiatePermission,
+		UnpinCode:             p.UnpinCode,
+		Admin:                 p.Admin,
+		Label:                 p.Label,
+		Msg:                   string(p.Msg),
+		Funds:                 p.Funds,
+	}, nil
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p UpdateParamsProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p UpdateParamsProposal) ProposalType() string { return string(ProposalTypeUpdateParams) }
+
+// ValidateBasic validates the proposal
+func (p UpdateParamsProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if err := p.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UpdateParamsProposal) String() string {
+	return fmt.Sprintf(`Update Params Proposal:
+  Title:       %s
+  Description: %s
+  Params:      %s
+`, p.Title, p.Description, p.Params)
+}
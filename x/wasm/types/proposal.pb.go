@@ -44,6 +44,14 @@ type StoreCodeProposal struct {
 	InstantiatePermission *AccessConfig `protobuf:"bytes,7,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty"`
 	// UnpinCode code on upload, optional
 	UnpinCode bool `protobuf:"varint,8,opt,name=unpin_code,json=unpinCode,proto3" json:"unpin_code,omitempty"`
+	// Source is the URL where the code is hosted, optional
+	Source string `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	// Builder is the docker image used to build the code deterministically,
+	// used for smart contract verification, optional
+	Builder string `protobuf:"bytes,10,opt,name=builder,proto3" json:"builder,omitempty"`
+	// CodeHash is the SHA256 sum of the code outputted by the builder, used for
+	// smart contract verification, optional
+	CodeHash []byte `protobuf:"bytes,11,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
 }
 
 func (m *StoreCodeProposal) Reset()      { *m = StoreCodeProposal{} }
@@ -583,6 +591,168 @@ func (m *UpdateInstantiateConfigProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateInstantiateConfigProposal proto.InternalMessageInfo
 
+// SetContractStorageQuotaProposal gov proposal content type to set the maximum
+// state size of a set of contracts. It takes precedence over the params.
+type SetContractStorageQuotaProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Contracts are the addresses of the smart contracts
+	Contracts []string `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty" yaml:"contracts"`
+	// MaxContractBytes is the maximum state size of each contract in bytes, 0
+	// for no limit
+	MaxContractBytes uint64 `protobuf:"varint,4,opt,name=max_contract_bytes,json=maxContractBytes,proto3" json:"max_contract_bytes,omitempty" yaml:"max_contract_bytes"`
+}
+
+func (m *SetContractStorageQuotaProposal) Reset()      { *m = SetContractStorageQuotaProposal{} }
+func (*SetContractStorageQuotaProposal) ProtoMessage() {}
+func (*SetContractStorageQuotaProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{11}
+}
+
+func (m *SetContractStorageQuotaProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SetContractStorageQuotaProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetContractStorageQuotaProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SetContractStorageQuotaProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetContractStorageQuotaProposal.Merge(m, src)
+}
+
+func (m *SetContractStorageQuotaProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SetContractStorageQuotaProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetContractStorageQuotaProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetContractStorageQuotaProposal proto.InternalMessageInfo
+
+// StoreAndInstantiateContractProposal gov proposal content type to store and
+// instantiate the contract with the module authority as creator.
+type StoreAndInstantiateContractProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// WASMByteCode can be raw or gzip compressed
+	WASMByteCode []byte `protobuf:"bytes,3,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
+	// InstantiatePermission to apply on contract creation, optional
+	InstantiatePermission *AccessConfig `protobuf:"bytes,4,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty"`
+	// UnpinCode code on upload, optional
+	UnpinCode bool `protobuf:"varint,5,opt,name=unpin_code,json=unpinCode,proto3" json:"unpin_code,omitempty"`
+	// Admin is an optional address that can execute migrations
+	Admin string `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Label is optional metadata to be stored with a constract instance.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	// Msg json encoded message to be passed to the contract on instantiation
+	Msg RawContractMessage `protobuf:"bytes,8,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// Funds coins that are transferred from the authority account to the
+	// contract on instantiation
+	Funds github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,9,rep,name=funds,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"funds"`
+}
+
+func (m *StoreAndInstantiateContractProposal) Reset()      { *m = StoreAndInstantiateContractProposal{} }
+func (*StoreAndInstantiateContractProposal) ProtoMessage() {}
+func (*StoreAndInstantiateContractProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{12}
+}
+
+func (m *StoreAndInstantiateContractProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *StoreAndInstantiateContractProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreAndInstantiateContractProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *StoreAndInstantiateContractProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreAndInstantiateContractProposal.Merge(m, src)
+}
+
+func (m *StoreAndInstantiateContractProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *StoreAndInstantiateContractProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreAndInstantiateContractProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreAndInstantiateContractProposal proto.InternalMessageInfo
+
+// UpdateParamsProposal gov proposal content type to update all x/wasm
+// parameters.
+type UpdateParamsProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Params defines the x/wasm parameters to set.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (m *UpdateParamsProposal) Reset()      { *m = UpdateParamsProposal{} }
+func (*UpdateParamsProposal) ProtoMessage() {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{13}
+}
+
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*StoreCodeProposal)(nil), "cosmwasm.wasm.v1.StoreCodeProposal")
 	proto.RegisterType((*InstantiateContractProposal)(nil), "cosmwasm.wasm.v1.InstantiateContractProposal")
@@ -595,65 +765,80 @@ func init() {
 	proto.RegisterType((*UnpinCodesProposal)(nil), "cosmwasm.wasm.v1.UnpinCodesProposal")
 	proto.RegisterType((*AccessConfigUpdate)(nil), "cosmwasm.wasm.v1.AccessConfigUpdate")
 	proto.RegisterType((*UpdateInstantiateConfigProposal)(nil), "cosmwasm.wasm.v1.UpdateInstantiateConfigProposal")
+	proto.RegisterType((*SetContractStorageQuotaProposal)(nil), "cosmwasm.wasm.v1.SetContractStorageQuotaProposal")
+	proto.RegisterType((*StoreAndInstantiateContractProposal)(nil), "cosmwasm.wasm.v1.StoreAndInstantiateContractProposal")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "cosmwasm.wasm.v1.UpdateParamsProposal")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/proposal.proto", fileDescriptor_be6422d717c730cb) }
 
 var fileDescriptor_be6422d717c730cb = []byte{
-	// 836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xcf, 0xe4, 0x8f, 0x93, 0x4e, 0x23, 0x08, 0xde, 0xb4, 0x1b, 0x0a, 0xd8, 0x91, 0x41, 0x2b,
-	0x1f, 0xc0, 0x56, 0x0a, 0x42, 0xc0, 0xad, 0x0e, 0x20, 0x75, 0x45, 0xa5, 0xca, 0x55, 0x85, 0x04,
-	0x12, 0xd6, 0xc4, 0x9e, 0xba, 0x23, 0x92, 0x19, 0xcb, 0x33, 0x6e, 0xb7, 0xdf, 0x82, 0x03, 0x70,
-	0xda, 0x0f, 0x80, 0xb8, 0x20, 0xee, 0x7c, 0x80, 0x9e, 0xd0, 0x1e, 0xf7, 0x64, 0xd8, 0xf4, 0x1b,
-	0xf4, 0xc8, 0x09, 0xcd, 0x8c, 0x53, 0xd2, 0xdd, 0x6d, 0x77, 0x11, 0x5b, 0xa4, 0xbd, 0x58, 0x1e,
-	0xbf, 0xf7, 0xe6, 0xf7, 0x9b, 0x9f, 0x7e, 0xef, 0x8d, 0xa1, 0x1d, 0x33, 0x3e, 0x3b, 0x46, 0x7c,
-	0xe6, 0xab, 0xc7, 0xd1, 0xc8, 0xcf, 0x72, 0x96, 0x31, 0x8e, 0xa6, 0x5e, 0x96, 0x33, 0xc1, 0xcc,
-	0xde, 0x22, 0xc1, 0x53, 0x8f, 0xa3, 0xd1, 0x46, 0x3f, 0x65, 0x29, 0x53, 0x41, 0x5f, 0xbe, 0xe9,
-	0xbc, 0x0d, 0x4b, 0xe6, 0x31, 0xee, 0x4f, 0x10, 0xc7, 0xfe, 0xd1, 0x68, 0x82, 0x05, 0x1a, 0xf9,
-	0x31, 0x23, 0xb4, 0x8a, 0xbf, 0xf9, 0x04, 0x90, 0x38, 0xc9, 0x30, 0xd7, 0x51, 0xe7, 0x7e, 0x1d,
-	0xbe, 0xb6, 0x27, 0x58, 0x8e, 0xc7, 0x2c, 0xc1, 0xbb, 0x15, 0x03, 0xb3, 0x0f, 0x5b, 0x82, 0x88,
-	0x29, 0x1e, 0x80, 0x21, 0x70, 0x57, 0x42, 0xbd, 0x30, 0x87, 0x70, 0x35, 0xc1, 0x3c, 0xce, 0x49,
-	0x26, 0x08, 0xa3, 0x83, 0xba, 0x8a, 0x2d, 0x7f, 0x32, 0xd7, 0xa0, 0x91, 0x17, 0x34, 0x42, 0x7c,
-	0xd0, 0xd0, 0x85, 0x79, 0x41, 0xb7, 0xb8, 0xf9, 0x21, 0x7c, 0x45, 0x62, 0x47, 0x93, 0x13, 0x81,
-	0xa3, 0x98, 0x25, 0x78, 0xd0, 0x1c, 0x02, 0xb7, 0x1b, 0xf4, 0xe6, 0xa5, 0xdd, 0xfd, 0x72, 0x6b,
-	0x6f, 0x27, 0x38, 0x11, 0x8a, 0x40, 0xd8, 0x95, 0x79, 0x8b, 0x95, 0xb9, 0x0f, 0xd7, 0x09, 0xe5,
-	0x02, 0x51, 0x41, 0x90, 0xc0, 0x51, 0x86, 0xf3, 0x19, 0xe1, 0x5c, 0x62, 0xb7, 0x87, 0xc0, 0x5d,
-	0xdd, 0xb4, 0xbc, 0xc7, 0x35, 0xf2, 0xb6, 0xe2, 0x18, 0x73, 0x3e, 0x66, 0xf4, 0x80, 0xa4, 0xe1,
-	0xda, 0x52, 0xf5, 0xee, 0x45, 0xb1, 0xf9, 0x16, 0x84, 0x05, 0xcd, 0x08, 0xd5, 0x54, 0x3a, 0x43,
-	0xe0, 0x76, 0xc2, 0x15, 0xf5, 0x45, 0xa2, 0xde, 0x6d, 0x76, 0x5a, 0x3d, 0xe3, 0x6e, 0xb3, 0x63,
-	0xf4, 0xda, 0xce, 0xef, 0x75, 0xf8, 0xc6, 0xf6, 0x3f, 0x9b, 0x8c, 0x19, 0x15, 0x39, 0x8a, 0xc5,
-	0x4d, 0x09, 0xd5, 0x87, 0x2d, 0x94, 0xcc, 0x08, 0x55, 0xfa, 0xac, 0x84, 0x7a, 0x61, 0xbe, 0x0d,
-	0xdb, 0x92, 0x69, 0x44, 0x92, 0x41, 0x6b, 0x08, 0xdc, 0x66, 0x00, 0xe7, 0xa5, 0x6d, 0x48, 0xae,
-	0xdb, 0x9f, 0x86, 0x86, 0x0c, 0x6d, 0x27, 0xb2, 0x74, 0x8a, 0x26, 0x78, 0x3a, 0x30, 0x74, 0xa9,
-	0x5a, 0x98, 0x2e, 0x6c, 0xcc, 0x78, 0xaa, 0xe4, 0xea, 0x06, 0xeb, 0x7f, 0x95, 0xb6, 0x19, 0xa2,
-	0xe3, 0xc5, 0x29, 0x76, 0x30, 0xe7, 0x28, 0xc5, 0xa1, 0x4c, 0x31, 0x31, 0x6c, 0x1d, 0x14, 0x34,
-	0xe1, 0x83, 0xce, 0xb0, 0xe1, 0xae, 0x6e, 0xbe, 0xee, 0x69, 0x5b, 0x79, 0xd2, 0x56, 0x5e, 0x65,
-	0x2b, 0x6f, 0xcc, 0x08, 0x0d, 0x3e, 0x38, 0x2d, 0xed, 0xda, 0xcf, 0x7f, 0xd8, 0xef, 0xa6, 0x44,
-	0x1c, 0x16, 0x13, 0x2f, 0x66, 0x33, 0xff, 0x73, 0x42, 0x79, 0x7c, 0x48, 0x90, 0x7f, 0x50, 0xbd,
-	0xbc, 0xc7, 0x93, 0x6f, 0x2b, 0xa3, 0xc9, 0x22, 0x1e, 0xea, 0xdd, 0x9d, 0xdf, 0x00, 0xbc, 0xbd,
-	0x43, 0xd2, 0xfc, 0x45, 0x8a, 0xb9, 0x01, 0x3b, 0x71, 0xb5, 0x57, 0x25, 0xdc, 0xc5, 0xfa, 0xf9,
-	0xb4, 0xab, 0x54, 0x32, 0x9e, 0xa9, 0x92, 0xf3, 0x3d, 0x80, 0xfd, 0xbd, 0x22, 0x61, 0x37, 0xc2,
-	0xbd, 0xf1, 0x18, 0xf7, 0x8a, 0x56, 0xf3, 0xd9, 0xb4, 0x7e, 0xa8, 0xc3, 0xdb, 0x9f, 0xdd, 0xc3,
-	0x71, 0x71, 0xf3, 0x16, 0xbd, 0x4e, 0xec, 0x8a, 0x70, 0xeb, 0x5f, 0xb8, 0xcd, 0xb8, 0x51, 0xb7,
-	0xdd, 0x07, 0xf0, 0xd6, 0x7e, 0x96, 0x20, 0x81, 0xb7, 0x64, 0x27, 0xfd, 0x67, 0x4d, 0x46, 0x70,
-	0x85, 0xe2, 0xe3, 0x48, 0xf7, 0xa8, 0x92, 0x25, 0xe8, 0x9f, 0x97, 0x76, 0xef, 0x04, 0xcd, 0xa6,
-	0x9f, 0x38, 0x17, 0x21, 0x27, 0xec, 0x50, 0x7c, 0xac, 0x20, 0xaf, 0xd3, 0xcb, 0x39, 0x84, 0xe6,
-	0x78, 0x8a, 0x51, 0xfe, 0x62, 0xc8, 0x5d, 0x63, 0x25, 0xe7, 0x17, 0x00, 0x7b, 0xbb, 0x7a, 0xbe,
-	0xf1, 0x0b, 0xa0, 0x3b, 0x97, 0x80, 0x82, 0xde, 0x79, 0x69, 0x77, 0xf5, 0x49, 0xd4, 0x67, 0x67,
-	0x01, 0xfd, 0xd1, 0x53, 0xa0, 0x83, 0xf5, 0xf3, 0xd2, 0x36, 0x75, 0xf6, 0x52, 0xd0, 0xb9, 0x4c,
-	0xe9, 0x63, 0x49, 0x49, 0x75, 0x9f, 0x74, 0x51, 0xc3, 0x6d, 0x06, 0xd6, 0xbc, 0xb4, 0xdb, 0xba,
-	0xfd, 0xf8, 0x79, 0x69, 0xbf, 0xaa, 0x77, 0x58, 0x24, 0x39, 0x61, 0x5b, 0xb7, 0x24, 0x77, 0x7e,
-	0x05, 0xd0, 0xdc, 0x5f, 0xcc, 0xe4, 0x97, 0x84, 0xf3, 0x8f, 0x00, 0x9a, 0xcb, 0x17, 0x90, 0xb6,
-	0xde, 0xf2, 0x0c, 0x02, 0x57, 0xce, 0xa0, 0xaf, 0xaf, 0xbc, 0xeb, 0xea, 0xcf, 0x73, 0xd7, 0x05,
-	0x4d, 0xd9, 0x27, 0x57, 0xdc, 0x78, 0xce, 0x19, 0x80, 0xb6, 0x26, 0x73, 0xf9, 0x32, 0x3b, 0x20,
-	0xe9, 0xff, 0xa8, 0xec, 0x37, 0x70, 0x0d, 0x29, 0xca, 0x51, 0xac, 0xa0, 0xa3, 0x42, 0x51, 0xd2,
-	0x32, 0xaf, 0x6e, 0xbe, 0x73, 0xfd, 0x09, 0x35, 0xff, 0xea, 0x9c, 0xb7, 0xd0, 0x13, 0x11, 0x1e,
-	0x7c, 0x71, 0xfa, 0xc8, 0xaa, 0x3d, 0x7c, 0x64, 0xd5, 0x7e, 0x9a, 0x5b, 0xe0, 0x74, 0x6e, 0x81,
-	0x07, 0x73, 0x0b, 0xfc, 0x39, 0xb7, 0xc0, 0x77, 0x67, 0x56, 0xed, 0xc1, 0x99, 0x55, 0x7b, 0x78,
-	0x66, 0xd5, 0xbe, 0xba, 0xf3, 0xb4, 0x41, 0x22, 0x01, 0x13, 0xff, 0x9e, 0xfe, 0x45, 0x52, 0x83,
-	0x64, 0x62, 0xa8, 0x1f, 0xa4, 0xf7, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x90, 0xaf, 0x66, 0xb8,
-	0xa9, 0x09, 0x00, 0x00,
+	// 1035 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xf3, 0xe1, 0x38, 0x93, 0x00, 0x61, 0x36, 0xed, 0x7a, 0xbb, 0xac, 0x1d, 0x79, 0xd1,
+	0x2a, 0x07, 0x48, 0x94, 0x80, 0x10, 0x70, 0x8b, 0xc3, 0x57, 0x17, 0x2a, 0x15, 0x57, 0x15, 0x12,
+	0x48, 0x44, 0x13, 0x7b, 0x9a, 0x58, 0xc4, 0x1e, 0xcb, 0x63, 0xf7, 0xe3, 0xce, 0x1f, 0xc0, 0x01,
+	0x38, 0xf1, 0x07, 0x20, 0x2e, 0x88, 0x3b, 0x17, 0x6e, 0x3d, 0xa1, 0x3d, 0xee, 0xc9, 0xb0, 0xe9,
+	0x91, 0x5b, 0x8e, 0x9c, 0xd0, 0xcc, 0x38, 0xd9, 0xb4, 0x69, 0xbb, 0xbb, 0x6a, 0x53, 0x89, 0x8b,
+	0xe3, 0x99, 0xf7, 0xde, 0xbc, 0xdf, 0xfb, 0xf9, 0xcd, 0x7b, 0x2f, 0x40, 0xb7, 0x09, 0xf5, 0x0e,
+	0x10, 0xf5, 0x5a, 0xfc, 0xb1, 0xdf, 0x6e, 0x05, 0x21, 0x09, 0x08, 0x45, 0xe3, 0x66, 0x10, 0x92,
+	0x88, 0xc0, 0xea, 0x4c, 0xa1, 0xc9, 0x1f, 0xfb, 0xed, 0x8d, 0xda, 0x90, 0x0c, 0x09, 0x17, 0xb6,
+	0xd8, 0x9b, 0xd0, 0xdb, 0xd0, 0x98, 0x1e, 0xa1, 0xad, 0x01, 0xa2, 0xb8, 0xb5, 0xdf, 0x1e, 0xe0,
+	0x08, 0xb5, 0x5b, 0x36, 0x71, 0xfd, 0x54, 0xfe, 0xda, 0x92, 0xa3, 0xe8, 0x28, 0xc0, 0x54, 0x48,
+	0x8d, 0x7f, 0xb2, 0xe0, 0xd5, 0x9d, 0x88, 0x84, 0xb8, 0x47, 0x1c, 0xbc, 0x9d, 0x22, 0x80, 0x35,
+	0x50, 0x88, 0xdc, 0x68, 0x8c, 0x55, 0xa9, 0x2e, 0x35, 0x4a, 0x96, 0x58, 0xc0, 0x3a, 0x28, 0x3b,
+	0x98, 0xda, 0xa1, 0x1b, 0x44, 0x2e, 0xf1, 0xd5, 0x2c, 0x97, 0x2d, 0x6e, 0xc1, 0x35, 0x20, 0x87,
+	0xb1, 0xdf, 0x47, 0x54, 0xcd, 0x09, 0xc3, 0x30, 0xf6, 0xbb, 0x14, 0xbe, 0x03, 0x5e, 0x66, 0xbe,
+	0xfb, 0x83, 0xa3, 0x08, 0xf7, 0x6d, 0xe2, 0x60, 0x35, 0x5f, 0x97, 0x1a, 0x15, 0xb3, 0x3a, 0x49,
+	0xf4, 0xca, 0x17, 0xdd, 0x9d, 0x2d, 0xf3, 0x28, 0xe2, 0x00, 0xac, 0x0a, 0xd3, 0x9b, 0xad, 0xe0,
+	0x2e, 0x58, 0x77, 0x7d, 0x1a, 0x21, 0x3f, 0x72, 0x51, 0x84, 0xfb, 0x01, 0x0e, 0x3d, 0x97, 0x52,
+	0xe6, 0xbb, 0x58, 0x97, 0x1a, 0xe5, 0x8e, 0xd6, 0x3c, 0xcb, 0x51, 0xb3, 0x6b, 0xdb, 0x98, 0xd2,
+	0x1e, 0xf1, 0xf7, 0xdc, 0xa1, 0xb5, 0xb6, 0x60, 0xbd, 0x3d, 0x37, 0x86, 0xf7, 0x00, 0x88, 0xfd,
+	0xc0, 0xf5, 0x05, 0x14, 0xa5, 0x2e, 0x35, 0x14, 0xab, 0xc4, 0x77, 0xb8, 0xd7, 0x75, 0x20, 0x53,
+	0x12, 0x87, 0x36, 0x56, 0x4b, 0x3c, 0x88, 0x74, 0x05, 0x55, 0x50, 0x1c, 0xc4, 0xee, 0xd8, 0xc1,
+	0xa1, 0x0a, 0xb8, 0x60, 0xb6, 0x84, 0x77, 0x41, 0x89, 0x1d, 0xd5, 0x1f, 0x21, 0x3a, 0x52, 0xcb,
+	0x2c, 0x34, 0x4b, 0x61, 0x1b, 0x9f, 0x20, 0x3a, 0x7a, 0x98, 0x57, 0x0a, 0x55, 0xf9, 0x61, 0x5e,
+	0x91, 0xab, 0x45, 0xe3, 0xcf, 0x2c, 0xb8, 0xbb, 0xf9, 0x14, 0x53, 0x8f, 0xf8, 0x51, 0x88, 0xec,
+	0x68, 0x55, 0xbc, 0xd7, 0x40, 0x01, 0x39, 0x9e, 0xeb, 0x73, 0xba, 0x4b, 0x96, 0x58, 0xc0, 0xfb,
+	0xa0, 0xc8, 0xd1, 0xba, 0x8e, 0x5a, 0xa8, 0x4b, 0x8d, 0xbc, 0x09, 0x26, 0x89, 0x2e, 0xb3, 0xd0,
+	0x37, 0x3f, 0xb0, 0x64, 0x26, 0xda, 0x74, 0x98, 0xe9, 0x18, 0x0d, 0xf0, 0x58, 0x95, 0x85, 0x29,
+	0x5f, 0xc0, 0x06, 0xc8, 0x79, 0x74, 0xc8, 0xd9, 0xaf, 0x98, 0xeb, 0xff, 0x26, 0x3a, 0xb4, 0xd0,
+	0xc1, 0x2c, 0x8a, 0x2d, 0x4c, 0x29, 0x1a, 0x62, 0x8b, 0xa9, 0x40, 0x0c, 0x0a, 0x7b, 0xb1, 0xef,
+	0x50, 0x55, 0xa9, 0xe7, 0x1a, 0xe5, 0xce, 0x9d, 0xa6, 0xc8, 0xd2, 0x26, 0xcb, 0xd2, 0x66, 0x9a,
+	0xa5, 0xcd, 0x1e, 0x71, 0x7d, 0xf3, 0xed, 0xe3, 0x44, 0xcf, 0xfc, 0xf2, 0x97, 0xfe, 0xc6, 0xd0,
+	0x8d, 0x46, 0xf1, 0xa0, 0x69, 0x13, 0xaf, 0xf5, 0x91, 0xeb, 0x53, 0x7b, 0xe4, 0xa2, 0xd6, 0x5e,
+	0xfa, 0xf2, 0x26, 0x75, 0xbe, 0x49, 0xf3, 0x96, 0x19, 0x51, 0x4b, 0x9c, 0x6e, 0xfc, 0x2e, 0x81,
+	0xdb, 0x5b, 0xee, 0x30, 0xbc, 0x4e, 0x32, 0x37, 0x80, 0x62, 0xa7, 0x67, 0xa5, 0xc4, 0xcd, 0xd7,
+	0xcf, 0xc7, 0x5d, 0xca, 0x92, 0xfc, 0x4c, 0x96, 0x8c, 0xef, 0x25, 0x50, 0xdb, 0x89, 0x1d, 0xb2,
+	0x12, 0xec, 0xb9, 0x33, 0xd8, 0x53, 0x58, 0xf9, 0x67, 0xc3, 0xfa, 0x21, 0x0b, 0x6e, 0x7f, 0x78,
+	0x88, 0xed, 0x78, 0xf5, 0x29, 0x7a, 0x19, 0xd9, 0x29, 0xe0, 0xc2, 0x0b, 0x64, 0x9b, 0xbc, 0xd2,
+	0x6c, 0xfb, 0x49, 0x02, 0xb7, 0x76, 0x03, 0x07, 0x45, 0xb8, 0xcb, 0x6e, 0xd2, 0x95, 0x39, 0x69,
+	0x83, 0x92, 0x8f, 0x0f, 0xfa, 0xe2, 0x8e, 0x72, 0x5a, 0xcc, 0xda, 0x34, 0xd1, 0xab, 0x47, 0xc8,
+	0x1b, 0xbf, 0x6f, 0xcc, 0x45, 0x86, 0xa5, 0xf8, 0xf8, 0x80, 0xbb, 0xbc, 0x8c, 0x2f, 0x63, 0x04,
+	0x60, 0x6f, 0x8c, 0x51, 0x78, 0x3d, 0xe0, 0x2e, 0x49, 0x25, 0xe3, 0x57, 0x09, 0x54, 0xb7, 0x45,
+	0xb9, 0xa4, 0x73, 0x47, 0x0f, 0x4e, 0x39, 0x32, 0xab, 0xd3, 0x44, 0xaf, 0x88, 0x48, 0xf8, 0xb6,
+	0x31, 0x73, 0xfd, 0xee, 0x39, 0xae, 0xcd, 0xf5, 0x69, 0xa2, 0x43, 0xa1, 0xbd, 0x20, 0x34, 0x4e,
+	0x43, 0x7a, 0x0f, 0x28, 0xe9, 0xed, 0x63, 0x59, 0x94, 0x6b, 0xe4, 0x4d, 0x6d, 0x92, 0xe8, 0x45,
+	0x71, 0xfd, 0xe8, 0x34, 0xd1, 0x5f, 0x11, 0x27, 0xcc, 0x94, 0x0c, 0xab, 0x28, 0xae, 0x24, 0x35,
+	0x7e, 0x93, 0x00, 0xdc, 0xf5, 0x83, 0xff, 0x15, 0xe6, 0x1f, 0x25, 0x00, 0x17, 0xfb, 0x99, 0x48,
+	0xbd, 0xc5, 0x1a, 0x24, 0x5d, 0x58, 0x83, 0xbe, 0xba, 0xb0, 0x75, 0x66, 0x9f, 0xa7, 0x75, 0x9a,
+	0x79, 0x76, 0x4f, 0x2e, 0x68, 0xa0, 0xc6, 0x89, 0x04, 0x74, 0x01, 0xe6, 0x74, 0x33, 0xdb, 0x73,
+	0x87, 0x37, 0xc8, 0xec, 0xd7, 0x60, 0x0d, 0x71, 0xc8, 0x7d, 0x9b, 0xbb, 0xee, 0xc7, 0x1c, 0x92,
+	0xa0, 0xb9, 0xdc, 0x79, 0xfd, 0xf2, 0x08, 0x05, 0xfe, 0x34, 0xce, 0x5b, 0x68, 0x49, 0x42, 0x8d,
+	0x6f, 0xb3, 0x40, 0xdf, 0xc1, 0xd1, 0xac, 0xe0, 0xb0, 0x29, 0x09, 0x0d, 0xf1, 0xe7, 0x31, 0x89,
+	0xd0, 0x0d, 0x46, 0xd9, 0x61, 0xb3, 0x85, 0x40, 0x20, 0x22, 0x3b, 0x55, 0x23, 0xe6, 0x22, 0xc3,
+	0x7a, 0xaa, 0x06, 0x3f, 0x05, 0xd0, 0x43, 0x87, 0xfd, 0xd9, 0x06, 0x9f, 0xbb, 0x28, 0x2f, 0x17,
+	0x79, 0xf3, 0xde, 0x34, 0xd1, 0xef, 0x08, 0xe3, 0x65, 0x1d, 0xc3, 0xaa, 0x7a, 0xe8, 0x70, 0x16,
+	0xb1, 0xc9, 0xb7, 0x8e, 0x73, 0xe0, 0x3e, 0x8b, 0x1d, 0x77, 0x7d, 0x67, 0x15, 0xb3, 0xcb, 0xf2,
+	0x70, 0x98, 0xbb, 0xe2, 0x70, 0x98, 0xbf, 0xbe, 0xe1, 0xb0, 0x70, 0x76, 0x38, 0x9c, 0x8f, 0x54,
+	0xf2, 0xe2, 0x48, 0x35, 0x9f, 0x96, 0x8a, 0xe7, 0x4c, 0x4b, 0xca, 0x0b, 0xf4, 0xaf, 0xd2, 0x4a,
+	0xfb, 0xd7, 0x1f, 0x12, 0xa8, 0x89, 0xec, 0xde, 0x46, 0x21, 0xf2, 0x6e, 0xb2, 0x0c, 0x7e, 0x0c,
+	0xe4, 0x80, 0xfb, 0xe4, 0x5f, 0xb7, 0xdc, 0x51, 0x97, 0xbf, 0x8e, 0xc0, 0x64, 0xae, 0xb1, 0x08,
+	0xa7, 0x89, 0xfe, 0x92, 0x38, 0x52, 0x58, 0x19, 0x56, 0x6a, 0x6e, 0x7e, 0x76, 0xfc, 0x44, 0xcb,
+	0x3c, 0x7e, 0xa2, 0x65, 0x7e, 0x9e, 0x68, 0xd2, 0xf1, 0x44, 0x93, 0x1e, 0x4d, 0x34, 0xe9, 0xef,
+	0x89, 0x26, 0x7d, 0x77, 0xa2, 0x65, 0x1e, 0x9d, 0x68, 0x99, 0xc7, 0x27, 0x5a, 0xe6, 0xcb, 0x07,
+	0xe7, 0xd1, 0xc3, 0x1c, 0x39, 0xad, 0x43, 0xfe, 0x2b, 0xe8, 0x19, 0xc8, 0xfc, 0x5f, 0xd0, 0x5b,
+	0xff, 0x0d, 0x00, 0x72, 0x8f, 0xf5, 0x3b, 0x8e, 0x0d, 0x00, 0x00,
 }
 
 func (this *StoreCodeProposal) Equal(that interface{}) bool {
@@ -693,6 +878,15 @@ func (this *StoreCodeProposal) Equal(that interface{}) bool {
 	if this.UnpinCode != that1.UnpinCode {
 		return false
 	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if this.Builder != that1.Builder {
+		return false
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
 	return true
 }
 
@@ -1064,6 +1258,130 @@ func (this *UpdateInstantiateConfigProposal) Equal(that interface{}) bool {
 	return true
 }
 
+func (this *SetContractStorageQuotaProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetContractStorageQuotaProposal)
+	if !ok {
+		that2, ok := that.(SetContractStorageQuotaProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Contracts) != len(that1.Contracts) {
+		return false
+	}
+	for i := range this.Contracts {
+		if this.Contracts[i] != that1.Contracts[i] {
+			return false
+		}
+	}
+	if this.MaxContractBytes != that1.MaxContractBytes {
+		return false
+	}
+	return true
+}
+
+func (this *StoreAndInstantiateContractProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StoreAndInstantiateContractProposal)
+	if !ok {
+		that2, ok := that.(StoreAndInstantiateContractProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !bytes.Equal(this.WASMByteCode, that1.WASMByteCode) {
+		return false
+	}
+	if !this.InstantiatePermission.Equal(that1.InstantiatePermission) {
+		return false
+	}
+	if this.UnpinCode != that1.UnpinCode {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if len(this.Funds) != len(that1.Funds) {
+		return false
+	}
+	for i := range this.Funds {
+		if !this.Funds[i].Equal(&that1.Funds[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *UpdateParamsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateParamsProposal)
+	if !ok {
+		that2, ok := that.(UpdateParamsProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+
 func (m *StoreCodeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1084,6 +1402,27 @@ func (m *StoreCodeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Builder) > 0 {
+		i -= len(m.Builder)
+		copy(dAtA[i:], m.Builder)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Builder)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.UnpinCode {
 		i--
 		if m.UnpinCode {
@@ -1694,35 +2033,234 @@ func (m *UpdateInstantiateConfigProposal) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposal(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SetContractStorageQuotaProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *StoreCodeProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetContractStorageQuotaProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetContractStorageQuotaProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if m.MaxContractBytes != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.MaxContractBytes))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.RunAs)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreAndInstantiateContractProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreAndInstantiateContractProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreAndInstantiateContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UnpinCode {
+		i--
+		if m.UnpinCode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InstantiatePermission != nil {
+		{
+			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WASMByteCode) > 0 {
+		i -= len(m.WASMByteCode)
+		copy(dAtA[i:], m.WASMByteCode)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.WASMByteCode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *StoreCodeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.RunAs)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
 	}
 	l = len(m.WASMByteCode)
 	if l > 0 {
@@ -1735,6 +2273,18 @@ func (m *StoreCodeProposal) Size() (n int) {
 	if m.UnpinCode {
 		n += 2
 	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
 	return n
 }
 
@@ -1999,53 +2549,144 @@ func (m *UpdateInstantiateConfigProposal) Size() (n int) {
 	return n
 }
 
-func sovProposal(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozProposal(x uint64) (n int) {
-	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SetContractStorageQuotaProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if m.MaxContractBytes != 0 {
+		n += 1 + sovProposal(uint64(m.MaxContractBytes))
+	}
+	return n
 }
 
-func (m *StoreCodeProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProposal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StoreCodeProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreCodeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
+func (m *StoreAndInstantiateContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.UnpinCode {
+		n += 2
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *StoreCodeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreCodeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreCodeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
@@ -2222,6 +2863,104 @@ func (m *StoreCodeProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UnpinCode = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -4130,6 +4869,657 @@ func (m *UpdateInstantiateConfigProposal) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *SetContractStorageQuotaProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetContractStorageQuotaProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetContractStorageQuotaProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractBytes", wireType)
+			}
+			m.MaxContractBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreAndInstantiateContractProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreAndInstantiateContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpinCode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnpinCode = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -149,6 +149,19 @@ func TestValidateStoreCodeProposal(t *testing.T) {
 			}),
 			expErr: true,
 		},
+		"with verification info": {
+			src: StoreCodeProposalFixture(func(p *StoreCodeProposal) {
+				p.Source = "https://example.com/"
+				p.Builder = "cosmwasm/workspace-optimizer:v0.12.9"
+				p.CodeHash = bytes.Repeat([]byte{0x1}, 32)
+			}),
+		},
+		"with incomplete verification info": {
+			src: StoreCodeProposalFixture(func(p *StoreCodeProposal) {
+				p.Source = "https://example.com/"
+			}),
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -520,6 +533,166 @@ func TestValidateClearAdminProposal(t *testing.T) {
 	}
 }
 
+func TestValidateSetContractStorageQuotaProposal(t *testing.T) {
+	invalidAddress := "invalid address"
+
+	specs := map[string]struct {
+		src    *SetContractStorageQuotaProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SetContractStorageQuotaProposalFixture(),
+		},
+		"without limit": {
+			src: SetContractStorageQuotaProposalFixture(func(p *SetContractStorageQuotaProposal) {
+				p.MaxContractBytes = 0
+			}),
+		},
+		"base data missing": {
+			src: SetContractStorageQuotaProposalFixture(func(p *SetContractStorageQuotaProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contracts missing": {
+			src: SetContractStorageQuotaProposalFixture(func(p *SetContractStorageQuotaProposal) {
+				p.Contracts = nil
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: SetContractStorageQuotaProposalFixture(func(p *SetContractStorageQuotaProposal) {
+				p.Contracts = []string{invalidAddress}
+			}),
+			expErr: true,
+		},
+		"duplicate contracts": {
+			src: SetContractStorageQuotaProposalFixture(func(p *SetContractStorageQuotaProposal) {
+				p.Contracts = append(p.Contracts, p.Contracts[0])
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateStoreAndInstantiateContractProposal(t *testing.T) {
+	invalidAddress := "invalid address"
+
+	specs := map[string]struct {
+		src    *StoreAndInstantiateContractProposal
+		expErr bool
+	}{
+		"all good": {
+			src: StoreAndInstantiateContractProposalFixture(),
+		},
+		"with instantiate permission": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.InstantiatePermission = &AllowNobody
+			}),
+		},
+		"without admin": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Admin = ""
+			}),
+		},
+		"base data missing": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"wasm code missing": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.WASMByteCode = nil
+			}),
+			expErr: true,
+		},
+		"with invalid instantiate permission": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.InstantiatePermission = &AccessConfig{}
+			}),
+			expErr: true,
+		},
+		"admin invalid": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Admin = invalidAddress
+			}),
+			expErr: true,
+		},
+		"label empty": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Label = ""
+			}),
+			expErr: true,
+		},
+		"init msg empty": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Msg = nil
+			}),
+			expErr: true,
+		},
+		"init funds negative": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Funds = sdk.Coins{{Denom: "foo", Amount: sdk.NewInt(-1)}}
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateParamsProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *UpdateParamsProposal
+		expErr bool
+	}{
+		"all good": {
+			src: UpdateParamsProposalFixture(),
+		},
+		"base data missing": {
+			src: UpdateParamsProposalFixture(func(p *UpdateParamsProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"invalid params": {
+			src: UpdateParamsProposalFixture(func(p *UpdateParamsProposal) {
+				p.Params.CodeUploadAccess = AccessConfig{}
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestProposalStrings(t *testing.T) {
 	specs := map[string]struct {
 		src govtypes.Content
@@ -534,6 +707,26 @@ func TestProposalStrings(t *testing.T) {
   Description: Bar
   Run as:      link1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsh9tp23
   WasmCode:    0102030405060708090A
+  Source:      
+  Builder:     
+  Code Hash:   
+`,
+		},
+		"store code with verification info": {
+			src: StoreCodeProposalFixture(func(p *StoreCodeProposal) {
+				p.WASMByteCode = []byte{0o1, 0o2, 0o3, 0o4, 0o5, 0o6, 0o7, 0x08, 0x09, 0x0a}
+				p.Source = "https://example.com/"
+				p.Builder = "cosmwasm/workspace-optimizer:v0.12.9"
+				p.CodeHash = []byte{0x1, 0x2}
+			}),
+			exp: `Store Code Proposal:
+  Title:       Foo
+  Description: Bar
+  Run as:      link1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsh9tp23
+  WasmCode:    0102030405060708090A
+  Source:      https://example.com/
+  Builder:     cosmwasm/workspace-optimizer:v0.12.9
+  Code Hash:   0102
 `,
 		},
 		"instantiate contract": {
@@ -626,6 +819,15 @@ func TestProposalStrings(t *testing.T) {
   Title:       Foo
   Description: Bar
   Codes:       [3 2 1]
+`,
+		},
+		"set contract storage quota": {
+			src: SetContractStorageQuotaProposalFixture(),
+			exp: `Set Contract Storage Quota Proposal:
+  Title:            Foo
+  Description:      Bar
+  Contracts:        [link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8]
+  MaxContractBytes: 1024
 `,
 		},
 	}
@@ -650,6 +852,26 @@ description: Bar
 run_as: link1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsh9tp23
 wasm_byte_code: AQIDBAUGBwgJCg==
 instantiate_permission: null
+source: ""
+builder: ""
+code_hash: ""
+`,
+		},
+		"store code with verification info": {
+			src: StoreCodeProposalFixture(func(p *StoreCodeProposal) {
+				p.WASMByteCode = []byte{0o1, 0o2, 0o3, 0o4, 0o5, 0o6, 0o7, 0x08, 0x09, 0x0a}
+				p.Source = "https://example.com/"
+				p.Builder = "cosmwasm/workspace-optimizer:v0.12.9"
+				p.CodeHash = []byte{0x1, 0x2}
+			}),
+			exp: `title: Foo
+description: Bar
+run_as: link1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsh9tp23
+wasm_byte_code: AQIDBAUGBwgJCg==
+instantiate_permission: null
+source: https://example.com/
+builder: cosmwasm/workspace-optimizer:v0.12.9
+code_hash: "0102"
 `,
 		},
 		"instantiate contract": {
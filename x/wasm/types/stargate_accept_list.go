@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+// nonDeterministicStargateQueryPaths are the query paths that reset the gas meter with a node-local gas limit.
+// They must not be executed by contracts.
+var nonDeterministicStargateQueryPaths = []string{
+	"/cosmwasm.wasm.v1.Query/SmartContractState",
+	"/cosmwasm.wasm.v1.Query/BatchSmartContractState",
+	"/cosmwasm.wasm.v1.Query/SimulateExecuteContract",
+}
+
+// StargateQueryResponse is implemented by the response types of the stargate queries in the accept list of the
+// params. The response types are resolved by the interface registry, see RegisterStargateQueryResponses.
+type StargateQueryResponse interface {
+	proto.Message
+}
+
+// RegisterStargateQueryResponses registers the response types so that they can be used in the stargate query
+// accept list of the params.
+func RegisterStargateQueryResponses(registry codectypes.InterfaceRegistry, responses ...proto.Message) {
+	registry.RegisterImplementations((*StargateQueryResponse)(nil), responses...)
+}
+
+// DefaultStargateQueryAcceptList returns an empty accept list that rejects all stargate queries
+func DefaultStargateQueryAcceptList() []AcceptedStargateQuery {
+	return nil
+}
+
+// ValidateBasic validates the path and the response type url
+func (q AcceptedStargateQuery) ValidateBasic() error {
+	if !strings.HasPrefix(q.Path, "/") || len(q.Path) == 1 {
+		return sdkerrors.Wrapf(ErrInvalid, "path %q must start with '/'", q.Path)
+	}
+	if !strings.HasPrefix(q.ResponseTypeURL, "/") || len(q.ResponseTypeURL) == 1 {
+		return sdkerrors.Wrapf(ErrInvalid, "response type url %q must start with '/'", q.ResponseTypeURL)
+	}
+	for _, p := range nonDeterministicStargateQueryPaths {
+		if q.Path == p {
+			return sdkerrors.Wrapf(ErrInvalid, "path %q is not deterministic", q.Path)
+		}
+	}
+	return nil
+}
+
+// FindAcceptedStargateQuery returns the entry of the accept list for the path
+func FindAcceptedStargateQuery(acceptList []AcceptedStargateQuery, path string) (AcceptedStargateQuery, bool) {
+	for _, q := range acceptList {
+		if q.Path == path {
+			return q, true
+		}
+	}
+	return AcceptedStargateQuery{}, false
+}
+
+func validateStargateQueryAcceptList(i interface{}) error {
+	acceptList, ok := i.([]AcceptedStargateQuery)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	dedup := make(map[string]bool, len(acceptList))
+	for _, q := range acceptList {
+		if err := q.ValidateBasic(); err != nil {
+			return err
+		}
+		if dedup[q.Path] {
+			return sdkerrors.Wrapf(ErrDuplicate, "duplicate path: %s", q.Path)
+		}
+		dedup[q.Path] = true
+	}
+	return nil
+}
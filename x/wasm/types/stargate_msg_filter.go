@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+// DefaultMaxStargateMsgSize is the max size in bytes of a stargate message dispatched by a contract
+// when no other limit is configured
+const DefaultMaxStargateMsgSize = 128 * 1024
+
+// DefaultStargateMsgFilter returns the empty filter of the params so that the filter of the app wiring is used
+func DefaultStargateMsgFilter() StargateMsgFilter {
+	return StargateMsgFilter{}
+}
+
+// IsEmpty returns true when no restriction is set
+func (f StargateMsgFilter) IsEmpty() bool {
+	return len(f.AcceptList) == 0 && len(f.DenyList) == 0 && f.MaxMsgSize == 0
+}
+
+// ValidateBasic validates the type urls of the filter
+func (f StargateMsgFilter) ValidateBasic() error {
+	if len(f.AcceptList) != 0 && len(f.DenyList) != 0 {
+		return sdkerrors.Wrap(ErrInvalid, "either accept list or deny list can be set")
+	}
+	if err := validateMsgTypeURLs(f.AcceptList); err != nil {
+		return sdkerrors.Wrap(err, "accept list")
+	}
+	if err := validateMsgTypeURLs(f.DenyList); err != nil {
+		return sdkerrors.Wrap(err, "deny list")
+	}
+	return nil
+}
+
+// Accept returns an error when the message with the type url and the size in bytes is not allowed
+func (f StargateMsgFilter) Accept(typeURL string, msgSize int) error {
+	if f.MaxMsgSize != 0 && uint64(msgSize) > f.MaxMsgSize {
+		return sdkerrors.Wrapf(ErrStargateMsgNotAllowed, "message size %d exceeds limit %d", msgSize, f.MaxMsgSize)
+	}
+	if len(f.AcceptList) != 0 && !containsString(f.AcceptList, typeURL) {
+		return sdkerrors.Wrapf(ErrStargateMsgNotAllowed, "%s is not in accept list", typeURL)
+	}
+	if containsString(f.DenyList, typeURL) {
+		return sdkerrors.Wrapf(ErrStargateMsgNotAllowed, "%s is in deny list", typeURL)
+	}
+	return nil
+}
+
+func validateMsgTypeURLs(typeURLs []string) error {
+	dedup := make(map[string]bool, len(typeURLs))
+	for _, u := range typeURLs {
+		if !strings.HasPrefix(u, "/") || len(u) == 1 {
+			return sdkerrors.Wrapf(ErrInvalid, "type url %q must start with '/'", u)
+		}
+		if dedup[u] {
+			return sdkerrors.Wrapf(ErrDuplicate, "duplicate type url: %s", u)
+		}
+		dedup[u] = true
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func validateStargateMsgFilter(i interface{}) error {
+	f, ok := i.(StargateMsgFilter)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return f.ValidateBasic()
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+// DefaultStorageQuotaParams returns the storage quota params without any limit
+func DefaultStorageQuotaParams() StorageQuotaParams {
+	return StorageQuotaParams{}
+}
+
+// MaxContractBytesFor returns the maximum state size of the contracts of the given code id, 0 for no limit
+func (p StorageQuotaParams) MaxContractBytesFor(codeID uint64) uint64 {
+	for _, o := range p.CodeOverrides {
+		if o.CodeID == codeID {
+			return o.MaxContractBytes
+		}
+	}
+	return p.MaxContractBytes
+}
+
+// ValidateBasic validates the storage quota params
+func (p StorageQuotaParams) ValidateBasic() error {
+	dedup := make(map[uint64]bool)
+	for _, o := range p.CodeOverrides {
+		if o.CodeID == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "code id")
+		}
+		if dedup[o.CodeID] {
+			return sdkerrors.Wrapf(ErrDuplicate, "duplicate code: %d", o.CodeID)
+		}
+		dedup[o.CodeID] = true
+	}
+	return nil
+}
+
+func validateStorageQuotaParams(i interface{}) error {
+	p, ok := i.(StorageQuotaParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return p.ValidateBasic()
+}
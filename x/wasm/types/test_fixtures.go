@@ -329,3 +329,46 @@ func ClearAdminProposalFixture(mutators ...func(p *ClearAdminProposal)) *ClearAd
 	}
 	return p
 }
+
+func SetContractStorageQuotaProposalFixture(mutators ...func(p *SetContractStorageQuotaProposal)) *SetContractStorageQuotaProposal {
+	const contractAddr = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
+	p := &SetContractStorageQuotaProposal{
+		Title:            "Foo",
+		Description:      "Bar",
+		Contracts:        []string{contractAddr},
+		MaxContractBytes: 1024,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func StoreAndInstantiateContractProposalFixture(mutators ...func(p *StoreAndInstantiateContractProposal)) *StoreAndInstantiateContractProposal {
+	const anyAddress = "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsh9tp23"
+	p := &StoreAndInstantiateContractProposal{
+		Title:        "Foo",
+		Description:  "Bar",
+		WASMByteCode: []byte{0x0},
+		Admin:        anyAddress,
+		Label:        "testing",
+		Msg:          []byte(`{"foo":"bar"}`),
+		Funds:        nil,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func UpdateParamsProposalFixture(mutators ...func(p *UpdateParamsProposal)) *UpdateParamsProposal {
+	p := &UpdateParamsProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Params:      DefaultParams(),
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// Tracer records the frames of the contract call tree
+type Tracer interface {
+	// BeginFrame is called before a contract entry point or a sub message is executed
+	BeginFrame(frame TraceFrame)
+	// EndFrame is called when the last begun frame completed, with the gas consumed and the unredacted error
+	EndFrame(gasAfter uint64, err error)
+}
+
+// WithTracer stores a tracer in the context
+func WithTracer(ctx sdk.Context, tracer Tracer) sdk.Context {
+	return ctx.WithValue(contextKeyTracer, tracer)
+}
+
+// TracerFromContext returns the tracer and found bool from the context
+func TracerFromContext(ctx sdk.Context) (Tracer, bool) {
+	val, ok := ctx.Value(contextKeyTracer).(Tracer)
+	return val, ok
+}
+
+var _ Tracer = &CallTreeTracer{}
+
+// CallTreeTracer records the frames as a tree
+type CallTreeTracer struct {
+	frames []*TraceFrame
+	stack  []*TraceFrame
+}
+
+// NewCallTreeTracer constructor
+func NewCallTreeTracer() *CallTreeTracer {
+	return &CallTreeTracer{}
+}
+
+// BeginFrame adds the frame to the children of the current frame
+func (t *CallTreeTracer) BeginFrame(frame TraceFrame) {
+	f := &frame
+	if len(t.stack) == 0 {
+		t.frames = append(t.frames, f)
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Children = append(parent.Children, f)
+	}
+	t.stack = append(t.stack, f)
+}
+
+// EndFrame completes the current frame
+func (t *CallTreeTracer) EndFrame(gasAfter uint64, err error) {
+	if len(t.stack) == 0 {
+		return
+	}
+	f := t.stack[len(t.stack)-1]
+	f.GasAfter = gasAfter
+	if err != nil {
+		f.Error = err.Error()
+	}
+	t.stack = t.stack[:len(t.stack)-1]
+}
+
+// Frames returns the recorded root frames
+func (t CallTreeTracer) Frames() []*TraceFrame {
+	return t.frames
+}
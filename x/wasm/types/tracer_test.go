@@ -0,0 +1,56 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+func TestCallTreeTracer(t *testing.T) {
+	tracer := NewCallTreeTracer()
+
+	tracer.BeginFrame(TraceFrame{EntryPoint: "execute", Contract: "first", GasBefore: 1})
+	tracer.BeginFrame(TraceFrame{EntryPoint: "submsg", SubMsgID: 1, ReplyOn: "error", GasBefore: 2})
+	tracer.BeginFrame(TraceFrame{EntryPoint: "execute", Contract: "second", GasBefore: 3})
+	tracer.EndFrame(4, errors.New("testing"))
+	tracer.EndFrame(5, errors.New("testing"))
+	tracer.BeginFrame(TraceFrame{EntryPoint: "reply", Contract: "first", GasBefore: 6})
+	tracer.EndFrame(7, nil)
+	tracer.EndFrame(8, nil)
+	tracer.BeginFrame(TraceFrame{EntryPoint: "query", Contract: "third", GasBefore: 9})
+	tracer.EndFrame(10, nil)
+	// unbalanced end is ignored
+	tracer.EndFrame(11, errors.New("testing"))
+
+	exp := []*TraceFrame{
+		{
+			EntryPoint: "execute", Contract: "first", GasBefore: 1, GasAfter: 8,
+			Children: []*TraceFrame{
+				{
+					EntryPoint: "submsg", SubMsgID: 1, ReplyOn: "error", GasBefore: 2, GasAfter: 5, Error: "testing",
+					Children: []*TraceFrame{
+						{EntryPoint: "execute", Contract: "second", GasBefore: 3, GasAfter: 4, Error: "testing"},
+					},
+				},
+				{EntryPoint: "reply", Contract: "first", GasBefore: 6, GasAfter: 7},
+			},
+		},
+		{EntryPoint: "query", Contract: "third", GasBefore: 9, GasAfter: 10},
+	}
+	assert.Equal(t, exp, tracer.Frames())
+}
+
+func TestTracerFromContext(t *testing.T) {
+	_, ok := TracerFromContext(sdk.Context{}.WithContext(context.Background()))
+	assert.False(t, ok)
+
+	ctx := WithTracer(sdk.Context{}.WithContext(context.Background()), NewCallTreeTracer())
+	got, ok := TracerFromContext(ctx)
+	require.True(t, ok)
+	assert.NotNil(t, got)
+}
@@ -51,8 +51,12 @@ func TestStoreCodeValidation(t *testing.T) {
 		},
 		"correct maximal": {
 			msg: MsgStoreCode{
-				Sender:       goodAddress,
-				WASMByteCode: []byte("foo"),
+				Sender:                goodAddress,
+				WASMByteCode:          []byte("foo"),
+				InstantiatePermission: &AllowEverybody,
+				Source:                "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom",
+				Builder:               "cosmwasm/rust-optimizer:0.12.6",
+				CodeHash:              bytes.Repeat([]byte{0x1}, 32),
 			},
 			valid: true,
 		},
@@ -64,6 +68,73 @@ func TestStoreCodeValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"source without https": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "http://github.com/CosmWasm/cosmwasm",
+				Builder:      "cosmwasm/rust-optimizer:0.12.6",
+				CodeHash:     bytes.Repeat([]byte{0x1}, 32),
+			},
+			valid: false,
+		},
+		"relative source": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "/CosmWasm/cosmwasm",
+				Builder:      "cosmwasm/rust-optimizer:0.12.6",
+				CodeHash:     bytes.Repeat([]byte{0x1}, 32),
+			},
+			valid: false,
+		},
+		"builder without tag": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "https://github.com/CosmWasm/cosmwasm",
+				Builder:      "cosmwasm/rust-optimizer",
+				CodeHash:     bytes.Repeat([]byte{0x1}, 32),
+			},
+			valid: false,
+		},
+		"builder too long": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "https://github.com/CosmWasm/cosmwasm",
+				Builder:      "cosmwasm/" + strings.Repeat("a", MaxBuilderSize) + ":0.12.6",
+				CodeHash:     bytes.Repeat([]byte{0x1}, 32),
+			},
+			valid: false,
+		},
+		"code hash missing": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "https://github.com/CosmWasm/cosmwasm",
+				Builder:      "cosmwasm/rust-optimizer:0.12.6",
+			},
+			valid: false,
+		},
+		"code hash only": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				CodeHash:     bytes.Repeat([]byte{0x1}, 32),
+			},
+			valid: false,
+		},
+		"code hash invalid length": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "https://github.com/CosmWasm/cosmwasm",
+				Builder:      "cosmwasm/rust-optimizer:0.12.6",
+				CodeHash:     bytes.Repeat([]byte{0x1}, 31),
+			},
+			valid: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -692,6 +763,26 @@ func TestMsgJsonSignBytes(t *testing.T) {
 {
 	"type":"wasm/MsgClearAdmin",
 	"value":{"contract":"contract_address","sender":"sender"}
+}`,
+		},
+		"MsgUpdateInstantiateConfig": {
+			src: &MsgUpdateInstantiateConfig{
+				Sender:                   "sender",
+				CodeID:                   1,
+				NewInstantiatePermission: &AllowNobody,
+			},
+			exp: `
+{
+	"type":"wasm/MsgUpdateInstantiateConfig",
+	"value":{"code_id":"1","new_instantiate_permission":{"permission":"Nobody"},"sender":"sender"}
+}`,
+		},
+		"MsgRemoveCode": {
+			src: &MsgRemoveCode{Sender: "sender", CodeID: 1},
+			exp: `
+{
+	"type":"wasm/MsgRemoveCode",
+	"value":{"code_id":"1","sender":"sender"}
 }`,
 		},
 		"MsgIBCSend": {
@@ -710,3 +801,396 @@ func TestMsgJsonSignBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateParams
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateParams{
+				Authority: goodAddress,
+				Params:    DefaultParams(),
+			},
+		},
+		"bad authority": {
+			src: MsgUpdateParams{
+				Authority: badAddress,
+				Params:    DefaultParams(),
+			},
+			expErr: true,
+		},
+		"empty authority": {
+			src: MsgUpdateParams{
+				Params: DefaultParams(),
+			},
+			expErr: true,
+		},
+		"invalid params": {
+			src: MsgUpdateParams{
+				Authority: goodAddress,
+				Params:    Params{},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSudoContractValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgSudoContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSudoContract{
+				Authority: goodAddress,
+				Contract:  anotherGoodAddress,
+				Msg:       []byte(`{"foo": "bar"}`),
+			},
+		},
+		"bad authority": {
+			src: MsgSudoContract{
+				Authority: badAddress,
+				Contract:  anotherGoodAddress,
+				Msg:       []byte(`{"foo": "bar"}`),
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgSudoContract{
+				Authority: goodAddress,
+				Contract:  badAddress,
+				Msg:       []byte(`{"foo": "bar"}`),
+			},
+			expErr: true,
+		},
+		"empty msg": {
+			src: MsgSudoContract{
+				Authority: goodAddress,
+				Contract:  anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"non json msg": {
+			src: MsgSudoContract{
+				Authority: goodAddress,
+				Contract:  anotherGoodAddress,
+				Msg:       []byte("invalid json"),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgPinCodesValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgPinCodes
+		expErr bool
+	}{
+		"all good": {
+			src: MsgPinCodes{
+				Authority: goodAddress,
+				CodeIDs:   []uint64{1},
+			},
+		},
+		"bad authority": {
+			src: MsgPinCodes{
+				Authority: badAddress,
+				CodeIDs:   []uint64{1},
+			},
+			expErr: true,
+		},
+		"empty code ids": {
+			src: MsgPinCodes{
+				Authority: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnpinCodesValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUnpinCodes
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUnpinCodes{
+				Authority: goodAddress,
+				CodeIDs:   []uint64{1},
+			},
+		},
+		"bad authority": {
+			src: MsgUnpinCodes{
+				Authority: badAddress,
+				CodeIDs:   []uint64{1},
+			},
+			expErr: true,
+		},
+		"empty code ids": {
+			src: MsgUnpinCodes{
+				Authority: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgStoreAndInstantiateContractValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgStoreAndInstantiateContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgStoreAndInstantiateContract{
+				Authority:    goodAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				Msg:          []byte(`{"some": "data"}`),
+			},
+		},
+		"with admin, funds and permission": {
+			src: MsgStoreAndInstantiateContract{
+				Authority:             goodAddress,
+				WASMByteCode:          []byte("foo"),
+				InstantiatePermission: &AllowEverybody,
+				Admin:                 goodAddress,
+				Label:                 "foo",
+				Msg:                   []byte(`{"some": "data"}`),
+				Funds:                 sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+			},
+		},
+		"bad authority": {
+			src: MsgStoreAndInstantiateContract{
+				Authority:    badAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				Msg:          []byte(`{"some": "data"}`),
+			},
+			expErr: true,
+		},
+		"bad admin": {
+			src: MsgStoreAndInstantiateContract{
+				Authority:    goodAddress,
+				WASMByteCode: []byte("foo"),
+				Admin:        badAddress,
+				Label:        "foo",
+				Msg:          []byte(`{"some": "data"}`),
+			},
+			expErr: true,
+		},
+		"empty wasm code": {
+			src: MsgStoreAndInstantiateContract{
+				Authority: goodAddress,
+				Label:     "foo",
+				Msg:       []byte(`{"some": "data"}`),
+			},
+			expErr: true,
+		},
+		"empty label": {
+			src: MsgStoreAndInstantiateContract{
+				Authority:    goodAddress,
+				WASMByteCode: []byte("foo"),
+				Msg:          []byte(`{"some": "data"}`),
+			},
+			expErr: true,
+		},
+		"invalid instantiate permission": {
+			src: MsgStoreAndInstantiateContract{
+				Authority:             goodAddress,
+				WASMByteCode:          []byte("foo"),
+				InstantiatePermission: &AccessConfig{Permission: AccessTypeOnlyAddress, Address: badAddress},
+				Label:                 "foo",
+				Msg:                   []byte(`{"some": "data"}`),
+			},
+			expErr: true,
+		},
+		"non json init msg": {
+			src: MsgStoreAndInstantiateContract{
+				Authority:    goodAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				Msg:          []byte("invalid-json"),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateInstantiateConfig(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateInstantiateConfig
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   goodAddress,
+				CodeID:                   1,
+				NewInstantiatePermission: &AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anotherGoodAddress}},
+			},
+		},
+		"bad sender": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   badAddress,
+				CodeID:                   1,
+				NewInstantiatePermission: &AllowNobody,
+			},
+			expErr: true,
+		},
+		"code id missing": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   goodAddress,
+				NewInstantiatePermission: &AllowNobody,
+			},
+			expErr: true,
+		},
+		"permission missing": {
+			src: MsgUpdateInstantiateConfig{
+				Sender: goodAddress,
+				CodeID: 1,
+			},
+			expErr: true,
+		},
+		"invalid permission": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   goodAddress,
+				CodeID:                   1,
+				NewInstantiatePermission: &AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{badAddress}},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveCode(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgRemoveCode
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRemoveCode{Sender: goodAddress, CodeID: 1},
+		},
+		"bad sender": {
+			src:    MsgRemoveCode{Sender: badAddress, CodeID: 1},
+			expErr: true,
+		},
+		"code id missing": {
+			src:    MsgRemoveCode{Sender: goodAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
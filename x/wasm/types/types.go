@@ -40,6 +40,11 @@ func (c CodeInfo) ValidateBasic() error {
 	if err := c.InstantiateConfig.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "instantiate config")
 	}
+	if c.Source != "" || c.Builder != "" {
+		if err := ValidateVerificationInfo(c.Source, c.Builder, c.CodeHash); err != nil {
+			return sdkerrors.Wrap(err, "code verification info")
+		}
+	}
 	return nil
 }
 
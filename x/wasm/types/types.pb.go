@@ -198,6 +198,19 @@ var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
 type Params struct {
 	CodeUploadAccess             AccessConfig `protobuf:"bytes,1,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
 	InstantiateDefaultPermission AccessType   `protobuf:"varint,2,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
+	// GasRegister are the costs charged for the wasm operations
+	GasRegister GasRegisterParams `protobuf:"bytes,3,opt,name=gas_register,json=gasRegister,proto3" json:"gas_register" yaml:"gas_register"`
+	// StorageQuota limits the state size of the contracts
+	StorageQuota StorageQuotaParams `protobuf:"bytes,4,opt,name=storage_quota,json=storageQuota,proto3" json:"storage_quota" yaml:"storage_quota"`
+	// StargateQueryAcceptList are the stargate queries that contracts are allowed
+	// to execute. The response types must be registered in the interface
+	// registry of the app. The wasm smart, batch smart and simulation queries
+	// are not allowed.
+	StargateQueryAcceptList []AcceptedStargateQuery `protobuf:"bytes,5,rep,name=stargate_query_accept_list,json=stargateQueryAcceptList,proto3" json:"stargate_query_accept_list" yaml:"stargate_query_accept_list"`
+	// StargateMsgFilter restricts the stargate messages that contracts can
+	// dispatch. A message must be accepted by this filter and by the filter of
+	// the app wiring. A max size set here replaces the one of the app wiring.
+	StargateMsgFilter StargateMsgFilter `protobuf:"bytes,6,opt,name=stargate_msg_filter,json=stargateMsgFilter,proto3" json:"stargate_msg_filter" yaml:"stargate_msg_filter"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -237,6 +250,268 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// StargateMsgFilter restricts the stargate messages that contracts can
+// dispatch. Either an accept list or a deny list of message type urls can be
+// set.
+type StargateMsgFilter struct {
+	// AcceptList are the only message type urls that are accepted when not empty
+	AcceptList []string `protobuf:"bytes,1,rep,name=accept_list,json=acceptList,proto3" json:"accept_list,omitempty" yaml:"accept_list"`
+	// DenyList are the message type urls that are rejected
+	DenyList []string `protobuf:"bytes,2,rep,name=deny_list,json=denyList,proto3" json:"deny_list,omitempty" yaml:"deny_list"`
+	// MaxMsgSize is the max size in bytes of a message. 0 means no limit.
+	MaxMsgSize uint64 `protobuf:"varint,3,opt,name=max_msg_size,json=maxMsgSize,proto3" json:"max_msg_size,omitempty" yaml:"max_msg_size"`
+}
+
+func (m *StargateMsgFilter) Reset()         { *m = StargateMsgFilter{} }
+func (m *StargateMsgFilter) String() string { return proto.CompactTextString(m) }
+func (*StargateMsgFilter) ProtoMessage()    {}
+func (*StargateMsgFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{3}
+}
+
+func (m *StargateMsgFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *StargateMsgFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StargateMsgFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *StargateMsgFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StargateMsgFilter.Merge(m, src)
+}
+
+func (m *StargateMsgFilter) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *StargateMsgFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_StargateMsgFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StargateMsgFilter proto.InternalMessageInfo
+
+// AcceptedStargateQuery is a gRPC query path that contracts are allowed to
+// execute via stargate queries
+type AcceptedStargateQuery struct {
+	// Path is the gRPC method, e.g. "/cosmos.auth.v1beta1.Query/Account"
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty" yaml:"path"`
+	// ResponseTypeURL is the type url of the response, e.g.
+	// "/cosmos.auth.v1beta1.QueryAccountResponse"
+	ResponseTypeURL string `protobuf:"bytes,2,opt,name=response_type_url,json=responseTypeUrl,proto3" json:"response_type_url,omitempty" yaml:"response_type_url"`
+}
+
+func (m *AcceptedStargateQuery) Reset()         { *m = AcceptedStargateQuery{} }
+func (m *AcceptedStargateQuery) String() string { return proto.CompactTextString(m) }
+func (*AcceptedStargateQuery) ProtoMessage()    {}
+func (*AcceptedStargateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{4}
+}
+
+func (m *AcceptedStargateQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AcceptedStargateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedStargateQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AcceptedStargateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedStargateQuery.Merge(m, src)
+}
+
+func (m *AcceptedStargateQuery) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AcceptedStargateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedStargateQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedStargateQuery proto.InternalMessageInfo
+
+// GasRegisterParams defines the costs charged by the wasm gas register. All
+// costs are in SDK gas.
+type GasRegisterParams struct {
+	// InstanceCost is charged when interacting with a wasm contract that is not
+	// pinned
+	InstanceCost uint64 `protobuf:"varint,1,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty" yaml:"instance_cost"`
+	// CompileCost is charged per byte to persist and "compile" a new wasm
+	// contract
+	CompileCost uint64 `protobuf:"varint,2,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty" yaml:"compile_cost"`
+	// UncompressCostNumerator is the numerator of the cost charged per byte to
+	// unpack a new wasm contract
+	UncompressCostNumerator uint64 `protobuf:"varint,3,opt,name=uncompress_cost_numerator,json=uncompressCostNumerator,proto3" json:"uncompress_cost_numerator,omitempty" yaml:"uncompress_cost_numerator"`
+	// UncompressCostDenominator is the denominator of the cost charged per byte
+	// to unpack a new wasm contract
+	UncompressCostDenominator uint64 `protobuf:"varint,4,opt,name=uncompress_cost_denominator,json=uncompressCostDenominator,proto3" json:"uncompress_cost_denominator,omitempty" yaml:"uncompress_cost_denominator"`
+	// GasMultiplier is how many cosmwasm gas points = 1 sdk gas point
+	GasMultiplier uint64 `protobuf:"varint,5,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty" yaml:"gas_multiplier"`
+	// EventPerAttributeCost is charged per attribute count of events
+	EventPerAttributeCost uint64 `protobuf:"varint,6,opt,name=event_per_attribute_cost,json=eventPerAttributeCost,proto3" json:"event_per_attribute_cost,omitempty" yaml:"event_per_attribute_cost"`
+	// EventAttributeDataCost is charged per byte for attribute data in events
+	EventAttributeDataCost uint64 `protobuf:"varint,7,opt,name=event_attribute_data_cost,json=eventAttributeDataCost,proto3" json:"event_attribute_data_cost,omitempty" yaml:"event_attribute_data_cost"`
+	// EventAttributeDataFreeTier is the number of bytes of total attribute data
+	// that is free of charge
+	EventAttributeDataFreeTier uint64 `protobuf:"varint,8,opt,name=event_attribute_data_free_tier,json=eventAttributeDataFreeTier,proto3" json:"event_attribute_data_free_tier,omitempty" yaml:"event_attribute_data_free_tier"`
+	// ContractMessageDataCost is charged per byte of the message that goes to
+	// the contract
+	ContractMessageDataCost uint64 `protobuf:"varint,9,opt,name=contract_message_data_cost,json=contractMessageDataCost,proto3" json:"contract_message_data_cost,omitempty" yaml:"contract_message_data_cost"`
+	// CustomEventCost is charged per custom event
+	CustomEventCost uint64 `protobuf:"varint,10,opt,name=custom_event_cost,json=customEventCost,proto3" json:"custom_event_cost,omitempty" yaml:"custom_event_cost"`
+}
+
+func (m *GasRegisterParams) Reset()         { *m = GasRegisterParams{} }
+func (m *GasRegisterParams) String() string { return proto.CompactTextString(m) }
+func (*GasRegisterParams) ProtoMessage()    {}
+func (*GasRegisterParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{5}
+}
+
+func (m *GasRegisterParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *GasRegisterParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasRegisterParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *GasRegisterParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRegisterParams.Merge(m, src)
+}
+
+func (m *GasRegisterParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *GasRegisterParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRegisterParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasRegisterParams proto.InternalMessageInfo
+
+// StorageQuotaParams defines the maximum state size of the contracts. The state
+// size is the sum of the key and value lengths of all entries of a contract.
+type StorageQuotaParams struct {
+	// MaxContractBytes is the maximum state size of a contract in bytes, 0 for no
+	// limit
+	MaxContractBytes uint64 `protobuf:"varint,1,opt,name=max_contract_bytes,json=maxContractBytes,proto3" json:"max_contract_bytes,omitempty" yaml:"max_contract_bytes"`
+	// CodeOverrides set the maximum state size for the contracts of a code id
+	CodeOverrides []CodeStorageQuota `protobuf:"bytes,2,rep,name=code_overrides,json=codeOverrides,proto3" json:"code_overrides" yaml:"code_overrides"`
+}
+
+func (m *StorageQuotaParams) Reset()         { *m = StorageQuotaParams{} }
+func (m *StorageQuotaParams) String() string { return proto.CompactTextString(m) }
+func (*StorageQuotaParams) ProtoMessage()    {}
+func (*StorageQuotaParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{6}
+}
+
+func (m *StorageQuotaParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *StorageQuotaParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageQuotaParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *StorageQuotaParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageQuotaParams.Merge(m, src)
+}
+
+func (m *StorageQuotaParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *StorageQuotaParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageQuotaParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageQuotaParams proto.InternalMessageInfo
+
+// CodeStorageQuota is the maximum state size of the contracts of a code id
+type CodeStorageQuota struct {
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	// MaxContractBytes is the maximum state size of a contract in bytes, 0 for no
+	// limit
+	MaxContractBytes uint64 `protobuf:"varint,2,opt,name=max_contract_bytes,json=maxContractBytes,proto3" json:"max_contract_bytes,omitempty" yaml:"max_contract_bytes"`
+}
+
+func (m *CodeStorageQuota) Reset()         { *m = CodeStorageQuota{} }
+func (m *CodeStorageQuota) String() string { return proto.CompactTextString(m) }
+func (*CodeStorageQuota) ProtoMessage()    {}
+func (*CodeStorageQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{7}
+}
+
+func (m *CodeStorageQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeStorageQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeStorageQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *CodeStorageQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeStorageQuota.Merge(m, src)
+}
+
+func (m *CodeStorageQuota) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CodeStorageQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeStorageQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeStorageQuota proto.InternalMessageInfo
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	// CodeHash is the unique identifier created by wasmvm
@@ -245,13 +520,18 @@ type CodeInfo struct {
 	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 	// InstantiateConfig access control to apply on contract creation, optional
 	InstantiateConfig AccessConfig `protobuf:"bytes,5,opt,name=instantiate_config,json=instantiateConfig,proto3" json:"instantiate_config"`
+	// Source is the URL where the code is hosted, optional
+	Source string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	// Builder is the docker image used to build the code deterministically,
+	// optional
+	Builder string `protobuf:"bytes,7,opt,name=builder,proto3" json:"builder,omitempty"`
 }
 
 func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{3}
+	return fileDescriptor_e6155d98fa173e02, []int{8}
 }
 
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
@@ -309,7 +589,7 @@ func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{4}
+	return fileDescriptor_e6155d98fa173e02, []int{9}
 }
 
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
@@ -357,7 +637,7 @@ func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEnt
 func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
 func (*ContractCodeHistoryEntry) ProtoMessage()    {}
 func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{5}
+	return fileDescriptor_e6155d98fa173e02, []int{10}
 }
 
 func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
@@ -405,7 +685,7 @@ func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
 func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
 func (*AbsoluteTxPosition) ProtoMessage()    {}
 func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{6}
+	return fileDescriptor_e6155d98fa173e02, []int{11}
 }
 
 func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
@@ -451,7 +731,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{7}
+	return fileDescriptor_e6155d98fa173e02, []int{12}
 }
 
 func (m *Model) XXX_Unmarshal(b []byte) error {
@@ -485,97 +765,247 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
+// ContractStorageUsage is the amount of state that a contract holds in its
+// prefix store
+type ContractStorageUsage struct {
+	// Bytes is the sum of the key and value lengths of all entries
+	Bytes uint64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// Keys is the number of entries
+	Keys uint64 `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *ContractStorageUsage) Reset()         { *m = ContractStorageUsage{} }
+func (m *ContractStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageUsage) ProtoMessage()    {}
+func (*ContractStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{13}
+}
+
+func (m *ContractStorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageUsage.Merge(m, src)
+}
+
+func (m *ContractStorageUsage) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageUsage proto.InternalMessageInfo
+
+// StorageQuota is the maximum state size of a contract that was set by
+// governance. It takes precedence over the params.
+type StorageQuota struct {
+	// MaxContractBytes is the maximum state size in bytes, 0 for no limit
+	MaxContractBytes uint64 `protobuf:"varint,1,opt,name=max_contract_bytes,json=maxContractBytes,proto3" json:"max_contract_bytes,omitempty"`
+}
+
+func (m *StorageQuota) Reset()         { *m = StorageQuota{} }
+func (m *StorageQuota) String() string { return proto.CompactTextString(m) }
+func (*StorageQuota) ProtoMessage()    {}
+func (*StorageQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{14}
+}
+
+func (m *StorageQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *StorageQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *StorageQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageQuota.Merge(m, src)
+}
+
+func (m *StorageQuota) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *StorageQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageQuota proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
 	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
 	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
 	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
+	proto.RegisterType((*StargateMsgFilter)(nil), "cosmwasm.wasm.v1.StargateMsgFilter")
+	proto.RegisterType((*AcceptedStargateQuery)(nil), "cosmwasm.wasm.v1.AcceptedStargateQuery")
+	proto.RegisterType((*GasRegisterParams)(nil), "cosmwasm.wasm.v1.GasRegisterParams")
+	proto.RegisterType((*StorageQuotaParams)(nil), "cosmwasm.wasm.v1.StorageQuotaParams")
+	proto.RegisterType((*CodeStorageQuota)(nil), "cosmwasm.wasm.v1.CodeStorageQuota")
 	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
 	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+	proto.RegisterType((*ContractStorageUsage)(nil), "cosmwasm.wasm.v1.ContractStorageUsage")
+	proto.RegisterType((*StorageQuota)(nil), "cosmwasm.wasm.v1.StorageQuota")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
 
 var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1177 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x93, 0xec, 0x47, 0xa6, 0x4b, 0x71, 0x87, 0x5d, 0x9a, 0x0d, 0xab, 0x24, 0x35, 0x05,
-	0xb6, 0x5f, 0x49, 0xbb, 0x20, 0x90, 0x7a, 0xa8, 0x94, 0x0f, 0x6f, 0xd7, 0x15, 0x1b, 0x47, 0x93,
-	0x14, 0xb4, 0x48, 0x95, 0xe5, 0xd8, 0xb3, 0x89, 0x55, 0xc7, 0x13, 0x79, 0x26, 0xdb, 0xf8, 0x3f,
-	0x40, 0x91, 0x10, 0x1c, 0xb9, 0x44, 0x42, 0x80, 0x50, 0xb9, 0x73, 0xe5, 0x5e, 0xc1, 0xa5, 0x47,
-	0x4e, 0x11, 0x6c, 0x2f, 0x9c, 0x73, 0x2c, 0x17, 0xe4, 0x99, 0xa4, 0x31, 0xdd, 0x6d, 0x37, 0x5c,
-	0xac, 0x99, 0xf7, 0xde, 0xef, 0xf7, 0xde, 0xfb, 0xcd, 0xbc, 0x91, 0xc1, 0x96, 0x45, 0x68, 0xf7,
-	0x91, 0x49, 0xbb, 0x45, 0xfe, 0x39, 0xba, 0x55, 0x64, 0x41, 0x0f, 0xd3, 0x42, 0xcf, 0x27, 0x8c,
-	0x40, 0x79, 0xe6, 0x2d, 0xf0, 0xcf, 0xd1, 0xad, 0xcc, 0x66, 0x68, 0x21, 0xd4, 0xe0, 0xfe, 0xa2,
-	0xd8, 0x88, 0xe0, 0xcc, 0x7a, 0x9b, 0xb4, 0x89, 0xb0, 0x87, 0xab, 0xa9, 0x75, 0xb3, 0x4d, 0x48,
-	0xdb, 0xc5, 0x45, 0xbe, 0x6b, 0xf5, 0x0f, 0x8b, 0xa6, 0x17, 0x08, 0x97, 0xf2, 0x00, 0xbc, 0x59,
-	0xb2, 0x2c, 0x4c, 0x69, 0x33, 0xe8, 0xe1, 0xba, 0xe9, 0x9b, 0x5d, 0x58, 0x05, 0x4b, 0x47, 0xa6,
-	0xdb, 0xc7, 0x69, 0x29, 0x2f, 0x6d, 0x9f, 0xdf, 0xd9, 0x2a, 0xbc, 0x5c, 0x40, 0x61, 0x8e, 0x28,
-	0xcb, 0x93, 0x71, 0x6e, 0x2d, 0x30, 0xbb, 0xee, 0x6d, 0x85, 0x83, 0x14, 0x24, 0xc0, 0xb7, 0x93,
-	0xdf, 0x7e, 0x97, 0x93, 0x94, 0xdf, 0x25, 0xb0, 0x26, 0xa2, 0x2b, 0xc4, 0x3b, 0x74, 0xda, 0xb0,
-	0x01, 0x40, 0x0f, 0xfb, 0x5d, 0x87, 0x52, 0x87, 0x78, 0x0b, 0x65, 0xd8, 0x98, 0x8c, 0x73, 0x17,
-	0x44, 0x86, 0x39, 0x52, 0x41, 0x11, 0x1a, 0x78, 0x1d, 0xac, 0x98, 0xb6, 0xed, 0x63, 0x4a, 0xd3,
-	0xf1, 0xbc, 0xb4, 0x9d, 0x2a, 0xc3, 0xc9, 0x38, 0x77, 0x5e, 0x60, 0xa6, 0x0e, 0x05, 0xcd, 0x42,
-	0xe0, 0x0e, 0x48, 0x4d, 0x97, 0x98, 0xa6, 0x13, 0xf9, 0xc4, 0x76, 0xaa, 0xbc, 0x3e, 0x19, 0xe7,
-	0xe4, 0xff, 0xc4, 0x63, 0xaa, 0xa0, 0x79, 0xd8, 0xb4, 0x9b, 0xaf, 0xe3, 0x60, 0x99, 0x6b, 0x44,
-	0x21, 0x01, 0xd0, 0x22, 0x36, 0x36, 0xfa, 0x3d, 0x97, 0x98, 0xb6, 0x61, 0xf2, 0x7a, 0x79, 0x3f,
-	0xe7, 0x76, 0xb2, 0xaf, 0xea, 0x47, 0x68, 0x50, 0xbe, 0xf4, 0x64, 0x9c, 0x8b, 0x4d, 0xc6, 0xb9,
-	0x4d, 0x91, 0xf1, 0x24, 0x8f, 0x82, 0xe4, 0xd0, 0x78, 0x9f, 0xdb, 0x04, 0x14, 0x7e, 0x25, 0x81,
-	0xac, 0xe3, 0x51, 0x66, 0x7a, 0xcc, 0x31, 0x19, 0x36, 0x6c, 0x7c, 0x68, 0xf6, 0x5d, 0x66, 0x44,
-	0xd4, 0x8c, 0x2f, 0xa0, 0xe6, 0x95, 0xc9, 0x38, 0xf7, 0x9e, 0xc8, 0xfb, 0x7a, 0x36, 0x05, 0x6d,
-	0x45, 0x02, 0xaa, 0xc2, 0x5f, 0x7f, 0xe1, 0xe6, 0x8a, 0xc4, 0x94, 0xef, 0x25, 0xb0, 0x5a, 0x21,
-	0x36, 0xd6, 0xbc, 0x43, 0x02, 0xdf, 0x01, 0x29, 0xde, 0x4b, 0xc7, 0xa4, 0x1d, 0x2e, 0xc5, 0x1a,
-	0x5a, 0x0d, 0x0d, 0x7b, 0x26, 0xed, 0xc0, 0x34, 0x58, 0xb1, 0x7c, 0x6c, 0x32, 0xe2, 0x8b, 0x33,
-	0x42, 0xb3, 0x2d, 0x6c, 0x00, 0x18, 0x2d, 0xc5, 0xe2, 0x22, 0xa5, 0x97, 0x16, 0x92, 0x32, 0x19,
-	0x4a, 0x89, 0x2e, 0x44, 0xf0, 0xc2, 0x71, 0x2f, 0xb9, 0x9a, 0x90, 0x93, 0xf7, 0x92, 0xab, 0x49,
-	0x79, 0x49, 0xf9, 0x35, 0x0e, 0xd6, 0x2a, 0xc4, 0x63, 0xbe, 0x69, 0x31, 0x5e, 0xe8, 0xbb, 0x60,
-	0x85, 0x17, 0xea, 0xd8, 0xbc, 0xcc, 0x64, 0x19, 0x1c, 0x8f, 0x73, 0xcb, 0xbc, 0x8f, 0x2a, 0x5a,
-	0x0e, 0x5d, 0x9a, 0xfd, 0x9a, 0x82, 0xd7, 0xc1, 0x92, 0x69, 0x77, 0x1d, 0x2f, 0x9d, 0xe0, 0x76,
-	0xb1, 0x09, 0xad, 0xae, 0xd9, 0xc2, 0x6e, 0x3a, 0x29, 0xac, 0x7c, 0x03, 0xef, 0x4c, 0x59, 0xb0,
-	0x3d, 0xed, 0xe8, 0xf2, 0x29, 0x1d, 0xb5, 0x28, 0x71, 0xfb, 0x0c, 0x37, 0x07, 0x75, 0x42, 0x1d,
-	0xe6, 0x10, 0x0f, 0xcd, 0x40, 0xf0, 0x06, 0x38, 0xe7, 0xb4, 0x2c, 0xa3, 0x47, 0x7c, 0x16, 0x96,
-	0xbb, 0xcc, 0xaf, 0xf7, 0x1b, 0xc7, 0xe3, 0x5c, 0x4a, 0x2b, 0x57, 0xea, 0xc4, 0x67, 0x5a, 0x15,
-	0xa5, 0x9c, 0x96, 0xc5, 0x97, 0x36, 0xdc, 0x07, 0x29, 0x3c, 0x60, 0xd8, 0xe3, 0xf7, 0x61, 0x85,
-	0x27, 0x5c, 0x2f, 0x88, 0xe9, 0x2f, 0xcc, 0xa6, 0xbf, 0x50, 0xf2, 0x82, 0xf2, 0xe6, 0x6f, 0xbf,
-	0xdc, 0xd8, 0x88, 0x8a, 0xa2, 0xce, 0x60, 0x68, 0xce, 0x70, 0x3b, 0xf9, 0x77, 0x78, 0xed, 0xff,
-	0x91, 0x40, 0x7a, 0x16, 0x1a, 0x8a, 0xb4, 0xe7, 0x50, 0x46, 0xfc, 0x40, 0xf5, 0x98, 0x1f, 0xc0,
-	0x3a, 0x48, 0x91, 0x1e, 0xf6, 0x4d, 0x36, 0x9f, 0xe7, 0x9d, 0x93, 0x2d, 0x9e, 0x02, 0xd7, 0x67,
-	0xa8, 0xf0, 0x5e, 0xa2, 0x39, 0x49, 0xf4, 0x74, 0xe2, 0xaf, 0x3c, 0x9d, 0x3b, 0x60, 0xa5, 0xdf,
-	0xb3, 0xb9, 0xae, 0x89, 0xff, 0xa3, 0xeb, 0x14, 0x04, 0xb7, 0x41, 0xa2, 0x4b, 0xdb, 0xfc, 0xac,
-	0xd6, 0xca, 0x6f, 0x3f, 0x1f, 0xe7, 0x20, 0x32, 0x1f, 0xcd, 0xaa, 0xdc, 0xc7, 0x94, 0x9a, 0x6d,
-	0x8c, 0xc2, 0x10, 0x05, 0x01, 0x78, 0x92, 0x08, 0x5e, 0x02, 0x6b, 0x2d, 0x97, 0x58, 0x0f, 0x8d,
-	0x0e, 0x76, 0xda, 0x1d, 0x26, 0xee, 0x11, 0x3a, 0xc7, 0x6d, 0x7b, 0xdc, 0x04, 0x37, 0xc1, 0x2a,
-	0x1b, 0x18, 0x8e, 0x67, 0xe3, 0x81, 0x68, 0x04, 0xad, 0xb0, 0x81, 0x16, 0x6e, 0x15, 0x0c, 0x96,
-	0xf6, 0x89, 0x8d, 0x5d, 0xb8, 0x0b, 0x12, 0x0f, 0x71, 0x20, 0x86, 0xa5, 0xfc, 0xd1, 0xf3, 0x71,
-	0xee, 0x66, 0xdb, 0x61, 0x9d, 0x7e, 0xab, 0x60, 0x91, 0x6e, 0x71, 0xd7, 0xf1, 0xa8, 0xd5, 0x71,
-	0xcc, 0x22, 0xa1, 0x61, 0x59, 0xc4, 0x2b, 0xba, 0x4e, 0x8b, 0x16, 0x5b, 0x01, 0xc3, 0xb4, 0xb0,
-	0x87, 0x07, 0xe5, 0x70, 0x81, 0x42, 0x82, 0xf0, 0xf2, 0x89, 0x37, 0x3b, 0xce, 0xc7, 0x4e, 0x6c,
-	0xae, 0xfe, 0x1c, 0x07, 0x60, 0x3e, 0xfb, 0xf0, 0x63, 0x70, 0xb1, 0x54, 0xa9, 0xa8, 0x8d, 0x86,
-	0xd1, 0x3c, 0xa8, 0xab, 0xc6, 0xfd, 0x5a, 0xa3, 0xae, 0x56, 0xb4, 0x5d, 0x4d, 0xad, 0xca, 0xb1,
-	0xcc, 0xe6, 0x70, 0x94, 0xdf, 0x98, 0x07, 0xdf, 0xf7, 0x68, 0x0f, 0x5b, 0xce, 0xa1, 0x83, 0x6d,
-	0x78, 0x1d, 0xc0, 0x28, 0xae, 0xa6, 0x97, 0xf5, 0xea, 0x81, 0x2c, 0x65, 0xd6, 0x87, 0xa3, 0xbc,
-	0x3c, 0x87, 0xd4, 0x48, 0x8b, 0xd8, 0x01, 0xfc, 0x04, 0xa4, 0xa3, 0xd1, 0x7a, 0xed, 0xd3, 0x03,
-	0xa3, 0x54, 0xad, 0x22, 0xb5, 0xd1, 0x90, 0xe3, 0x2f, 0xa7, 0xd1, 0x3d, 0x37, 0x28, 0xbd, 0x78,
-	0x97, 0x37, 0xa2, 0x40, 0xf5, 0x33, 0x15, 0x1d, 0xf0, 0x4c, 0x89, 0xcc, 0xc5, 0xe1, 0x28, 0xff,
-	0xd6, 0x1c, 0xa5, 0x1e, 0x61, 0x3f, 0xe0, 0xc9, 0xee, 0x80, 0xad, 0x28, 0xa6, 0x54, 0x3b, 0x30,
-	0xf4, 0xdd, 0x59, 0x3a, 0xb5, 0x21, 0x27, 0x33, 0x5b, 0xc3, 0x51, 0x3e, 0x3d, 0x87, 0x96, 0xbc,
-	0x40, 0x3f, 0x2c, 0xcd, 0xde, 0xf5, 0xcc, 0xea, 0x97, 0x3f, 0x64, 0x63, 0x8f, 0x7f, 0xcc, 0xc6,
-	0xae, 0xfe, 0x94, 0x00, 0xf9, 0xb3, 0x6e, 0x29, 0xc4, 0xe0, 0x66, 0x45, 0xaf, 0x35, 0x51, 0xa9,
-	0xd2, 0x34, 0x2a, 0x7a, 0x55, 0x35, 0xf6, 0xb4, 0x46, 0x53, 0x47, 0x07, 0x86, 0x5e, 0x57, 0x51,
-	0xa9, 0xa9, 0xe9, 0xb5, 0xd3, 0xa4, 0x2d, 0x0e, 0x47, 0xf9, 0x6b, 0x67, 0x71, 0x47, 0x05, 0xff,
-	0x1c, 0x5c, 0x59, 0x28, 0x8d, 0x56, 0xd3, 0x9a, 0xb2, 0x94, 0xd9, 0x1e, 0x8e, 0xf2, 0x97, 0xcf,
-	0xe2, 0xd7, 0x3c, 0x87, 0xc1, 0x07, 0xe0, 0xfa, 0x42, 0xc4, 0xfb, 0xda, 0x5d, 0x54, 0x6a, 0xaa,
-	0x72, 0x3c, 0x73, 0x6d, 0x38, 0xca, 0x7f, 0x70, 0x16, 0xf7, 0xbe, 0xd3, 0xf6, 0x4d, 0x86, 0x17,
-	0xa6, 0xbf, 0xab, 0xd6, 0xd4, 0x86, 0xd6, 0x90, 0x13, 0x8b, 0xd1, 0xdf, 0xc5, 0x1e, 0xa6, 0x0e,
-	0xcd, 0x24, 0xc3, 0xc3, 0x2a, 0xef, 0x3d, 0xf9, 0x2b, 0x1b, 0x7b, 0x7c, 0x9c, 0x95, 0x9e, 0x1c,
-	0x67, 0xa5, 0xa7, 0xc7, 0x59, 0xe9, 0xcf, 0xe3, 0xac, 0xf4, 0xcd, 0xb3, 0x6c, 0xec, 0xe9, 0xb3,
-	0x6c, 0xec, 0x8f, 0x67, 0xd9, 0xd8, 0x17, 0xef, 0x9f, 0x36, 0x43, 0xe1, 0xa3, 0x60, 0x17, 0x07,
-	0xe2, 0x17, 0x8b, 0xff, 0x5f, 0xb5, 0x96, 0xf9, 0x8b, 0xf8, 0xe1, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xce, 0x06, 0x16, 0x10, 0x80, 0x09, 0x00, 0x00,
+	// 1989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x25, 0xf9, 0x43, 0x63, 0x25, 0x91, 0x27, 0x76, 0x2c, 0x2b, 0x5e, 0x51, 0x99, 0x64,
+	0x77, 0x9d, 0xdd, 0xac, 0xb5, 0x71, 0x3f, 0x16, 0x0d, 0xda, 0x60, 0xf5, 0xe5, 0x58, 0xdb, 0xd8,
+	0xf2, 0x8e, 0x9c, 0x16, 0x6e, 0xbb, 0x60, 0x29, 0x72, 0x4c, 0x13, 0xa1, 0x38, 0x5a, 0xce, 0xc8,
+	0xb1, 0xfa, 0x17, 0x14, 0x06, 0x8a, 0xf6, 0xd8, 0x02, 0x35, 0x50, 0xa0, 0x45, 0xb1, 0xbd, 0xf7,
+	0x54, 0xa0, 0xf7, 0xa0, 0xbd, 0x6c, 0x81, 0x1e, 0x7a, 0x12, 0x5a, 0xe7, 0xb2, 0x67, 0x1d, 0xb7,
+	0x3d, 0x14, 0x33, 0x24, 0x4d, 0x5a, 0xb2, 0x36, 0x6e, 0x2f, 0x32, 0xe7, 0xbd, 0xf7, 0xfb, 0xbd,
+	0x79, 0x3f, 0xf2, 0x3d, 0x0e, 0x0d, 0x56, 0x0d, 0xca, 0x3a, 0x2f, 0x74, 0xd6, 0x29, 0xc9, 0x9f,
+	0xa3, 0x87, 0x25, 0xde, 0xef, 0x12, 0xb6, 0xde, 0xf5, 0x28, 0xa7, 0x30, 0x1b, 0x7a, 0xd7, 0xe5,
+	0xcf, 0xd1, 0xc3, 0xfc, 0x8a, 0xb0, 0x50, 0xa6, 0x49, 0x7f, 0xc9, 0x5f, 0xf8, 0xc1, 0xf9, 0x45,
+	0x8b, 0x5a, 0xd4, 0xb7, 0x8b, 0xab, 0xc0, 0xba, 0x62, 0x51, 0x6a, 0x39, 0xa4, 0x24, 0x57, 0xed,
+	0xde, 0x41, 0x49, 0x77, 0xfb, 0xbe, 0x0b, 0x7d, 0x02, 0x6e, 0x94, 0x0d, 0x83, 0x30, 0xb6, 0xd7,
+	0xef, 0x92, 0x5d, 0xdd, 0xd3, 0x3b, 0xb0, 0x06, 0xa6, 0x8f, 0x74, 0xa7, 0x47, 0x72, 0x4a, 0x51,
+	0x59, 0xbb, 0xbe, 0xb1, 0xba, 0x3e, 0xba, 0x81, 0xf5, 0x08, 0x51, 0xc9, 0x0e, 0x07, 0x6a, 0xa6,
+	0xaf, 0x77, 0x9c, 0x47, 0x48, 0x82, 0x10, 0xf6, 0xc1, 0x8f, 0x52, 0xbf, 0xfc, 0x8d, 0xaa, 0xa0,
+	0xbf, 0x2a, 0x20, 0xe3, 0x47, 0x57, 0xa9, 0x7b, 0x60, 0x5b, 0xb0, 0x05, 0x40, 0x97, 0x78, 0x1d,
+	0x9b, 0x31, 0x9b, 0xba, 0x57, 0xca, 0xb0, 0x34, 0x1c, 0xa8, 0x0b, 0x7e, 0x86, 0x08, 0x89, 0x70,
+	0x8c, 0x06, 0x3e, 0x00, 0xb3, 0xba, 0x69, 0x7a, 0x84, 0xb1, 0x5c, 0xa2, 0xa8, 0xac, 0xa5, 0x2b,
+	0x70, 0x38, 0x50, 0xaf, 0xfb, 0x98, 0xc0, 0x81, 0x70, 0x18, 0x02, 0x37, 0x40, 0x3a, 0xb8, 0x24,
+	0x2c, 0x97, 0x2c, 0x26, 0xd7, 0xd2, 0x95, 0xc5, 0xe1, 0x40, 0xcd, 0x5e, 0x88, 0x27, 0x0c, 0xe1,
+	0x28, 0x2c, 0xa8, 0xe6, 0x8b, 0x69, 0x30, 0x23, 0x35, 0x62, 0x90, 0x02, 0x68, 0x50, 0x93, 0x68,
+	0xbd, 0xae, 0x43, 0x75, 0x53, 0xd3, 0xe5, 0x7e, 0x65, 0x3d, 0xf3, 0x1b, 0x85, 0x49, 0xf5, 0xf8,
+	0x1a, 0x54, 0xee, 0xbc, 0x1c, 0xa8, 0x53, 0xc3, 0x81, 0xba, 0xe2, 0x67, 0x1c, 0xe7, 0x41, 0x38,
+	0x2b, 0x8c, 0xcf, 0xa4, 0xcd, 0x87, 0xc2, 0x9f, 0x29, 0xa0, 0x60, 0xbb, 0x8c, 0xeb, 0x2e, 0xb7,
+	0x75, 0x4e, 0x34, 0x93, 0x1c, 0xe8, 0x3d, 0x87, 0x6b, 0x31, 0x35, 0x13, 0x57, 0x50, 0xf3, 0xfe,
+	0x70, 0xa0, 0xbe, 0xe9, 0xe7, 0xfd, 0x6a, 0x36, 0x84, 0x57, 0x63, 0x01, 0x35, 0xdf, 0xbf, 0x1b,
+	0x69, 0x6e, 0x80, 0x8c, 0xa5, 0x33, 0xcd, 0x23, 0x96, 0xcd, 0x38, 0xf1, 0x72, 0x49, 0x59, 0xfa,
+	0xdd, 0xf1, 0xe4, 0x4f, 0x74, 0x86, 0x83, 0x20, 0x5f, 0xbb, 0xca, 0xed, 0xa0, 0xfe, 0x9b, 0xfe,
+	0x3e, 0xe2, 0x34, 0x08, 0xcf, 0x5b, 0x51, 0x3c, 0xb4, 0xc0, 0x35, 0xc6, 0xa9, 0xa7, 0x5b, 0x44,
+	0xfb, 0xb4, 0x47, 0xb9, 0x9e, 0x4b, 0xc9, 0x2c, 0xf7, 0xc6, 0xb3, 0xb4, 0xfc, 0xb0, 0x8f, 0x45,
+	0x54, 0x90, 0x66, 0x35, 0x48, 0xb3, 0xe8, 0xa7, 0xb9, 0x40, 0x84, 0x70, 0x86, 0xc5, 0x10, 0xf0,
+	0xe7, 0x0a, 0xc8, 0x33, 0xae, 0x7b, 0x96, 0x10, 0xe3, 0xd3, 0x1e, 0xf1, 0xfa, 0xf2, 0x56, 0x74,
+	0xb9, 0xe6, 0xd8, 0x8c, 0xe7, 0xa6, 0x8b, 0xc9, 0xb5, 0xf9, 0x8d, 0xb7, 0x2f, 0x57, 0xb6, 0xcb,
+	0x89, 0xd9, 0x0a, 0xb0, 0x1f, 0x0b, 0x68, 0xe5, 0x7e, 0x90, 0xf9, 0x4e, 0x98, 0x79, 0x12, 0x31,
+	0xc2, 0xcb, 0x2c, 0x8e, 0xf4, 0xe9, 0x9e, 0xda, 0x8c, 0xc3, 0x17, 0xe0, 0xe6, 0x39, 0xae, 0xc3,
+	0x2c, 0xed, 0xc0, 0x76, 0x84, 0xcc, 0x33, 0x93, 0x64, 0x0e, 0x77, 0xb0, 0xcd, 0xac, 0x4d, 0x19,
+	0x5a, 0x41, 0xc1, 0x2e, 0xf2, 0x23, 0xbb, 0x88, 0xd8, 0x10, 0x5e, 0x60, 0xa3, 0x30, 0xf9, 0xa8,
+	0x4f, 0xa1, 0x3f, 0x29, 0x60, 0x61, 0x8c, 0x12, 0x7e, 0x00, 0xe6, 0xe3, 0xb2, 0x28, 0xb2, 0x79,
+	0x6e, 0x0d, 0x07, 0x2a, 0x0c, 0x9a, 0x27, 0x5e, 0x1a, 0xd0, 0xa3, 0x6a, 0x1e, 0x82, 0xb4, 0x49,
+	0xdc, 0xbe, 0x0f, 0x4b, 0x8c, 0xf6, 0xdc, 0xb9, 0x0b, 0xe1, 0x39, 0x71, 0x2d, 0x21, 0xdf, 0x02,
+	0x99, 0x8e, 0x7e, 0x2c, 0x77, 0xcb, 0xec, 0x9f, 0x10, 0xf9, 0x80, 0xa5, 0x2a, 0xcb, 0xd1, 0x73,
+	0x13, 0xf7, 0x22, 0x0c, 0x3a, 0xfa, 0xf1, 0x36, 0xb3, 0x5a, 0x62, 0xf1, 0x2b, 0x05, 0x2c, 0x5d,
+	0x7a, 0x67, 0xe0, 0x5d, 0x90, 0xea, 0xea, 0xfc, 0x50, 0x36, 0x6a, 0xba, 0x72, 0x63, 0x38, 0x50,
+	0xe7, 0x83, 0xd1, 0xa2, 0xf3, 0x43, 0x84, 0xa5, 0x13, 0xfe, 0x10, 0x2c, 0x78, 0x84, 0x75, 0xa9,
+	0xcb, 0x88, 0x26, 0x26, 0xb1, 0xd6, 0xf3, 0x9c, 0x60, 0xb0, 0x94, 0xce, 0x06, 0xea, 0x0d, 0x1c,
+	0x38, 0x45, 0x43, 0x3d, 0xc3, 0x4f, 0x87, 0x03, 0x35, 0xe7, 0x93, 0x8c, 0xa1, 0x10, 0xbe, 0xe1,
+	0xc5, 0x83, 0x3d, 0x07, 0xfd, 0x67, 0x06, 0x2c, 0x8c, 0xb5, 0x04, 0xfc, 0x0e, 0xb8, 0xe6, 0x77,
+	0x9b, 0x41, 0x34, 0x83, 0x4a, 0x69, 0x45, 0xb5, 0xb9, 0xe8, 0xf1, 0xbd, 0xe0, 0x46, 0x38, 0x13,
+	0xae, 0xab, 0x94, 0x71, 0xf8, 0x08, 0x64, 0x0c, 0xda, 0xe9, 0xda, 0x4e, 0x80, 0x4e, 0x8c, 0x6a,
+	0x15, 0xf7, 0x22, 0x3c, 0x1f, 0x2c, 0x25, 0xf6, 0xc7, 0x60, 0xa5, 0xe7, 0x0a, 0x83, 0x18, 0x75,
+	0x32, 0x40, 0x73, 0x7b, 0x1d, 0xe2, 0xe9, 0x9c, 0x7a, 0x81, 0xe8, 0xf7, 0x86, 0x03, 0xb5, 0xe8,
+	0x13, 0x4d, 0x0c, 0x45, 0x78, 0x39, 0xf2, 0x09, 0xe2, 0x9d, 0xd0, 0x03, 0x0f, 0xc0, 0xed, 0x51,
+	0x98, 0x49, 0x5c, 0xda, 0xb1, 0x5d, 0x99, 0x23, 0x25, 0x73, 0xbc, 0x35, 0x1c, 0xa8, 0xe8, 0xf2,
+	0x1c, 0xb1, 0x60, 0x84, 0x57, 0x2e, 0x66, 0xa9, 0x45, 0x3e, 0xf8, 0x21, 0xb8, 0x2e, 0x66, 0x49,
+	0xa7, 0xe7, 0x70, 0xbb, 0xeb, 0xd8, 0xc4, 0xcb, 0x4d, 0x4b, 0xea, 0x95, 0xe1, 0x40, 0x5d, 0x8a,
+	0x66, 0x4d, 0xe4, 0x47, 0xf8, 0x9a, 0xa5, 0xb3, 0xed, 0xf3, 0x35, 0xfc, 0x11, 0xc8, 0x91, 0x23,
+	0xe2, 0xca, 0x39, 0xa8, 0xe9, 0x9c, 0x7b, 0x76, 0xbb, 0xc7, 0x03, 0x4d, 0x67, 0x24, 0xd7, 0xdd,
+	0xe1, 0x40, 0x55, 0x7d, 0xae, 0x49, 0x91, 0x08, 0x2f, 0x49, 0xd7, 0x2e, 0xf1, 0xca, 0xa1, 0x43,
+	0x2a, 0xad, 0x81, 0x15, 0x1f, 0x13, 0xc5, 0x9b, 0x3a, 0xd7, 0x7d, 0xfa, 0xd9, 0x51, 0xa5, 0x27,
+	0x86, 0x22, 0x7c, 0x4b, 0xfa, 0xce, 0xc9, 0x6b, 0x3a, 0xd7, 0x65, 0x82, 0x0e, 0x28, 0x5c, 0x8a,
+	0x3a, 0xf0, 0x08, 0xd1, 0xb8, 0x10, 0x64, 0x4e, 0x66, 0x89, 0xbd, 0x04, 0xbe, 0x3a, 0x1e, 0xe1,
+	0xfc, 0x78, 0xaa, 0x4d, 0x8f, 0x90, 0x3d, 0xa1, 0x56, 0x1b, 0xe4, 0x0d, 0xea, 0x72, 0x4f, 0x37,
+	0xb8, 0xd6, 0x21, 0x8c, 0xe9, 0x56, 0x80, 0x97, 0x05, 0xa5, 0x65, 0xaa, 0x37, 0xa3, 0x31, 0x38,
+	0x39, 0x16, 0xe1, 0xe5, 0xd0, 0xb9, 0xed, 0xfb, 0xce, 0x4b, 0xda, 0x02, 0x0b, 0x46, 0x8f, 0x71,
+	0xda, 0xd1, 0xfc, 0x9d, 0x4a, 0x6a, 0x20, 0xa9, 0x57, 0xa3, 0xc6, 0x1b, 0x0b, 0x41, 0xf8, 0x86,
+	0x6f, 0xab, 0x0b, 0x93, 0x60, 0x0a, 0x5e, 0xe1, 0x7f, 0x53, 0x00, 0x1c, 0x7f, 0x57, 0xc0, 0xef,
+	0x02, 0x28, 0xc6, 0xc9, 0xf9, 0x16, 0xdb, 0x7d, 0x4e, 0x58, 0xd0, 0x84, 0x6f, 0x44, 0xaf, 0xea,
+	0xf1, 0x18, 0x84, 0xb3, 0x1d, 0xfd, 0xb8, 0x1a, 0xd8, 0x2a, 0xc2, 0x04, 0x0f, 0xc1, 0x75, 0xf9,
+	0x4e, 0xa7, 0x47, 0xc4, 0xf3, 0x6c, 0x93, 0x30, 0x39, 0xf1, 0xe6, 0x37, 0xd0, 0xf8, 0xd4, 0xae,
+	0x52, 0x93, 0xc4, 0xb7, 0x53, 0x79, 0x23, 0x18, 0xda, 0x4b, 0xb1, 0xb3, 0xc1, 0x39, 0x0f, 0xc2,
+	0xd7, 0x84, 0xa1, 0x19, 0xae, 0x83, 0x9a, 0x7e, 0xad, 0x80, 0xec, 0x28, 0x11, 0xfc, 0x06, 0x98,
+	0x95, 0x60, 0xdb, 0x0c, 0xca, 0x58, 0x3d, 0x1b, 0xa8, 0x33, 0x22, 0xac, 0x51, 0x8b, 0x4e, 0x47,
+	0x41, 0x08, 0xc2, 0x33, 0xe2, 0xaa, 0x61, 0x4e, 0x10, 0x22, 0xf1, 0x7f, 0x09, 0x11, 0x6c, 0xef,
+	0xef, 0x0a, 0x98, 0x93, 0x79, 0xdd, 0x03, 0x0a, 0x6f, 0x83, 0xb4, 0xcc, 0x79, 0xa8, 0x33, 0x7f,
+	0x0a, 0x67, 0xf0, 0x9c, 0x30, 0x6c, 0xe9, 0xec, 0x10, 0xe6, 0xc0, 0xac, 0xe1, 0x11, 0x39, 0x14,
+	0xe4, 0xb8, 0xc5, 0xe1, 0x12, 0xb6, 0x00, 0x8c, 0x1f, 0x57, 0x0c, 0x79, 0x90, 0xca, 0x4d, 0x5f,
+	0xe9, 0xb8, 0x95, 0x12, 0x92, 0xe2, 0x85, 0x18, 0xde, 0x77, 0xc0, 0x5b, 0x60, 0x86, 0xd1, 0x9e,
+	0x67, 0x10, 0xd9, 0xdb, 0x69, 0x1c, 0xac, 0xc4, 0x36, 0xda, 0x3d, 0xdb, 0x31, 0x89, 0x27, 0xbb,
+	0x32, 0x8d, 0xc3, 0xe5, 0x47, 0xa9, 0xb9, 0x64, 0x36, 0xf5, 0x51, 0x6a, 0x2e, 0x95, 0x9d, 0x46,
+	0x7f, 0x4e, 0x80, 0x4c, 0x58, 0xae, 0x2c, 0xed, 0xee, 0xa8, 0xe2, 0x20, 0x52, 0xfc, 0x5c, 0xdf,
+	0xc9, 0x25, 0x2e, 0x82, 0x69, 0xdd, 0xec, 0xd8, 0xae, 0x9c, 0xb9, 0x69, 0xec, 0x2f, 0x84, 0xd5,
+	0xd1, 0xdb, 0xc4, 0x91, 0x53, 0x32, 0x8d, 0xfd, 0x05, 0x7c, 0x1c, 0xb0, 0x10, 0x33, 0x37, 0x3d,
+	0xe9, 0x44, 0x54, 0x6e, 0x33, 0xea, 0xf4, 0x38, 0xd9, 0x3b, 0xde, 0xa5, 0xcc, 0xe6, 0x36, 0x75,
+	0x71, 0x08, 0x82, 0xef, 0x81, 0x79, 0xbb, 0x6d, 0x68, 0x5d, 0xea, 0x71, 0xb1, 0x5d, 0x59, 0x7e,
+	0xe5, 0xda, 0xd9, 0x40, 0x4d, 0x37, 0x2a, 0xd5, 0x5d, 0xea, 0xf1, 0x46, 0x0d, 0xa7, 0xed, 0xb6,
+	0x21, 0x2f, 0x4d, 0xb8, 0x0d, 0xd2, 0xe4, 0x98, 0x13, 0x57, 0x9e, 0x32, 0x67, 0x65, 0xc2, 0xc5,
+	0x75, 0xff, 0x9b, 0x62, 0x3d, 0xfc, 0xa6, 0x58, 0x2f, 0xbb, 0xfd, 0xca, 0xca, 0x5f, 0xfe, 0xf8,
+	0xde, 0x52, 0x5c, 0x94, 0x7a, 0x08, 0xc3, 0x11, 0xc3, 0xa3, 0xd4, 0x17, 0xe2, 0xb1, 0xf8, 0xb7,
+	0x02, 0x72, 0x61, 0xa8, 0x10, 0x69, 0xcb, 0x66, 0x9c, 0x7a, 0xfd, 0xba, 0xcb, 0xbd, 0x3e, 0xdc,
+	0x05, 0x69, 0xda, 0x15, 0xaf, 0x8f, 0xe8, 0x2b, 0x61, 0xe3, 0xb2, 0xee, 0x19, 0x83, 0x37, 0x43,
+	0x94, 0x78, 0xdf, 0xe2, 0x88, 0x24, 0x7e, 0x77, 0x12, 0x13, 0xef, 0xce, 0x63, 0x30, 0xdb, 0xeb,
+	0x9a, 0x52, 0xd7, 0xe4, 0xff, 0xa2, 0x6b, 0x00, 0x82, 0x6b, 0x20, 0xd9, 0x61, 0x96, 0xbc, 0x57,
+	0x99, 0xca, 0xad, 0x2f, 0x07, 0x2a, 0xc4, 0xfa, 0x8b, 0xea, 0xc5, 0xd1, 0x86, 0x45, 0x08, 0xc2,
+	0x00, 0x8e, 0x13, 0xc1, 0x3b, 0x20, 0xd3, 0x76, 0xa8, 0xf1, 0x5c, 0x3b, 0x24, 0xb6, 0x75, 0x18,
+	0x9c, 0x02, 0xf0, 0xbc, 0xb4, 0x6d, 0x49, 0x13, 0x5c, 0x01, 0x73, 0xfc, 0x58, 0xb3, 0x5d, 0x93,
+	0x1c, 0xfb, 0x85, 0xe0, 0x59, 0x7e, 0xdc, 0x10, 0x4b, 0x44, 0xc0, 0xf4, 0x36, 0x35, 0x89, 0x03,
+	0x37, 0x41, 0xf2, 0x39, 0xe9, 0xfb, 0xed, 0x55, 0xf9, 0xfa, 0x97, 0x03, 0xf5, 0x7d, 0xcb, 0xe6,
+	0x87, 0xbd, 0xf6, 0xba, 0x41, 0x3b, 0xa5, 0x4d, 0xdb, 0x65, 0xc6, 0xa1, 0xad, 0x97, 0x28, 0x13,
+	0xdb, 0xa2, 0x6e, 0xc9, 0xb1, 0xdb, 0xac, 0x24, 0x9b, 0x78, 0x7d, 0x8b, 0x1c, 0xcb, 0xd6, 0xc5,
+	0x82, 0x40, 0x3c, 0x7c, 0xfe, 0x97, 0x60, 0x42, 0x36, 0xaa, 0xbf, 0x40, 0x1f, 0x82, 0xc5, 0xb0,
+	0xa4, 0x60, 0xe2, 0x3c, 0x13, 0x75, 0x89, 0xe8, 0xd8, 0xd8, 0xc4, 0xfe, 0x02, 0x42, 0x90, 0x7a,
+	0x4e, 0xfa, 0xc1, 0x08, 0xc1, 0xf2, 0x1a, 0x7d, 0x1b, 0x64, 0x2e, 0xcc, 0xaa, 0x07, 0x93, 0xa7,
+	0xef, 0xf8, 0x54, 0x79, 0xe7, 0x0f, 0x09, 0x00, 0xa2, 0x2f, 0x1a, 0xf8, 0x4d, 0xb0, 0x5c, 0xae,
+	0x56, 0xeb, 0xad, 0x96, 0xb6, 0xb7, 0xbf, 0x5b, 0xd7, 0x9e, 0xed, 0xb4, 0x76, 0xeb, 0xd5, 0xc6,
+	0x66, 0xa3, 0x5e, 0xcb, 0x4e, 0xe5, 0x57, 0x4e, 0x4e, 0x8b, 0x4b, 0x51, 0xf0, 0x33, 0x97, 0x75,
+	0x89, 0x61, 0x1f, 0xd8, 0xc4, 0x14, 0x49, 0xe3, 0xb8, 0x9d, 0x66, 0xa5, 0x59, 0xdb, 0xcf, 0x2a,
+	0xf9, 0xc5, 0x93, 0xd3, 0x62, 0x36, 0x82, 0xec, 0xd0, 0x36, 0x35, 0xfb, 0xf0, 0x03, 0x90, 0x8b,
+	0x47, 0x37, 0x77, 0x9e, 0xee, 0x6b, 0xe5, 0x5a, 0x0d, 0xd7, 0x5b, 0xad, 0x6c, 0x62, 0x34, 0x4d,
+	0xd3, 0x75, 0xfa, 0xe5, 0xf3, 0xaf, 0xcd, 0xa5, 0x38, 0xb0, 0xfe, 0xbd, 0x3a, 0xde, 0x97, 0x99,
+	0x92, 0xf9, 0xe5, 0x93, 0xd3, 0xe2, 0xcd, 0x08, 0x55, 0x3f, 0x22, 0x5e, 0x5f, 0x26, 0x7b, 0x0c,
+	0x56, 0xe3, 0x98, 0xf2, 0xce, 0xbe, 0xd6, 0xdc, 0x0c, 0xd3, 0xd5, 0x5b, 0xd9, 0x54, 0x7e, 0xf5,
+	0xe4, 0xb4, 0x98, 0x8b, 0xa0, 0x65, 0xb7, 0xdf, 0x3c, 0x28, 0x87, 0x5f, 0xab, 0xf9, 0xb9, 0x9f,
+	0xfe, 0xb6, 0x30, 0xf5, 0xd9, 0xef, 0x0a, 0x53, 0xef, 0xfc, 0x3e, 0x09, 0x8a, 0xaf, 0xeb, 0x12,
+	0x48, 0xc0, 0xfb, 0xd5, 0xe6, 0xce, 0x1e, 0x2e, 0x57, 0xf7, 0xb4, 0x6a, 0xb3, 0x56, 0xd7, 0xb6,
+	0x1a, 0xad, 0xbd, 0x26, 0xde, 0xd7, 0x9a, 0xbb, 0x75, 0x5c, 0xde, 0x6b, 0x34, 0x77, 0x2e, 0x93,
+	0xb6, 0x74, 0x72, 0x5a, 0x7c, 0xf7, 0x75, 0xdc, 0x71, 0xc1, 0xbf, 0x0f, 0xee, 0x5f, 0x29, 0x4d,
+	0x63, 0xa7, 0xb1, 0x97, 0x55, 0xf2, 0x6b, 0x27, 0xa7, 0xc5, 0x7b, 0xaf, 0xe3, 0x6f, 0xb8, 0x36,
+	0x87, 0x9f, 0x80, 0x07, 0x57, 0x22, 0xde, 0x6e, 0x3c, 0xc1, 0xe5, 0xbd, 0x7a, 0x36, 0x91, 0x7f,
+	0xf7, 0xe4, 0xb4, 0xf8, 0xf6, 0xeb, 0xb8, 0xb7, 0x6d, 0xcb, 0xd3, 0x39, 0xb9, 0x32, 0xfd, 0x93,
+	0xfa, 0x4e, 0xbd, 0xd5, 0x68, 0x65, 0x93, 0x57, 0xa3, 0x7f, 0x42, 0x5c, 0xc2, 0x6c, 0x96, 0x4f,
+	0x89, 0x9b, 0x55, 0xd9, 0x7a, 0xf9, 0xaf, 0xc2, 0xd4, 0x67, 0x67, 0x05, 0xe5, 0xe5, 0x59, 0x41,
+	0xf9, 0xfc, 0xac, 0xa0, 0xfc, 0xf3, 0xac, 0xa0, 0xfc, 0xe2, 0x55, 0x61, 0xea, 0xf3, 0x57, 0x85,
+	0xa9, 0x7f, 0xbc, 0x2a, 0x4c, 0xfd, 0xe0, 0xad, 0xcb, 0x7a, 0x58, 0x0c, 0x25, 0xb3, 0x74, 0x2c,
+	0xff, 0xfa, 0xff, 0x35, 0x6a, 0xcf, 0xc8, 0x89, 0xfc, 0xb5, 0xff, 0x0e, 0x00, 0x5e, 0x7d, 0xcf,
+	0x83, 0x56, 0x12, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -664,17 +1094,34 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
 		return false
 	}
+	if !this.GasRegister.Equal(&that1.GasRegister) {
+		return false
+	}
+	if !this.StorageQuota.Equal(&that1.StorageQuota) {
+		return false
+	}
+	if len(this.StargateQueryAcceptList) != len(that1.StargateQueryAcceptList) {
+		return false
+	}
+	for i := range this.StargateQueryAcceptList {
+		if !this.StargateQueryAcceptList[i].Equal(&that1.StargateQueryAcceptList[i]) {
+			return false
+		}
+	}
+	if !this.StargateMsgFilter.Equal(&that1.StargateMsgFilter) {
+		return false
+	}
 	return true
 }
 
-func (this *CodeInfo) Equal(that interface{}) bool {
+func (this *StargateMsgFilter) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CodeInfo)
+	that1, ok := that.(*StargateMsgFilter)
 	if !ok {
-		that2, ok := that.(CodeInfo)
+		that2, ok := that.(StargateMsgFilter)
 		if ok {
 			that1 = &that2
 		} else {
@@ -686,26 +1133,36 @@ func (this *CodeInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+	if len(this.AcceptList) != len(that1.AcceptList) {
 		return false
 	}
-	if this.Creator != that1.Creator {
+	for i := range this.AcceptList {
+		if this.AcceptList[i] != that1.AcceptList[i] {
+			return false
+		}
+	}
+	if len(this.DenyList) != len(that1.DenyList) {
 		return false
 	}
-	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
+	for i := range this.DenyList {
+		if this.DenyList[i] != that1.DenyList[i] {
+			return false
+		}
+	}
+	if this.MaxMsgSize != that1.MaxMsgSize {
 		return false
 	}
 	return true
 }
 
-func (this *ContractInfo) Equal(that interface{}) bool {
+func (this *AcceptedStargateQuery) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractInfo)
+	that1, ok := that.(*AcceptedStargateQuery)
 	if !ok {
-		that2, ok := that.(ContractInfo)
+		that2, ok := that.(AcceptedStargateQuery)
 		if ok {
 			that1 = &that2
 		} else {
@@ -717,38 +1174,23 @@ func (this *ContractInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CodeID != that1.CodeID {
-		return false
-	}
-	if this.Creator != that1.Creator {
-		return false
-	}
-	if this.Admin != that1.Admin {
-		return false
-	}
-	if this.Label != that1.Label {
-		return false
-	}
-	if !this.Created.Equal(that1.Created) {
-		return false
-	}
-	if this.IBCPortID != that1.IBCPortID {
+	if this.Path != that1.Path {
 		return false
 	}
-	if !this.Extension.Equal(that1.Extension) {
+	if this.ResponseTypeURL != that1.ResponseTypeURL {
 		return false
 	}
 	return true
 }
 
-func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+func (this *GasRegisterParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractCodeHistoryEntry)
+	that1, ok := that.(*GasRegisterParams)
 	if !ok {
-		that2, ok := that.(ContractCodeHistoryEntry)
+		that2, ok := that.(GasRegisterParams)
 		if ok {
 			that1 = &that2
 		} else {
@@ -760,27 +1202,220 @@ func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Operation != that1.Operation {
+	if this.InstanceCost != that1.InstanceCost {
 		return false
 	}
-	if this.CodeID != that1.CodeID {
+	if this.CompileCost != that1.CompileCost {
 		return false
 	}
-	if !this.Updated.Equal(that1.Updated) {
+	if this.UncompressCostNumerator != that1.UncompressCostNumerator {
 		return false
 	}
-	if !bytes.Equal(this.Msg, that1.Msg) {
+	if this.UncompressCostDenominator != that1.UncompressCostDenominator {
+		return false
+	}
+	if this.GasMultiplier != that1.GasMultiplier {
+		return false
+	}
+	if this.EventPerAttributeCost != that1.EventPerAttributeCost {
+		return false
+	}
+	if this.EventAttributeDataCost != that1.EventAttributeDataCost {
+		return false
+	}
+	if this.EventAttributeDataFreeTier != that1.EventAttributeDataFreeTier {
+		return false
+	}
+	if this.ContractMessageDataCost != that1.ContractMessageDataCost {
+		return false
+	}
+	if this.CustomEventCost != that1.CustomEventCost {
 		return false
 	}
 	return true
 }
 
-func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+func (this *StorageQuotaParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AbsoluteTxPosition)
+	that1, ok := that.(*StorageQuotaParams)
+	if !ok {
+		that2, ok := that.(StorageQuotaParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxContractBytes != that1.MaxContractBytes {
+		return false
+	}
+	if len(this.CodeOverrides) != len(that1.CodeOverrides) {
+		return false
+	}
+	for i := range this.CodeOverrides {
+		if !this.CodeOverrides[i].Equal(&that1.CodeOverrides[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *CodeStorageQuota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeStorageQuota)
+	if !ok {
+		that2, ok := that.(CodeStorageQuota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.MaxContractBytes != that1.MaxContractBytes {
+		return false
+	}
+	return true
+}
+
+func (this *CodeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeInfo)
+	if !ok {
+		that2, ok := that.(CodeInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if this.Builder != that1.Builder {
+		return false
+	}
+	return true
+}
+
+func (this *ContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractInfo)
+	if !ok {
+		that2, ok := that.(ContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	if !this.Created.Equal(that1.Created) {
+		return false
+	}
+	if this.IBCPortID != that1.IBCPortID {
+		return false
+	}
+	if !this.Extension.Equal(that1.Extension) {
+		return false
+	}
+	return true
+}
+
+func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractCodeHistoryEntry)
+	if !ok {
+		that2, ok := that.(ContractCodeHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !this.Updated.Equal(that1.Updated) {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	return true
+}
+
+func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AbsoluteTxPosition)
 	if !ok {
 		that2, ok := that.(AbsoluteTxPosition)
 		if ok {
@@ -831,6 +1466,59 @@ func (this *Model) Equal(that interface{}) bool {
 	return true
 }
 
+func (this *ContractStorageUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStorageUsage)
+	if !ok {
+		that2, ok := that.(ContractStorageUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Bytes != that1.Bytes {
+		return false
+	}
+	if this.Keys != that1.Keys {
+		return false
+	}
+	return true
+}
+
+func (this *StorageQuota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageQuota)
+	if !ok {
+		that2, ok := that.(StorageQuota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxContractBytes != that1.MaxContractBytes {
+		return false
+	}
+	return true
+}
+
 func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -923,6 +1611,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.StargateMsgFilter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.StargateQueryAcceptList) > 0 {
+		for iNdEx := len(m.StargateQueryAcceptList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StargateQueryAcceptList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.StorageQuota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.GasRegister.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.InstantiateDefaultPermission != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
 		i--
@@ -941,7 +1673,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+func (m *StargateMsgFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -951,34 +1683,279 @@ func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *StargateMsgFilter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StargateMsgFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+	if m.MaxMsgSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxMsgSize))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.CodeHash) > 0 {
+	if len(m.DenyList) > 0 {
+		for iNdEx := len(m.DenyList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenyList[iNdEx])
+			copy(dAtA[i:], m.DenyList[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DenyList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AcceptList) > 0 {
+		for iNdEx := len(m.AcceptList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptList[iNdEx])
+			copy(dAtA[i:], m.AcceptList[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AcceptList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedStargateQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedStargateQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedStargateQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResponseTypeURL) > 0 {
+		i -= len(m.ResponseTypeURL)
+		copy(dAtA[i:], m.ResponseTypeURL)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ResponseTypeURL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasRegisterParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasRegisterParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasRegisterParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CustomEventCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CustomEventCost))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ContractMessageDataCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ContractMessageDataCost))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EventAttributeDataFreeTier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventAttributeDataFreeTier))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EventAttributeDataCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventAttributeDataCost))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EventPerAttributeCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventPerAttributeCost))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasMultiplier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasMultiplier))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UncompressCostDenominator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UncompressCostDenominator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UncompressCostNumerator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UncompressCostNumerator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CompileCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InstanceCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageQuotaParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageQuotaParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageQuotaParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeOverrides) > 0 {
+		for iNdEx := len(m.CodeOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxContractBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxContractBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeStorageQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeStorageQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeStorageQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxContractBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxContractBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Builder) > 0 {
+		i -= len(m.Builder)
+		copy(dAtA[i:], m.Builder)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Builder)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
 		i -= len(m.CodeHash)
 		copy(dAtA[i:], m.CodeHash)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
@@ -1190,6 +2167,67 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractStorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Keys != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Keys))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxContractBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxContractBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1247,47 +2285,180 @@ func (m *Params) Size() (n int) {
 	if m.InstantiateDefaultPermission != 0 {
 		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
 	}
+	l = m.GasRegister.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.StorageQuota.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.StargateQueryAcceptList) > 0 {
+		for _, e := range m.StargateQueryAcceptList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.StargateMsgFilter.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
-func (m *CodeInfo) Size() (n int) {
+func (m *StargateMsgFilter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.AcceptList) > 0 {
+		for _, s := range m.AcceptList {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.DenyList) > 0 {
+		for _, s := range m.DenyList {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxMsgSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxMsgSize))
 	}
-	l = m.InstantiateConfig.Size()
-	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
-func (m *ContractInfo) Size() (n int) {
+func (m *AcceptedStargateQuery) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
-	}
-	l = len(m.Creator)
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Admin)
+	l = len(m.ResponseTypeURL)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Label)
-	if l > 0 {
+	return n
+}
+
+func (m *GasRegisterParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InstanceCost != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCost))
+	}
+	if m.CompileCost != 0 {
+		n += 1 + sovTypes(uint64(m.CompileCost))
+	}
+	if m.UncompressCostNumerator != 0 {
+		n += 1 + sovTypes(uint64(m.UncompressCostNumerator))
+	}
+	if m.UncompressCostDenominator != 0 {
+		n += 1 + sovTypes(uint64(m.UncompressCostDenominator))
+	}
+	if m.GasMultiplier != 0 {
+		n += 1 + sovTypes(uint64(m.GasMultiplier))
+	}
+	if m.EventPerAttributeCost != 0 {
+		n += 1 + sovTypes(uint64(m.EventPerAttributeCost))
+	}
+	if m.EventAttributeDataCost != 0 {
+		n += 1 + sovTypes(uint64(m.EventAttributeDataCost))
+	}
+	if m.EventAttributeDataFreeTier != 0 {
+		n += 1 + sovTypes(uint64(m.EventAttributeDataFreeTier))
+	}
+	if m.ContractMessageDataCost != 0 {
+		n += 1 + sovTypes(uint64(m.ContractMessageDataCost))
+	}
+	if m.CustomEventCost != 0 {
+		n += 1 + sovTypes(uint64(m.CustomEventCost))
+	}
+	return n
+}
+
+func (m *StorageQuotaParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxContractBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxContractBytes))
+	}
+	if len(m.CodeOverrides) > 0 {
+		for _, e := range m.CodeOverrides {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeStorageQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.MaxContractBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxContractBytes))
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.InstantiateConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	if m.Created != nil {
@@ -1360,6 +2531,33 @@ func (m *Model) Size() (n int) {
 	return n
 }
 
+func (m *ContractStorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		n += 1 + sovTypes(uint64(m.Bytes))
+	}
+	if m.Keys != 0 {
+		n += 1 + sovTypes(uint64(m.Keys))
+	}
+	return n
+}
+
+func (m *StorageQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxContractBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxContractBytes))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1516,13 +2714,841 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			}
+			m.InstantiateDefaultPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRegister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasRegister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StargateQueryAcceptList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StargateQueryAcceptList = append(m.StargateQueryAcceptList, AcceptedStargateQuery{})
+			if err := m.StargateQueryAcceptList[len(m.StargateQueryAcceptList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StargateMsgFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StargateMsgFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StargateMsgFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StargateMsgFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StargateMsgFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptList = append(m.AcceptList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenyList = append(m.DenyList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgSize", wireType)
+			}
+			m.MaxMsgSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AcceptedStargateQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptedStargateQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptedStargateQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GasRegisterParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasRegisterParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasRegisterParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCost", wireType)
+			}
+			m.InstanceCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompileCost", wireType)
+			}
+			m.CompileCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompileCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostNumerator", wireType)
+			}
+			m.UncompressCostNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostDenominator", wireType)
+			}
+			m.UncompressCostDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			m.GasMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventPerAttributeCost", wireType)
+			}
+			m.EventPerAttributeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventPerAttributeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataCost", wireType)
+			}
+			m.EventAttributeDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataFreeTier", wireType)
+			}
+			m.EventAttributeDataFreeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataFreeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMessageDataCost", wireType)
+			}
+			m.ContractMessageDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractMessageDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomEventCost", wireType)
+			}
+			m.CustomEventCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CustomEventCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StorageQuotaParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageQuotaParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageQuotaParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractBytes", wireType)
+			}
+			m.MaxContractBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeOverrides", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1532,23 +3558,25 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.CodeOverrides = append(m.CodeOverrides, CodeStorageQuota{})
+			if err := m.CodeOverrides[len(m.CodeOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1572,7 +3600,7 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CodeStorageQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1595,17 +3623,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeStorageQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeStorageQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			var msglen int
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1615,30 +3643,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractBytes", wireType)
 			}
-			m.InstantiateDefaultPermission = 0
+			m.MaxContractBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1648,7 +3662,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				m.MaxContractBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1803,6 +3817,70 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2462,6 +4540,165 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *ContractStorageUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStorageUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			m.Keys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StorageQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractBytes", wireType)
+			}
+			m.MaxContractBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -110,6 +110,23 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessConfig{} },
 			expError:   true,
 		},
+		"with verification info": {
+			srcMutator: func(c *CodeInfo) {
+				c.Source = "https://github.com/CosmWasm/cosmwasm"
+				c.Builder = "cosmwasm/rust-optimizer:0.12.6"
+			},
+		},
+		"source without builder": {
+			srcMutator: func(c *CodeInfo) { c.Source = "https://github.com/CosmWasm/cosmwasm" },
+			expError:   true,
+		},
+		"builder invalid": {
+			srcMutator: func(c *CodeInfo) {
+				c.Source = "https://github.com/CosmWasm/cosmwasm"
+				c.Builder = "invalid"
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -1,11 +1,26 @@
 package types
 
 import (
+	"crypto/sha256"
+	"net/url"
+	"regexp"
+
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 )
 
-// MaxSaltSize is the longest salt that can be used when instantiating a contract
-const MaxSaltSize = 64
+const (
+	// MaxSaltSize is the longest salt that can be used when instantiating a contract
+	MaxSaltSize = 64
+
+	// MaxBuilderSize is the longest builder tag that can be stored with a code
+	MaxBuilderSize = 128
+
+	// MaxSourceSize is the longest source url that can be stored with a code
+	MaxSourceSize = 256
+)
+
+// builderRegexp matches a docker image reference with a mandatory tag like `cosmwasm/rust-optimizer:0.12.6`
+var builderRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*[a-z0-9](/[a-z0-9][a-z0-9._-]*[a-z0-9])+:[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`)
 
 var (
 	// MaxLabelSize is the longest label that can be used when instantiating a contract
@@ -46,3 +61,55 @@ func ValidateSalt(salt []byte) error {
 	}
 	return nil
 }
+
+// ValidateVerificationInfo ensures the optional code verification metadata constraints.
+// Either all of source, builder and code hash are set or none. The code hash is compared with the
+// checksum of the stored code by the keeper.
+func ValidateVerificationInfo(source, builder string, codeHash []byte) error {
+	if source == "" && builder == "" && len(codeHash) == 0 {
+		return nil
+	}
+	if err := validateSource(source); err != nil {
+		return sdkerrors.Wrap(err, "source")
+	}
+	if err := validateBuilder(builder); err != nil {
+		return sdkerrors.Wrap(err, "builder")
+	}
+	if len(codeHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalid, "code hash must be %d bytes", sha256.Size)
+	}
+	return nil
+}
+
+func validateSource(s string) error {
+	if s == "" {
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	}
+	if len(s) > MaxSourceSize {
+		return ErrLimit.Wrapf("cannot be longer than %d characters", MaxSourceSize)
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalid, "not an url")
+	}
+	if !u.IsAbs() {
+		return sdkerrors.Wrap(ErrInvalid, "not an absolute url")
+	}
+	if u.Scheme != "https" {
+		return sdkerrors.Wrap(ErrInvalid, "must use https")
+	}
+	return nil
+}
+
+func validateBuilder(s string) error {
+	if s == "" {
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	}
+	if len(s) > MaxBuilderSize {
+		return ErrLimit.Wrapf("cannot be longer than %d characters", MaxBuilderSize)
+	}
+	if !builderRegexp.MatchString(s) {
+		return sdkerrors.Wrap(ErrInvalid, "not a docker image reference with tag")
+	}
+	return nil
+}
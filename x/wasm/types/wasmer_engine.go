@@ -236,6 +236,12 @@ type WasmerEngine interface {
 	// Unpin is idempotent.
 	Unpin(checksum wasmvm.Checksum) error
 
+	// RemoveCode releases the resources of the given checksum in the engine. Implementations that
+	// support it may also delete the original code and the compiled artifacts.
+	// The caller must ensure that no code id references the checksum anymore.
+	// RemoveCode is idempotent.
+	RemoveCode(checksum wasmvm.Checksum) error
+
 	// GetMetrics some internal metrics for monitoring purposes.
 	GetMetrics() (*wasmvmtypes.Metrics, error)
 }
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -14,6 +16,12 @@ import (
 	"github.com/Finschia/wasmd/x/wasmplus/types"
 )
 
+const (
+	flagDisableQueries = "disable-queries"
+	flagExpiryHeight   = "expiry-height"
+	flagExpiryTime     = "expiry-time"
+)
+
 func ProposalDeactivateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deactivate-contract [contract_addr_bech32]",
@@ -41,11 +49,35 @@ func ProposalDeactivateContractCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			disableQueries, err := cmd.Flags().GetBool(flagDisableQueries)
+			if err != nil {
+				return fmt.Errorf("disable queries: %s", err)
+			}
+			expiryHeight, err := cmd.Flags().GetUint64(flagExpiryHeight)
+			if err != nil {
+				return fmt.Errorf("expiry height: %s", err)
+			}
+			expiryTimeArg, err := cmd.Flags().GetString(flagExpiryTime)
+			if err != nil {
+				return fmt.Errorf("expiry time: %s", err)
+			}
+			var expiryTime *time.Time
+			if expiryTimeArg != "" {
+				t, err := time.Parse(time.RFC3339, expiryTimeArg)
+				if err != nil {
+					return fmt.Errorf("expiry time: %s", err)
+				}
+				t = t.UTC()
+				expiryTime = &t
+			}
 
 			content := types.DeactivateContractProposal{
-				Title:       proposalTitle,
-				Description: proposalDescr,
-				Contract:    args[0],
+				Title:          proposalTitle,
+				Description:    proposalDescr,
+				Contract:       args[0],
+				DisableQueries: disableQueries,
+				ExpiryHeight:   expiryHeight,
+				ExpiryTime:     expiryTime,
 			}
 
 			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
@@ -63,6 +95,9 @@ func ProposalDeactivateContractCmd() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().Bool(flagDisableQueries, false, "Reject smart queries to the contract while it is inactive")
+	cmd.Flags().Uint64(flagExpiryHeight, 0, "Block height at which the contract is activated again automatically")
+	cmd.Flags().String(flagExpiryTime, "", "Block time in RFC3339 format at which the contract is activated again automatically")
 
 	return cmd
 }
@@ -119,3 +154,125 @@ func ProposalActivateContractCmd() *cobra.Command {
 
 	return cmd
 }
+
+func ProposalDeactivateCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deactivate-code [code_id]",
+		Short: "Deactivate the code. All contracts of the code will not be executed and no contract can be instantiated from it after that.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("code id: %s", err)
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			disableQueries, err := cmd.Flags().GetBool(flagDisableQueries)
+			if err != nil {
+				return fmt.Errorf("disable queries: %s", err)
+			}
+
+			content := types.DeactivateCodeProposal{
+				Title:          proposalTitle,
+				Description:    proposalDescr,
+				CodeID:         codeID,
+				DisableQueries: disableQueries,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().Bool(flagDisableQueries, false, "Reject smart queries to the contracts of the code while it is inactive")
+
+	return cmd
+}
+
+func ProposalActivateCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activate-code [code_id]",
+		Short: "Activate the inactive code. Contracts of the code will be executed after that.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("code id: %s", err)
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+
+			content := types.ActivateCodeProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				CodeID:      codeID,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+
+	return cmd
+}
@@ -25,17 +25,25 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		wasmcli.GetCmdListCode(),
 		wasmcli.GetCmdListContractByCode(),
+		wasmcli.GetCmdListContractsByCreator(),
 		wasmcli.GetCmdQueryCode(),
 		wasmcli.GetCmdQueryCodeInfo(),
+		wasmcli.GetCmdQueryCodeInfoByChecksum(),
+		wasmcli.GetCmdVerifyCode(),
 		wasmcli.GetCmdGetContractInfo(),
 		wasmcli.GetCmdGetContractHistory(),
 		wasmcli.GetCmdGetContractState(),
+		wasmcli.GetCmdGetContractStorageUsage(),
 		wasmcli.GetCmdListPinnedCode(),
 		wasmcli.GetCmdLibVersion(),
 		wasmcli.GetCmdQueryParams(),
+		wasmcli.GetCmdQueryStargateQueryAcceptList(),
+		wasmcli.GetCmdQueryStargateMsgFilter(),
 		wasmcli.GetCmdBuildAddress(),
+		wasmcli.GetCmdSimulateExecute(),
 		GetCmdListInactiveContracts(),
 		GetCmdIsInactiveContract(),
+		GetCmdListInactiveCodes(),
 	)
 	return queryCmd
 }
@@ -117,3 +125,36 @@ func GetCmdIsInactiveContract() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdListInactiveCodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "inactive-codes",
+		Long: "List all inactive codes",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.InactiveCodes(
+				context.Background(),
+				&types.QueryInactiveCodesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list of inactive codes")
+	return cmd
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"strconv"
@@ -27,6 +29,9 @@ const (
 	flagAdmin                  = "admin"
 	flagInstantiateByEverybody = "instantiate-everybody"
 	flagInstantiateByAddress   = "instantiate-only-address"
+	flagSource                 = "code-source-url"
+	flagBuilder                = "builder"
+	flagCodeHash               = "code-hash"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -43,10 +48,15 @@ func GetTxCmd() *cobra.Command {
 		wasmcli.InstantiateContractCmd(),
 		wasmcli.InstantiateContract2Cmd(),
 		StoreCodeAndInstantiateContractCmd(),
+		StoreCodeAndMigrateContractCmd(),
 		wasmcli.ExecuteContractCmd(),
 		wasmcli.MigrateContractCmd(),
 		wasmcli.UpdateContractAdminCmd(),
 		wasmcli.ClearContractAdminCmd(),
+		wasmcli.UpdateInstantiateConfigCmd(),
+		wasmcli.RemoveCodeCmd(),
+		PauseContractCmd(),
+		UnpauseContractCmd(),
 	)
 	return txCmd
 }
@@ -78,53 +88,22 @@ func StoreCodeAndInstantiateContractCmd() *cobra.Command {
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
 	cmd.Flags().String(flagAdmin, "", "Address of an admin")
+	cmd.Flags().String(flagSource, "", "Code Source URL is a valid absolute HTTPS URI to the contract's source code, optional")
+	cmd.Flags().String(flagBuilder, "", "Builder is a valid docker image name with tag, optional")
+	cmd.Flags().BytesHex(flagCodeHash, nil, "CodeHash is the sha256 hash of the wasm code, optional")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
 func parseStoreCodeAndInstantiateContractArgs(file string, initMsg string, sender sdk.AccAddress, flags *flag.FlagSet) (types.MsgStoreCodeAndInstantiateContract, error) {
-	wasm, err := os.ReadFileWithSizeLimit(file, int64(wasmTypes.MaxWasmSize))
+	wasm, err := readWasmFile(file)
 	if err != nil {
 		return types.MsgStoreCodeAndInstantiateContract{}, err
 	}
 
-	// gzip the wasm file
-	if ioutils.IsWasm(wasm) {
-		wasm, err = ioutils.GzipIt(wasm)
-
-		if err != nil {
-			return types.MsgStoreCodeAndInstantiateContract{}, err
-		}
-	} else if !ioutils.IsGzip(wasm) {
-		return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("invalid input file. Use wasm binary or gzip")
-	}
-
-	var perm *wasmTypes.AccessConfig
-	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	perm, err := parseInstantiatePermissionFlags(flags)
 	if err != nil {
-		return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("instantiate by address: %s", err)
-	}
-	if onlyAddrStr != "" {
-		addr, err := sdk.AccAddressFromBech32(onlyAddrStr)
-		if err != nil {
-			return types.MsgStoreCodeAndInstantiateContract{}, sdkerrors.Wrap(err, flagInstantiateByAddress)
-		}
-		x := wasmTypes.AccessTypeOnlyAddress.With(addr)
-		perm = &x
-	} else {
-		everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
-		if err != nil {
-			return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("instantiate by everybody: %s", err)
-		}
-		if everybodyStr != "" {
-			ok, err := strconv.ParseBool(everybodyStr)
-			if err != nil {
-				return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
-			}
-			if ok {
-				perm = &wasmTypes.AllowEverybody
-			}
-		}
+		return types.MsgStoreCodeAndInstantiateContract{}, err
 	}
 
 	amountStr, err := flags.GetString(flagAmount)
@@ -146,6 +125,10 @@ func parseStoreCodeAndInstantiateContractArgs(file string, initMsg string, sende
 	if err != nil {
 		return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("admin: %s", err)
 	}
+	source, builder, codeHash, err := parseVerificationFlags(wasm, flags)
+	if err != nil {
+		return types.MsgStoreCodeAndInstantiateContract{}, err
+	}
 
 	msg := types.MsgStoreCodeAndInstantiateContract{
 		Sender:                sender.String(),
@@ -155,6 +138,189 @@ func parseStoreCodeAndInstantiateContractArgs(file string, initMsg string, sende
 		Funds:                 amount,
 		Msg:                   []byte(initMsg),
 		Admin:                 adminStr,
+		Source:                source,
+		Builder:               builder,
+		CodeHash:              codeHash,
+	}
+	return msg, nil
+}
+
+// parseVerificationFlags reads the optional code verification flags. The code hash is compared with the
+// checksum of the given gzipped wasm code.
+func parseVerificationFlags(gzippedWasm []byte, flags *flag.FlagSet) (string, string, []byte, error) {
+	source, err := flags.GetString(flagSource)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("source: %s", err)
+	}
+	builder, err := flags.GetString(flagBuilder)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("builder: %s", err)
+	}
+	codeHash, err := flags.GetBytesHex(flagCodeHash)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("code hash: %s", err)
+	}
+	if err := wasmTypes.ValidateVerificationInfo(source, builder, codeHash); err != nil {
+		return "", "", nil, err
+	}
+	if len(codeHash) != 0 {
+		wasm, err := ioutils.Uncompress(gzippedWasm, uint64(wasmTypes.MaxWasmSize))
+		if err != nil {
+			return "", "", nil, err
+		}
+		if checksum := sha256.Sum256(wasm); !bytes.Equal(checksum[:], codeHash) {
+			return "", "", nil, fmt.Errorf("code hash mismatch: %X, checksum: %X", codeHash, checksum)
+		}
+	}
+	return source, builder, codeHash, nil
+}
+
+// StoreCodeAndMigrateContractCmd will upload code and migrate a contract to it
+func StoreCodeAndMigrateContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-migrate [wasm file] [contract_addr_bech32] [json_encoded_migration_args]",
+		Short: "Upload a wasm binary and migrate a wasm contract to the code",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg, err := parseStoreCodeAndMigrateContractArgs(args[0], args[1], args[2], clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
+	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseStoreCodeAndMigrateContractArgs(file string, contractAddr string, migrateMsg string, sender sdk.AccAddress, flags *flag.FlagSet) (types.MsgStoreCodeAndMigrateContract, error) {
+	wasm, err := readWasmFile(file)
+	if err != nil {
+		return types.MsgStoreCodeAndMigrateContract{}, err
+	}
+
+	perm, err := parseInstantiatePermissionFlags(flags)
+	if err != nil {
+		return types.MsgStoreCodeAndMigrateContract{}, err
+	}
+
+	msg := types.MsgStoreCodeAndMigrateContract{
+		Sender:                sender.String(),
+		WASMByteCode:          wasm,
+		InstantiatePermission: perm,
+		Contract:              contractAddr,
+		Msg:                   []byte(migrateMsg),
 	}
 	return msg, nil
 }
+
+// PauseContractCmd deactivates a contract by its admin
+func PauseContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-contract [contract_addr_bech32]",
+		Short: "Pause a contract in an emergency. Only the contract admin can send it.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgPauseContract{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UnpauseContractCmd activates a contract paused by its admin
+func UnpauseContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause-contract [contract_addr_bech32]",
+		Short: "Unpause a contract paused by its admin. A contract deactivated by governance can not be unpaused.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnpauseContract{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readWasmFile reads the wasm file and gzips it when it is not compressed yet
+func readWasmFile(file string) ([]byte, error) {
+	wasm, err := os.ReadFileWithSizeLimit(file, int64(wasmTypes.MaxWasmSize))
+	if err != nil {
+		return nil, err
+	}
+
+	// gzip the wasm file
+	if ioutils.IsWasm(wasm) {
+		wasm, err = ioutils.GzipIt(wasm)
+
+		if err != nil {
+			return nil, err
+		}
+	} else if !ioutils.IsGzip(wasm) {
+		return nil, fmt.Errorf("invalid input file. Use wasm binary or gzip")
+	}
+	return wasm, nil
+}
+
+func parseInstantiatePermissionFlags(flags *flag.FlagSet) (*wasmTypes.AccessConfig, error) {
+	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by address: %s", err)
+	}
+	if onlyAddrStr != "" {
+		addr, err := sdk.AccAddressFromBech32(onlyAddrStr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, flagInstantiateByAddress)
+		}
+		x := wasmTypes.AccessTypeOnlyAddress.With(addr)
+		return &x, nil
+	}
+	everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by everybody: %s", err)
+	}
+	if everybodyStr != "" {
+		ok, err := strconv.ParseBool(everybodyStr)
+		if err != nil {
+			return nil, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
+		}
+		if ok {
+			return &wasmTypes.AllowEverybody, nil
+		}
+	}
+	return nil, nil
+}
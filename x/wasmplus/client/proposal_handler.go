@@ -20,6 +20,11 @@ var ProposalHandlers = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(wasmcli.ProposalPinCodesCmd),
 	govclient.NewProposalHandler(wasmcli.ProposalUnpinCodesCmd),
 	govclient.NewProposalHandler(wasmcli.ProposalUpdateInstantiateConfigCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalSetContractStorageQuotaCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalStoreAndInstantiateContractCmd),
+	govclient.NewProposalHandler(wasmcli.ProposalUpdateParamsCmd),
 	govclient.NewProposalHandler(cli.ProposalDeactivateContractCmd),
 	govclient.NewProposalHandler(cli.ProposalActivateContractCmd),
+	govclient.NewProposalHandler(cli.ProposalDeactivateCodeCmd),
+	govclient.NewProposalHandler(cli.ProposalActivateCodeCmd),
 }
@@ -30,6 +30,51 @@ func (s *IntegrationTestSuite) TestProposalDeactivateContractCmd() {
 			},
 			true,
 		},
+		"valid deactivateContract proposal with disabled queries": {
+			[]string{
+				s.contractAddress,
+				"--disable-queries",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "My Proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Test proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, initialDeposit),
+			},
+			true,
+		},
+		"valid deactivateContract proposal with expiry height": {
+			[]string{
+				s.contractAddress,
+				"--expiry-height=1000000",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "My Proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Test proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, initialDeposit),
+			},
+			true,
+		},
+		"invalid expiry time": {
+			[]string{
+				s.contractAddress,
+				"--expiry-time=tomorrow",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "My Proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Test proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, initialDeposit),
+			},
+			false,
+		},
+		"both expiry height and time": {
+			[]string{
+				s.contractAddress,
+				"--expiry-height=1000000",
+				"--expiry-time=2100-01-01T00:00:00Z",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "My Proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Test proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, initialDeposit),
+			},
+			false,
+		},
 		"no proposer": {
 			[]string{
 				s.contractAddress,
@@ -129,3 +174,62 @@ func (s *IntegrationTestSuite) TestProposalActivateContractCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestProposalDeactivateCodeCmd() {
+	val := s.network.Validators[0]
+	initialDeposit := sdk.NewCoin(s.cfg.BondDenom, types.DefaultMinDepositTokens.Sub(sdk.NewInt(20))).String()
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid deactivateCode proposal": {
+			[]string{
+				s.codeID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "My Proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Test proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, initialDeposit),
+			},
+			true,
+		},
+		"invalid code id": {
+			[]string{
+				"xxx",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "My Proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Test proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, initialDeposit),
+			},
+			false,
+		},
+		"no proposer": {
+			[]string{
+				s.codeID,
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "My Proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Test proposal"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, initialDeposit),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.ProposalDeactivateCodeCmd()
+			flags.AddTxFlagsToCmd(cmd)
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
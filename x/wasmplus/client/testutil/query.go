@@ -77,3 +77,19 @@ func (s *IntegrationTestSuite) TestGetCmdIsInactiveContract() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdListInactiveCodes() {
+	val := s.network.Validators[0]
+
+	cmd := cli.GetCmdListInactiveCodes()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, s.queryCommonArgs())
+	s.Require().NoError(err)
+
+	expected := &types.QueryInactiveCodesResponse{
+		CodeIDs:    []uint64{},
+		Pagination: &query.PageResponse{},
+	}
+	var resInfo types.QueryInactiveCodesResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &resInfo), out.String())
+	s.Require().Equal(expected, &resInfo)
+}
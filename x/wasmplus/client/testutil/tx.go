@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/spf13/cobra"
+
 	"github.com/Finschia/finschia-sdk/client/flags"
 	clitestutil "github.com/Finschia/finschia-sdk/testutil/cli"
 	sdk "github.com/Finschia/finschia-sdk/types"
@@ -88,3 +90,145 @@ func (s *IntegrationTestSuite) TestStoreCodeAndInstantiateContractCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestStoreCodeAndMigrateContractCmd() {
+	val := s.network.Validators[0]
+
+	wasmPath := "../../../wasm/keeper/testdata/hackatom.wasm"
+	_, err := os.ReadFile(wasmPath)
+	s.Require().NoError(err)
+
+	params := fmt.Sprintf("{\"verifier\": \"%s\"}", s.verifier)
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid storeCodeAndMigrateContract": {
+			[]string{
+				wasmPath,
+				s.contractAddress,
+				params,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%d", flags.FlagGas, 1600000),
+			},
+			true,
+		},
+		"wrong args count": {
+			[]string{"0"},
+			false,
+		},
+		"no sender error": {
+			[]string{
+				wasmPath,
+				s.contractAddress,
+				params,
+			},
+			false,
+		},
+		"invalid contract address error": {
+			[]string{
+				wasmPath,
+				"invalid",
+				params,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%d", flags.FlagGas, 1600000),
+			},
+			false,
+		},
+		"wrong wasm path error": {
+			[]string{
+				"../../keeper/testdata/noexist.wasm",
+				s.contractAddress,
+				params,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%d", flags.FlagGas, 1600000),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.StoreCodeAndMigrateContractCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestPauseAndUnpauseContractCmd() {
+	val := s.network.Validators[0]
+
+	params := fmt.Sprintf("{\"verifier\": \"%s\", \"beneficiary\": \"%s\"}", s.verifier, wasmkeeper.RandomAccountAddress(s.T()))
+	contractAddress := s.instantiate(s.codeID, params)
+
+	// the cases run in order, as the unpause depends on the pause
+	testCases := []struct {
+		name  string
+		cmd   func() *cobra.Command
+		args  []string
+		valid bool
+	}{
+		{
+			"valid pause",
+			cli.PauseContractCmd,
+			[]string{
+				contractAddress,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			true,
+		},
+		{
+			"valid unpause",
+			cli.UnpauseContractCmd,
+			[]string{
+				contractAddress,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			true,
+		},
+		{
+			"wrong args count",
+			cli.PauseContractCmd,
+			[]string{},
+			false,
+		},
+		{
+			"invalid contract address error",
+			cli.PauseContractCmd,
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tc.cmd(), append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
@@ -6,12 +6,11 @@ import (
 	sdk "github.com/Finschia/finschia-sdk/types"
 
 	"github.com/Finschia/wasmd/x/wasm"
-	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
 	"github.com/Finschia/wasmd/x/wasmplus/keeper"
 	"github.com/Finschia/wasmd/x/wasmplus/types"
 )
 
-func NewHandler(k wasmtypes.ContractOpsKeeper) sdk.Handler {
+func NewHandler(k types.ContractOpsKeeper) sdk.Handler {
 	msgServer := keeper.NewMsgServerImpl(k)
 	wasmHandler := wasm.NewHandler(k)
 
@@ -23,6 +22,12 @@ func NewHandler(k wasmtypes.ContractOpsKeeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case *types.MsgStoreCodeAndInstantiateContract:
 			res, err = msgServer.StoreCodeAndInstantiateContract(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgStoreCodeAndMigrateContract:
+			res, err = msgServer.StoreCodeAndMigrateContract(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgPauseContract:
+			res, err = msgServer.PauseContract(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgUnpauseContract:
+			res, err = msgServer.UnpauseContract(sdk.WrapSDKContext(ctx), msg)
 		default:
 			return wasmHandler(ctx, msg)
 		}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 
@@ -14,8 +16,18 @@ type decoratedKeeper interface {
 	types.ViewKeeper
 	activateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
 	deactivateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	disableContractQueries(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	updateInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, reason string, expiryHeight uint64, expiryTime *time.Time) error
+	pauseContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+	unpauseContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+	activateCode(ctx sdk.Context, codeID uint64) error
+	deactivateCode(ctx sdk.Context, codeID uint64) error
+	disableCodeQueries(ctx sdk.Context, codeID uint64) error
+	deleteInactiveCode(ctx sdk.Context, codeID uint64)
 }
 
+// PermissionedKeeper rejects the admin updates of inactive contracts. The calls into inactive contracts are
+// rejected by the wasm keeper itself, see inactiveContractChecker.
 type PermissionedKeeper struct {
 	wasmkeeper.PermissionedKeeper
 	extended decoratedKeeper
@@ -25,20 +37,6 @@ func NewPermissionedKeeper(k wasmkeeper.PermissionedKeeper, extended decoratedKe
 	return &PermissionedKeeper{k, extended}
 }
 
-func (p PermissionedKeeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
-	if p.extended.IsInactiveContract(ctx, contractAddress) {
-		return nil, sdkerrors.Wrap(types.ErrInactiveContract, "can not execute")
-	}
-	return p.PermissionedKeeper.Execute(ctx, contractAddress, caller, msg, coins)
-}
-
-func (p PermissionedKeeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte) ([]byte, error) {
-	if p.extended.IsInactiveContract(ctx, contractAddress) {
-		return nil, sdkerrors.Wrap(types.ErrInactiveContract, "can not execute")
-	}
-	return p.PermissionedKeeper.Migrate(ctx, contractAddress, caller, newCodeID, msg)
-}
-
 func (p PermissionedKeeper) UpdateContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newAdmin sdk.AccAddress) error {
 	if p.extended.IsInactiveContract(ctx, contractAddress) {
 		return sdkerrors.Wrap(types.ErrInactiveContract, "can not execute")
@@ -60,3 +58,41 @@ func (p PermissionedKeeper) DeactivateContract(ctx sdk.Context, contractAddress
 func (p PermissionedKeeper) ActivateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
 	return p.extended.activateContract(ctx, contractAddress)
 }
+
+func (p PermissionedKeeper) DisableContractQueries(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	return p.extended.disableContractQueries(ctx, contractAddress)
+}
+
+func (p PermissionedKeeper) UpdateInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, reason string, expiryHeight uint64, expiryTime *time.Time) error {
+	return p.extended.updateInactiveContractInfo(ctx, contractAddress, reason, expiryHeight, expiryTime)
+}
+
+func (p PermissionedKeeper) PauseContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return p.extended.pauseContract(ctx, contractAddress, caller)
+}
+
+func (p PermissionedKeeper) UnpauseContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return p.extended.unpauseContract(ctx, contractAddress, caller)
+}
+
+func (p PermissionedKeeper) DeactivateCode(ctx sdk.Context, codeID uint64) error {
+	return p.extended.deactivateCode(ctx, codeID)
+}
+
+func (p PermissionedKeeper) ActivateCode(ctx sdk.Context, codeID uint64) error {
+	return p.extended.activateCode(ctx, codeID)
+}
+
+func (p PermissionedKeeper) DisableCodeQueries(ctx sdk.Context, codeID uint64) error {
+	return p.extended.disableCodeQueries(ctx, codeID)
+}
+
+// RemoveCode deletes the code and drops it from the inactiveCode list, so that no entry is left for a code id
+// that does not exist anymore.
+func (p PermissionedKeeper) RemoveCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress) error {
+	if err := p.PermissionedKeeper.RemoveCode(ctx, codeID, caller); err != nil {
+		return err
+	}
+	p.extended.deleteInactiveCode(ctx, codeID)
+	return nil
+}
@@ -5,9 +5,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
 	wasmkeeper "github.com/Finschia/wasmd/x/wasm/keeper"
+	"github.com/Finschia/wasmd/x/wasmplus/types"
 )
 
 func mustMarshal(t *testing.T, r interface{}) []byte {
@@ -81,3 +86,86 @@ func TestInactivateContract(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestInactivateCode(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	otherContract := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newVerifier := RandomAccountAddress(t)
+	migrateMsg := []byte(fmt.Sprintf("{\"verifier\":\"%s\"}", newVerifier.String()))
+	initMsg := HackatomExampleInitMsg{Verifier: newVerifier, Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+
+	contractKeeper := NewPermissionedKeeper(*wasmkeeper.NewDefaultPermissionKeeper(keepers.WasmKeeper), keepers.WasmKeeper)
+
+	// set deactivate
+	err := contractKeeper.DeactivateCode(parentCtx, example.CodeID)
+	require.NoError(t, err)
+
+	// deactivate state
+	{
+		// check instantiate
+		_, _, err = contractKeeper.Instantiate(parentCtx, example.CodeID, example.CreatorAddr, nil, initMsg, "label", nil)
+		require.ErrorIs(t, err, types.ErrInactiveCode)
+
+		// check instantiate2
+		_, _, err = contractKeeper.Instantiate2(parentCtx, example.CodeID, example.CreatorAddr, nil, initMsg, "label", nil, []byte("salt"), false)
+		require.ErrorIs(t, err, types.ErrInactiveCode)
+
+		// check execute
+		_, err = contractKeeper.Execute(parentCtx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+		require.ErrorIs(t, err, types.ErrInactiveContract)
+
+		// check receiving funds
+		err = keepers.BankKeeper.SendCoins(parentCtx, example.CreatorAddr, example.Contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+		// contracts of other codes are not affected
+		_, err = contractKeeper.Execute(parentCtx, otherContract.Contract, otherContract.VerifierAddr, []byte(`{"release":{}}`), nil)
+		require.NoError(t, err)
+		err = keepers.BankKeeper.SendCoins(parentCtx, otherContract.CreatorAddr, otherContract.Contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+		require.NoError(t, err)
+	}
+
+	// a contract can not be migrated onto the inactive code
+	_, err = contractKeeper.Migrate(parentCtx, otherContract.Contract, otherContract.CreatorAddr, example.CodeID, migrateMsg)
+	require.ErrorIs(t, err, types.ErrInactiveCode)
+	assert.Equal(t, otherContract.CodeID, keepers.WasmKeeper.GetContractInfo(parentCtx, otherContract.Contract).CodeID)
+	assert.False(t, keepers.WasmKeeper.IsInactiveContract(parentCtx, otherContract.Contract))
+
+	// set activate
+	err = contractKeeper.ActivateCode(parentCtx, example.CodeID)
+	require.NoError(t, err)
+
+	// activate state
+	{
+		// check instantiate
+		_, _, err = contractKeeper.Instantiate(parentCtx, example.CodeID, example.CreatorAddr, nil, initMsg, "label", nil)
+		require.NoError(t, err)
+
+		// check execute
+		_, err = contractKeeper.Execute(parentCtx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+		require.NoError(t, err)
+
+		// check receiving funds
+		err = keepers.BankKeeper.SendCoins(parentCtx, example.CreatorAddr, example.Contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+		require.NoError(t, err)
+
+		// check migrate
+		_, err = contractKeeper.Migrate(parentCtx, otherContract.Contract, otherContract.CreatorAddr, example.CodeID, migrateMsg)
+		require.NoError(t, err)
+	}
+}
+
+func TestRemoveInactiveCode(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	contractKeeper := NewPermissionedKeeper(*wasmkeeper.NewDefaultPermissionKeeper(keepers.WasmKeeper), keepers.WasmKeeper)
+	require.NoError(t, contractKeeper.DeactivateCode(ctx, example.CodeID))
+
+	// when
+	require.NoError(t, contractKeeper.RemoveCode(ctx, example.CodeID, example.CreatorAddr))
+
+	// then
+	assert.Nil(t, keepers.WasmKeeper.GetCodeInfo(ctx, example.CodeID))
+	assert.False(t, keepers.WasmKeeper.IsInactiveCode(ctx, example.CodeID))
+}
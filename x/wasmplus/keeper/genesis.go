@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 
 	wasmkeeper "github.com/Finschia/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
 	"github.com/Finschia/wasmd/x/wasmplus/types"
 )
 
@@ -31,6 +32,38 @@ func InitGenesis(
 		}
 	}
 
+	// set InactiveCodeIDs
+	for i, codeID := range data.InactiveCodeIDs {
+		if err = keeper.deactivateCode(ctx, codeID); err != nil {
+			return nil, sdkerrors.Wrapf(err, "inactive code number %d", i)
+		}
+	}
+
+	// set QueryDisabledContractAddresses
+	for i, contractAddr := range data.QueryDisabledContractAddresses {
+		if err = keeper.disableContractQueries(ctx, sdk.MustAccAddressFromBech32(contractAddr)); err != nil {
+			return nil, sdkerrors.Wrapf(err, "query disabled contract number %d", i)
+		}
+	}
+
+	// set AdminPausedContractAddresses
+	for i, contractAddr := range data.AdminPausedContractAddresses {
+		pausedContractAddr := sdk.MustAccAddressFromBech32(contractAddr)
+		if !keeper.hasInactiveContract(ctx, pausedContractAddr) {
+			return nil, sdkerrors.Wrapf(wasmtypes.ErrNotFound, "admin paused contract number %d: no inactivate contract", i)
+		}
+		keeper.addAdminPausedContract(ctx, pausedContractAddr)
+	}
+
+	// set InactiveContractInfos
+	for i, info := range data.InactiveContractInfos {
+		inactiveContractAddr := sdk.MustAccAddressFromBech32(info.Address)
+		if !keeper.hasInactiveContract(ctx, inactiveContractAddr) {
+			return nil, sdkerrors.Wrapf(wasmtypes.ErrNotFound, "inactive contract info number %d: no inactivate contract", i)
+		}
+		keeper.setInactiveContractInfo(ctx, inactiveContractAddr, info)
+	}
+
 	return result, nil
 }
 
@@ -50,6 +83,22 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		genState.InactiveContractAddresses = append(genState.InactiveContractAddresses, contractAddr.String())
 		return false
 	})
+	keeper.IterateQueryDisabledContracts(ctx, func(contractAddr sdk.AccAddress) (stop bool) {
+		genState.QueryDisabledContractAddresses = append(genState.QueryDisabledContractAddresses, contractAddr.String())
+		return false
+	})
+	keeper.IterateAdminPausedContracts(ctx, func(contractAddr sdk.AccAddress) (stop bool) {
+		genState.AdminPausedContractAddresses = append(genState.AdminPausedContractAddresses, contractAddr.String())
+		return false
+	})
+	keeper.IterateInactiveContractInfos(ctx, func(info types.InactiveContractInfo) (stop bool) {
+		genState.InactiveContractInfos = append(genState.InactiveContractInfos, info)
+		return false
+	})
+	keeper.IterateInactiveCodes(ctx, func(codeID uint64) (stop bool) {
+		genState.InactiveCodeIDs = append(genState.InactiveCodeIDs, codeID)
+		return false
+	})
 
 	return &genState
 }
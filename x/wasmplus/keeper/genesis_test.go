@@ -112,6 +112,32 @@ func TestGenesisExportImport(t *testing.T) {
 		return false
 	})
 
+	// disable queries of the first inactive contract
+	require.NoError(t, contractKeeper.DisableContractQueries(srcCtx, inactiveContractAddr[0]))
+
+	// schedule the activation of the second inactive contract
+	expiryTime := srcCtx.BlockTime().Add(time.Hour).UTC()
+	require.NoError(t, contractKeeper.UpdateInactiveContractInfo(srcCtx, inactiveContractAddr[1], "reason", 0, &expiryTime))
+
+	// let the admin pause the last inactive contract
+	pausedContractAddr := inactiveContractAddr[len(inactiveContractAddr)-1]
+	pausedContractAdmin, err := sdk.AccAddressFromBech32(wasmKeeper.GetContractInfo(srcCtx, pausedContractAddr).Admin)
+	require.NoError(t, err)
+	require.NoError(t, contractKeeper.ActivateContract(srcCtx, pausedContractAddr))
+	require.NoError(t, contractKeeper.PauseContract(srcCtx, pausedContractAddr, pausedContractAdmin))
+
+	var inactiveContractInfos []types.InactiveContractInfo
+	wasmKeeper.IterateInactiveContractInfos(srcCtx, func(info types.InactiveContractInfo) bool {
+		inactiveContractInfos = append(inactiveContractInfos, info)
+		return false
+	})
+
+	// add inactiveCode
+	inactiveCodeIDs := []uint64{1, 3}
+	for _, codeID := range inactiveCodeIDs {
+		require.NoError(t, contractKeeper.DeactivateCode(srcCtx, codeID))
+	}
+
 	// export
 	exportedState := ExportGenesis(srcCtx, wasmKeeper)
 	exportedGenesis, err := wasmKeeper.cdc.MarshalJSON(exportedState)
@@ -137,6 +163,36 @@ func TestGenesisExportImport(t *testing.T) {
 		return false
 	})
 	require.Equal(t, inactiveContractAddr, destInactiveContractAddr)
+
+	var destQueryDisabledContractAddr []sdk.AccAddress
+	dstKeeper.IterateQueryDisabledContracts(dstCtx, func(contractAddress sdk.AccAddress) (stop bool) {
+		destQueryDisabledContractAddr = append(destQueryDisabledContractAddr, contractAddress)
+		return false
+	})
+	require.Equal(t, inactiveContractAddr[:1], destQueryDisabledContractAddr)
+
+	var destInactiveContractInfos []types.InactiveContractInfo
+	dstKeeper.IterateInactiveContractInfos(dstCtx, func(info types.InactiveContractInfo) (stop bool) {
+		destInactiveContractInfos = append(destInactiveContractInfos, info)
+		return false
+	})
+	require.Equal(t, inactiveContractInfos, destInactiveContractInfos)
+	dstKeeper.ActivateExpiredContracts(dstCtx.WithBlockTime(expiryTime))
+	require.False(t, dstKeeper.IsInactiveContract(dstCtx, inactiveContractAddr[1]))
+
+	var destAdminPausedContractAddr []sdk.AccAddress
+	dstKeeper.IterateAdminPausedContracts(dstCtx, func(contractAddress sdk.AccAddress) (stop bool) {
+		destAdminPausedContractAddr = append(destAdminPausedContractAddr, contractAddress)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{pausedContractAddr}, destAdminPausedContractAddr)
+
+	var destInactiveCodeIDs []uint64
+	dstKeeper.IterateInactiveCodes(dstCtx, func(codeID uint64) (stop bool) {
+		destInactiveCodeIDs = append(destInactiveCodeIDs, codeID)
+		return false
+	})
+	require.Equal(t, inactiveCodeIDs, destInactiveCodeIDs)
 }
 
 func TestGenesisInit(t *testing.T) {
@@ -444,6 +500,103 @@ func TestGenesisInit(t *testing.T) {
 			},
 			expSuccess: true,
 		},
+		"happy path: inactiveContract with disabled queries": {
+			src: types.GenesisState{
+				Codes: []wasmTypes.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []wasmTypes.Contract{
+					{
+						ContractAddress: keeper.BuildContractAddressClassic(1, 1).String(),
+						ContractInfo:    wasmTypes.ContractInfoFixture(func(c *wasmTypes.ContractInfo) { c.CodeID = 1 }, wasmTypes.OnlyGenesisFields),
+					},
+				},
+				Sequences: []wasmTypes.Sequence{
+					{IDKey: wasmTypes.KeyLastCodeID, Value: 2},
+					{IDKey: wasmTypes.KeyLastInstanceID, Value: 3},
+				},
+				Params:                         wasmTypes.DefaultParams(),
+				InactiveContractAddresses:      []string{keeper.BuildContractAddressClassic(1, 1).String()},
+				QueryDisabledContractAddresses: []string{keeper.BuildContractAddressClassic(1, 1).String()},
+			},
+			expSuccess: true,
+		},
+		"happy path: contract of an inactive code with disabled queries": {
+			src: types.GenesisState{
+				Codes: []wasmTypes.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []wasmTypes.Contract{
+					{
+						ContractAddress: keeper.BuildContractAddressClassic(1, 1).String(),
+						ContractInfo:    wasmTypes.ContractInfoFixture(func(c *wasmTypes.ContractInfo) { c.CodeID = 1 }, wasmTypes.OnlyGenesisFields),
+					},
+				},
+				Sequences: []wasmTypes.Sequence{
+					{IDKey: wasmTypes.KeyLastCodeID, Value: 2},
+					{IDKey: wasmTypes.KeyLastInstanceID, Value: 3},
+				},
+				Params:                         wasmTypes.DefaultParams(),
+				InactiveCodeIDs:                []uint64{firstCodeID},
+				QueryDisabledContractAddresses: []string{keeper.BuildContractAddressClassic(1, 1).String()},
+			},
+			expSuccess: true,
+		},
+		"happy path: inactiveContract paused by admin": {
+			src: types.GenesisState{
+				Codes: []wasmTypes.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []wasmTypes.Contract{
+					{
+						ContractAddress: keeper.BuildContractAddressClassic(1, 1).String(),
+						ContractInfo:    wasmTypes.ContractInfoFixture(func(c *wasmTypes.ContractInfo) { c.CodeID = 1 }, wasmTypes.OnlyGenesisFields),
+					},
+				},
+				Sequences: []wasmTypes.Sequence{
+					{IDKey: wasmTypes.KeyLastCodeID, Value: 2},
+					{IDKey: wasmTypes.KeyLastInstanceID, Value: 3},
+				},
+				Params:                       wasmTypes.DefaultParams(),
+				InactiveContractAddresses:    []string{keeper.BuildContractAddressClassic(1, 1).String()},
+				AdminPausedContractAddresses: []string{keeper.BuildContractAddressClassic(1, 1).String()},
+			},
+			expSuccess: true,
+		},
+		"happy path: inactiveContract with info": {
+			src: types.GenesisState{
+				Codes: []wasmTypes.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []wasmTypes.Contract{
+					{
+						ContractAddress: keeper.BuildContractAddressClassic(1, 1).String(),
+						ContractInfo:    wasmTypes.ContractInfoFixture(func(c *wasmTypes.ContractInfo) { c.CodeID = 1 }, wasmTypes.OnlyGenesisFields),
+					},
+				},
+				Sequences: []wasmTypes.Sequence{
+					{IDKey: wasmTypes.KeyLastCodeID, Value: 2},
+					{IDKey: wasmTypes.KeyLastInstanceID, Value: 3},
+				},
+				Params:                    wasmTypes.DefaultParams(),
+				InactiveContractAddresses: []string{keeper.BuildContractAddressClassic(1, 1).String()},
+				InactiveContractInfos: []types.InactiveContractInfo{{
+					Address:           keeper.BuildContractAddressClassic(1, 1).String(),
+					Reason:            "reason",
+					DeactivatedHeight: 1,
+					ExpiryHeight:      100,
+				}},
+			},
+			expSuccess: true,
+		},
 		"invalid path: inactiveContract - human address": {
 			src: types.GenesisState{
 				Codes: []wasmTypes.Code{{
@@ -474,6 +627,52 @@ func TestGenesisInit(t *testing.T) {
 				InactiveContractAddresses: []string{keeper.BuildContractAddressClassic(1, 1).String()},
 			},
 		},
+		"happy path: inactiveCode": {
+			src: types.GenesisState{
+				Codes: []wasmTypes.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Sequences: []wasmTypes.Sequence{
+					{IDKey: wasmTypes.KeyLastCodeID, Value: 2},
+					{IDKey: wasmTypes.KeyLastInstanceID, Value: 1},
+				},
+				Params:          wasmTypes.DefaultParams(),
+				InactiveCodeIDs: []uint64{firstCodeID},
+			},
+			expSuccess: true,
+		},
+		"invalid path: inactiveCode - do not imported": {
+			src: types.GenesisState{
+				Codes: []wasmTypes.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Sequences: []wasmTypes.Sequence{
+					{IDKey: wasmTypes.KeyLastCodeID, Value: 2},
+					{IDKey: wasmTypes.KeyLastInstanceID, Value: 1},
+				},
+				Params:          wasmTypes.DefaultParams(),
+				InactiveCodeIDs: []uint64{2},
+			},
+		},
+		"invalid path: inactiveCode - duplicate": {
+			src: types.GenesisState{
+				Codes: []wasmTypes.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Sequences: []wasmTypes.Sequence{
+					{IDKey: wasmTypes.KeyLastCodeID, Value: 2},
+					{IDKey: wasmTypes.KeyLastInstanceID, Value: 1},
+				},
+				Params:          wasmTypes.DefaultParams(),
+				InactiveCodeIDs: []uint64{firstCodeID, firstCodeID},
+			},
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -590,6 +789,7 @@ func setupKeeper(t *testing.T) (*Keeper, sdk.Context, []sdk.StoreKey) {
 		tempDir,
 		wasmConfig,
 		AvailableCapabilities,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	return &srcKeeper, ctx, []sdk.StoreKey{keys[types.StoreKey], keys[paramstypes.StoreKey]}
 }
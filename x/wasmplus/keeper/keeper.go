@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/Finschia/finschia-sdk/codec"
 	sdk "github.com/Finschia/finschia-sdk/types"
@@ -40,6 +42,7 @@ func NewKeeper(
 	homeDir string,
 	wasmConfig wasmtypes.WasmConfig,
 	availableCapabilities string,
+	authority string,
 	opts ...wasmkeeper.Option,
 ) Keeper {
 	bankPlusKeeper, ok := bankKeeper.(bankpluskeeper.Keeper)
@@ -52,6 +55,8 @@ func NewKeeper(
 		metrics:  wasmkeeper.NopMetrics(),
 		bank:     bankPlusKeeper,
 	}
+	// reject the calls into inactive contracts on every wasm VM entry point
+	opts = append([]wasmkeeper.Option{wasmkeeper.WithContractAccessChecker(inactiveContractChecker{storeKey: storeKey})}, opts...)
 	result.Keeper = wasmkeeper.NewKeeper(
 		cdc,
 		storeKey,
@@ -69,6 +74,7 @@ func NewKeeper(
 		homeDir,
 		wasmConfig,
 		availableCapabilities,
+		authority,
 		opts...,
 	)
 	return result
@@ -90,7 +96,17 @@ func ModuleLogger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// IsInactiveContract returns true when the contract is deactivated itself or
+// its current code is deactivated.
 func (k Keeper) IsInactiveContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	if k.hasInactiveContract(ctx, contractAddress) {
+		return true
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	return contractInfo != nil && k.IsInactiveCode(ctx, contractInfo.CodeID)
+}
+
+func (k Keeper) hasInactiveContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(types.GetInactiveContractKey(contractAddress))
 }
@@ -124,19 +140,31 @@ func (k Keeper) deleteInactiveContract(ctx sdk.Context, contractAddress sdk.AccA
 
 // activateContract delete the contract address from inactivateContract list if the contract is deactivated.
 func (k Keeper) activateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
-	if !k.IsInactiveContract(ctx, contractAddress) {
+	if !k.hasInactiveContract(ctx, contractAddress) {
 		return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "no inactivate contract %s", contractAddress.String())
 	}
 
 	k.deleteInactiveContract(ctx, contractAddress)
-	k.bank.DeleteFromInactiveAddr(ctx, contractAddress)
+	k.deleteAdminPausedContract(ctx, contractAddress)
+	k.deleteInactiveContractInfo(ctx, contractAddress)
+	// a contract of an inactive code can still not receive funds and keeps its query policy
+	if contractInfo := k.GetContractInfo(ctx, contractAddress); contractInfo == nil || !k.IsInactiveCode(ctx, contractInfo.CodeID) {
+		k.bank.DeleteFromInactiveAddr(ctx, contractAddress)
+		k.deleteQueryDisabledContract(ctx, contractAddress)
+	}
 
 	return nil
 }
 
 // deactivateContract add the contract address to inactivateContract list.
+// A contract paused by its admin is taken over by governance, so that the admin can not unpause it anymore.
 func (k Keeper) deactivateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
-	if k.IsInactiveContract(ctx, contractAddress) {
+	if k.hasInactiveContract(ctx, contractAddress) {
+		if k.IsAdminPausedContract(ctx, contractAddress) {
+			k.deleteAdminPausedContract(ctx, contractAddress)
+			k.setInactiveContractInfo(ctx, contractAddress, newInactiveContractInfo(ctx, contractAddress))
+			return nil
+		}
 		return sdkerrors.Wrapf(wasmtypes.ErrAccountExists, "already inactivate contract %s", contractAddress.String())
 	}
 	if !k.HasContractInfo(ctx, contractAddress) {
@@ -144,7 +172,372 @@ func (k Keeper) deactivateContract(ctx sdk.Context, contractAddress sdk.AccAddre
 	}
 
 	k.addInactiveContract(ctx, contractAddress)
+	k.setInactiveContractInfo(ctx, contractAddress, newInactiveContractInfo(ctx, contractAddress))
 	k.bank.AddToInactiveAddr(ctx, contractAddress)
 
 	return nil
 }
+
+func newInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) types.InactiveContractInfo {
+	return types.InactiveContractInfo{
+		Address:           contractAddress.String(),
+		DeactivatedHeight: ctx.BlockHeight(),
+	}
+}
+
+// GetInactiveContractInfo returns the details of the deactivation of the contract or nil if they are not stored.
+func (k Keeper) GetInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.InactiveContractInfo {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInactiveContractInfoKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var info types.InactiveContractInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return &info
+}
+
+func (k Keeper) IterateInactiveContractInfos(ctx sdk.Context, fn func(info types.InactiveContractInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.InactiveContractInfoPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.InactiveContractInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		if stop := fn(info); stop {
+			break
+		}
+	}
+}
+
+// setInactiveContractInfo stores the details of the deactivation and schedules the activation on its expiry.
+func (k Keeper) setInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, info types.InactiveContractInfo) {
+	k.deleteInactiveContractInfo(ctx, contractAddress)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInactiveContractInfoKey(contractAddress), k.cdc.MustMarshal(&info))
+	if info.ExpiryHeight != 0 {
+		store.Set(types.GetContractExpiryHeightQueueKey(info.ExpiryHeight, contractAddress), []byte{})
+	}
+	if info.ExpiryTime != nil {
+		store.Set(types.GetContractExpiryTimeQueueKey(*info.ExpiryTime, contractAddress), []byte{})
+	}
+}
+
+func (k Keeper) deleteInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	info := k.GetInactiveContractInfo(ctx, contractAddress)
+	if info == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if info.ExpiryHeight != 0 {
+		store.Delete(types.GetContractExpiryHeightQueueKey(info.ExpiryHeight, contractAddress))
+	}
+	if info.ExpiryTime != nil {
+		store.Delete(types.GetContractExpiryTimeQueueKey(*info.ExpiryTime, contractAddress))
+	}
+	store.Delete(types.GetInactiveContractInfoKey(contractAddress))
+}
+
+// updateInactiveContractInfo sets the reason and the optional expiry of the deactivation of an inactive contract.
+// An expiry that already passed, e.g. while the proposal was voted on, is set to the current block, so that the
+// contract is activated by ActivateExpiredContracts at the end of the block.
+func (k Keeper) updateInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, reason string, expiryHeight uint64, expiryTime *time.Time) error {
+	if !k.hasInactiveContract(ctx, contractAddress) {
+		return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "no inactivate contract %s", contractAddress.String())
+	}
+	if expiryHeight != 0 && expiryHeight < uint64(ctx.BlockHeight()) {
+		k.Logger(ctx).Info("expiry height passed", "contract", contractAddress.String(), "expiry_height", expiryHeight)
+		expiryHeight = uint64(ctx.BlockHeight())
+	}
+	if expiryTime != nil && expiryTime.Before(ctx.BlockTime()) {
+		k.Logger(ctx).Info("expiry time passed", "contract", contractAddress.String(), "expiry_time", expiryTime)
+		blockTime := ctx.BlockTime()
+		expiryTime = &blockTime
+	}
+
+	info := k.GetInactiveContractInfo(ctx, contractAddress)
+	if info == nil {
+		defaultInfo := newInactiveContractInfo(ctx, contractAddress)
+		info = &defaultInfo
+	}
+	info.Reason = reason
+	info.ExpiryHeight = expiryHeight
+	info.ExpiryTime = expiryTime
+	k.setInactiveContractInfo(ctx, contractAddress, *info)
+
+	return nil
+}
+
+// ActivateExpiredContracts activates the inactive contracts whose deactivation expired at the current block height
+// or block time. A contract that can not be activated is logged and skipped, so that the end blocker does not halt
+// the chain. The expired queue keys are always deleted.
+func (k Keeper) ActivateExpiredContracts(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect the keys first, as the activation deletes them from the queues
+	type expiredKey struct {
+		key             []byte
+		contractAddress sdk.AccAddress
+	}
+	var expired []expiredKey
+	heightIter := store.Iterator(
+		types.ContractExpiryHeightQueuePrefix,
+		types.GetContractExpiryHeightQueuePrefix(uint64(ctx.BlockHeight())+1),
+	)
+	heightPrefixLen := len(types.GetContractExpiryHeightQueuePrefix(0))
+	for ; heightIter.Valid(); heightIter.Next() {
+		expired = append(expired, expiredKey{key: heightIter.Key(), contractAddress: heightIter.Key()[heightPrefixLen:]})
+	}
+	heightIter.Close()
+
+	timeIter := store.Iterator(
+		types.ContractExpiryTimeQueuePrefix,
+		sdk.PrefixEndBytes(types.GetContractExpiryTimeQueuePrefix(ctx.BlockTime())),
+	)
+	timePrefixLen := len(types.GetContractExpiryTimeQueuePrefix(ctx.BlockTime()))
+	for ; timeIter.Valid(); timeIter.Next() {
+		expired = append(expired, expiredKey{key: timeIter.Key(), contractAddress: timeIter.Key()[timePrefixLen:]})
+	}
+	timeIter.Close()
+
+	for _, e := range expired {
+		store.Delete(e.key)
+		if !k.hasInactiveContract(ctx, e.contractAddress) {
+			// already activated by the other expiry
+			continue
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.activateExpiredContract(cacheCtx, e.contractAddress); err != nil {
+			k.Logger(ctx).Error("failed to activate expired contract", "contract", e.contractAddress.String(), "error", err)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		k.Logger(ctx).Info("deactivation expired", "contract", e.contractAddress.String())
+	}
+}
+
+func (k Keeper) activateExpiredContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	if err := k.activateContract(ctx, contractAddress); err != nil {
+		return err
+	}
+	event := types.EventActivateExpiredContract{Contract: contractAddress.String()}
+	return ctx.EventManager().EmitTypedEvent(&event)
+}
+
+func (k Keeper) IsInactiveCode(ctx sdk.Context, codeID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetInactiveCodeKey(codeID))
+}
+
+func (k Keeper) IterateInactiveCodes(ctx sdk.Context, fn func(codeID uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.InactiveCodePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		codeID := binary.BigEndian.Uint64(iterator.Key()[len(types.InactiveCodePrefix):])
+		if stop := fn(codeID); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) addInactiveCode(ctx sdk.Context, codeID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInactiveCodeKey(codeID), []byte{})
+}
+
+func (k Keeper) deleteInactiveCode(ctx sdk.Context, codeID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetInactiveCodeKey(codeID))
+}
+
+// activateCode delete the code id from inactiveCode list if the code is deactivated.
+// The contracts of the code can receive funds again and their query policy is dropped unless they are
+// deactivated themselves.
+func (k Keeper) activateCode(ctx sdk.Context, codeID uint64) error {
+	if !k.IsInactiveCode(ctx, codeID) {
+		return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "no inactivate code %d", codeID)
+	}
+
+	k.deleteInactiveCode(ctx, codeID)
+	k.IterateContractsByCode(ctx, codeID, func(contractAddress sdk.AccAddress) bool {
+		if !k.hasInactiveContract(ctx, contractAddress) {
+			k.bank.DeleteFromInactiveAddr(ctx, contractAddress)
+			k.deleteQueryDisabledContract(ctx, contractAddress)
+		}
+		return false
+	})
+
+	return nil
+}
+
+// deactivateCode add the code id to inactiveCode list. The contracts of the code are not added to the
+// inactiveContract list, as they are resolved at runtime, but to the bank inactive address list so that
+// they can not receive funds.
+func (k Keeper) deactivateCode(ctx sdk.Context, codeID uint64) error {
+	if k.IsInactiveCode(ctx, codeID) {
+		return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "already inactivate code %d", codeID)
+	}
+	if k.GetCodeInfo(ctx, codeID) == nil {
+		return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "no code %d", codeID)
+	}
+
+	k.addInactiveCode(ctx, codeID)
+	k.IterateContractsByCode(ctx, codeID, func(contractAddress sdk.AccAddress) bool {
+		k.bank.AddToInactiveAddr(ctx, contractAddress)
+		return false
+	})
+
+	return nil
+}
+
+func (k Keeper) IsQueryDisabledContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetQueryDisabledContractKey(contractAddress))
+}
+
+func (k Keeper) IterateQueryDisabledContracts(ctx sdk.Context, fn func(contractAddress sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.QueryDisabledContractPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractAddress := sdk.AccAddress(iterator.Key()[len(types.QueryDisabledContractPrefix):])
+		if stop := fn(contractAddress); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) deleteQueryDisabledContract(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueryDisabledContractKey(contractAddress))
+}
+
+// disableContractQueries rejects smart queries to the contract that is inactive itself or through its code.
+// The policy is dropped when the contract becomes active.
+func (k Keeper) disableContractQueries(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	if !k.IsInactiveContract(ctx, contractAddress) {
+		return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "no inactivate contract %s", contractAddress.String())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQueryDisabledContractKey(contractAddress), []byte{})
+
+	return nil
+}
+
+// disableCodeQueries rejects smart queries to all contracts of the inactive code. The policy of a contract
+// is dropped when it becomes active.
+func (k Keeper) disableCodeQueries(ctx sdk.Context, codeID uint64) error {
+	if !k.IsInactiveCode(ctx, codeID) {
+		return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "no inactivate code %d", codeID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	k.IterateContractsByCode(ctx, codeID, func(contractAddress sdk.AccAddress) bool {
+		store.Set(types.GetQueryDisabledContractKey(contractAddress), []byte{})
+		return false
+	})
+
+	return nil
+}
+
+func (k Keeper) IsAdminPausedContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAdminPausedContractKey(contractAddress))
+}
+
+func (k Keeper) IterateAdminPausedContracts(ctx sdk.Context, fn func(contractAddress sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AdminPausedContractPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractAddress := sdk.AccAddress(iterator.Key()[len(types.AdminPausedContractPrefix):])
+		if stop := fn(contractAddress); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) addAdminPausedContract(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAdminPausedContractKey(contractAddress), []byte{})
+}
+
+func (k Keeper) deleteAdminPausedContract(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAdminPausedContractKey(contractAddress))
+}
+
+// pauseContract add the contract address to inactivateContract list on behalf of the contract admin.
+func (k Keeper) pauseContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "no contract %s", contractAddress.String())
+	}
+	if !(wasmkeeper.DefaultAuthorizationPolicy{}).CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not pause")
+	}
+	if k.hasInactiveContract(ctx, contractAddress) {
+		return sdkerrors.Wrapf(wasmtypes.ErrAccountExists, "already inactivate contract %s", contractAddress.String())
+	}
+
+	if err := k.deactivateContract(ctx, contractAddress); err != nil {
+		return err
+	}
+	k.addAdminPausedContract(ctx, contractAddress)
+
+	return nil
+}
+
+// unpauseContract delete the contract address paused by the contract admin from inactivateContract list.
+// A contract deactivated by governance can only be activated by governance.
+func (k Keeper) unpauseContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "no contract %s", contractAddress.String())
+	}
+	if !(wasmkeeper.DefaultAuthorizationPolicy{}).CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not unpause")
+	}
+	if !k.hasInactiveContract(ctx, contractAddress) {
+		return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "no inactivate contract %s", contractAddress.String())
+	}
+	if !k.IsAdminPausedContract(ctx, contractAddress) {
+		return sdkerrors.Wrapf(types.ErrGovPausedContract, "contract %s", contractAddress.String())
+	}
+
+	return k.activateContract(ctx, contractAddress)
+}
+
+var _ wasmkeeper.ContractAccessChecker = inactiveContractChecker{}
+
+// inactiveContractChecker rejects the calls into inactive contracts on every wasm VM entry point.
+// Smart queries are only rejected when they are disabled for the contract.
+type inactiveContractChecker struct {
+	storeKey sdk.StoreKey
+}
+
+func (c inactiveContractChecker) CheckContractAccess(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64, entryPoint wasmkeeper.EntryPoint) error {
+	store := ctx.KVStore(c.storeKey)
+	if entryPoint == wasmkeeper.EntryPointInstantiate {
+		if store.Has(types.GetInactiveCodeKey(codeID)) {
+			return sdkerrors.Wrap(types.ErrInactiveCode, "can not instantiate")
+		}
+		return nil
+	}
+	if !store.Has(types.GetInactiveContractKey(contractAddress)) && !store.Has(types.GetInactiveCodeKey(codeID)) {
+		return nil
+	}
+	if entryPoint == wasmkeeper.EntryPointQuery && !store.Has(types.GetQueryDisabledContractKey(contractAddress)) {
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrInactiveContract, "can not call %s", entryPoint)
+}
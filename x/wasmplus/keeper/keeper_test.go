@@ -1,13 +1,24 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
+	wasmvmtypes "github.com/Finschia/wasmvm/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	"github.com/Finschia/wasmd/x/wasm"
+	wasmkeeper "github.com/Finschia/wasmd/x/wasm/keeper"
+	"github.com/Finschia/wasmd/x/wasm/keeper/testdata"
+	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
+	"github.com/Finschia/wasmd/x/wasmplus/types"
 )
 
 func TestActivateContract(t *testing.T) {
@@ -77,3 +88,435 @@ func TestIterateInactiveContracts(t *testing.T) {
 	expectList := []sdk.AccAddress{example1.Contract, example2.Contract}
 	assert.ElementsMatch(t, expectList, inactiveContracts)
 }
+
+func TestDeactivateCode(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// request unknown code id -> fail
+	err := k.deactivateCode(ctx, example.CodeID+1)
+	require.ErrorIs(t, err, wasmtypes.ErrNotFound)
+
+	// success case
+	err = k.deactivateCode(ctx, example.CodeID)
+	require.NoError(t, err)
+	assert.True(t, k.IsInactiveCode(ctx, example.CodeID))
+	assert.True(t, k.IsInactiveContract(ctx, example.Contract))
+
+	// contract is not added to the inactive contract list
+	assert.False(t, k.hasInactiveContract(ctx, example.Contract))
+	// but can not receive funds
+	assert.True(t, k.bank.IsInactiveAddr(example.Contract))
+
+	// already inactivate code -> fail
+	err = k.deactivateCode(ctx, example.CodeID)
+	require.ErrorIs(t, err, wasmtypes.ErrDuplicate)
+}
+
+func TestActivateCode(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// try to activate an activated code -> fail
+	err := k.activateCode(ctx, example.CodeID)
+	require.ErrorIs(t, err, wasmtypes.ErrNotFound)
+
+	err = k.deactivateCode(ctx, example.CodeID)
+	require.NoError(t, err)
+
+	// try to activate an inactivated code -> success
+	err = k.activateCode(ctx, example.CodeID)
+	require.NoError(t, err)
+	assert.False(t, k.IsInactiveCode(ctx, example.CodeID))
+	assert.False(t, k.IsInactiveContract(ctx, example.Contract))
+	assert.False(t, k.bank.IsInactiveAddr(example.Contract))
+}
+
+func TestActivateCodeWithInactiveContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, k.deactivateContract(ctx, example.Contract))
+	require.NoError(t, k.deactivateCode(ctx, example.CodeID))
+
+	// the contract is activated while its code is inactive
+	require.NoError(t, k.activateContract(ctx, example.Contract))
+	assert.True(t, k.IsInactiveContract(ctx, example.Contract))
+	assert.True(t, k.bank.IsInactiveAddr(example.Contract))
+
+	// the contract is deactivated again before its code is activated
+	require.NoError(t, k.deactivateContract(ctx, example.Contract))
+	require.NoError(t, k.activateCode(ctx, example.CodeID))
+	assert.True(t, k.IsInactiveContract(ctx, example.Contract))
+	assert.True(t, k.bank.IsInactiveAddr(example.Contract))
+}
+
+func TestIterateInactiveCodes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	example1 := StoreHackatomExampleContract(t, ctx, keepers)
+	example2 := StoreHackatomExampleContract(t, ctx, keepers)
+	StoreHackatomExampleContract(t, ctx, keepers)
+
+	require.NoError(t, k.deactivateCode(ctx, example2.CodeID))
+	require.NoError(t, k.deactivateCode(ctx, example1.CodeID))
+
+	var inactiveCodes []uint64
+	k.IterateInactiveCodes(ctx, func(codeID uint64) (stop bool) {
+		inactiveCodes = append(inactiveCodes, codeID)
+		return false
+	})
+	assert.Equal(t, []uint64{example1.CodeID, example2.CodeID}, inactiveCodes)
+}
+
+func TestInactiveContractEntryPoints(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, k.deactivateContract(ctx, example.Contract))
+
+	// sudo
+	_, err := k.Sudo(ctx, example.Contract, []byte(`{}`))
+	require.ErrorIs(t, err, types.ErrInactiveContract)
+
+	// ibc channel handshake and packet receive
+	_, err = k.OnOpenChannel(ctx, example.Contract, wasmvmtypes.IBCChannelOpenMsg{})
+	require.ErrorIs(t, err, types.ErrInactiveContract)
+	err = k.OnConnectChannel(ctx, example.Contract, wasmvmtypes.IBCChannelConnectMsg{})
+	require.ErrorIs(t, err, types.ErrInactiveContract)
+	err = k.OnCloseChannel(ctx, example.Contract, wasmvmtypes.IBCChannelCloseMsg{})
+	require.ErrorIs(t, err, types.ErrInactiveContract)
+	_, err = k.OnRecvPacket(ctx, example.Contract, wasmvmtypes.IBCPacketReceiveMsg{})
+	require.ErrorIs(t, err, types.ErrInactiveContract)
+	// the ack and timeout callbacks are skipped, so that the packet is still cleaned up
+	err = k.OnAckPacket(ctx, example.Contract, wasmvmtypes.IBCPacketAckMsg{})
+	require.NoError(t, err)
+	err = k.OnTimeoutPacket(ctx, example.Contract, wasmvmtypes.IBCPacketTimeoutMsg{})
+	require.NoError(t, err)
+
+	// an error acknowledgement is returned on packet receive
+	packet := channeltypes.Packet{DestinationPort: wasmkeeper.PortIDForContract(example.Contract)}
+	ack := wasm.NewIBCHandler(k, nil, nil).OnRecvPacket(ctx, packet, RandomAccountAddress(t))
+	require.False(t, ack.Success())
+
+	// submessages fail deterministically
+	reflectCodeID, _, err := keepers.ContractKeeper.Create(ctx, example.CreatorAddr, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	reflectAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, reflectCodeID, example.CreatorAddr, nil, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+	executeMsg := wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{
+		ContractAddr: example.Contract.String(),
+		Msg:          []byte(`{"release":{}}`),
+		Funds:        []wasmvmtypes.Coin{},
+	}}}
+	reflectMsg := testdata.ReflectHandleMsg{Reflect: &testdata.ReflectPayload{Msgs: []wasmvmtypes.CosmosMsg{executeMsg}}}
+	_, err = keepers.ContractKeeper.Execute(ctx, reflectAddr, example.CreatorAddr, mustMarshal(t, reflectMsg), nil)
+	require.ErrorIs(t, err, types.ErrInactiveContract)
+
+	reflectSubMsg := testdata.ReflectHandleMsg{ReflectSubMsg: &testdata.ReflectSubPayload{Msgs: []wasmvmtypes.SubMsg{{
+		ID:      1,
+		Msg:     executeMsg,
+		ReplyOn: wasmvmtypes.ReplyError,
+	}}}}
+	_, err = keepers.ContractKeeper.Execute(ctx, reflectAddr, example.CreatorAddr, mustMarshal(t, reflectSubMsg), nil)
+	require.NoError(t, err)
+	queryRes, err := k.QuerySmart(ctx, reflectAddr, mustMarshal(t, testdata.ReflectQueryMsg{SubMsgResult: &testdata.SubCall{ID: 1}}))
+	require.NoError(t, err)
+	var reply wasmvmtypes.Reply
+	require.NoError(t, json.Unmarshal(queryRes, &reply))
+	assert.Equal(t, "codespace: wasm, code: 101", reply.Result.Err)
+
+	// queries stay allowed unless disabled for the contract
+	_, err = k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+	require.NoError(t, k.disableContractQueries(ctx, example.Contract))
+	_, err = k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.ErrorIs(t, err, types.ErrInactiveContract)
+
+	// the query policy is dropped on activation
+	require.NoError(t, k.activateContract(ctx, example.Contract))
+	assert.False(t, k.IsQueryDisabledContract(ctx, example.Contract))
+	_, err = k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+}
+
+func TestDisableContractQueries(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// an active contract -> fail
+	err := k.disableContractQueries(ctx, example.Contract)
+	require.ErrorIs(t, err, wasmtypes.ErrNotFound)
+
+	require.NoError(t, k.deactivateContract(ctx, example.Contract))
+
+	// success case
+	err = k.disableContractQueries(ctx, example.Contract)
+	require.NoError(t, err)
+	assert.True(t, k.IsQueryDisabledContract(ctx, example.Contract))
+}
+
+func TestDisableQueriesOfInactiveCode(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// an active code -> fail
+	err := k.disableCodeQueries(ctx, example.CodeID)
+	require.ErrorIs(t, err, wasmtypes.ErrNotFound)
+
+	require.NoError(t, k.deactivateCode(ctx, example.CodeID))
+
+	// the policy applies to a contract that is inactive through its code
+	require.NoError(t, k.disableContractQueries(ctx, example.Contract))
+	assert.True(t, k.IsQueryDisabledContract(ctx, example.Contract))
+	_, err = k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.ErrorIs(t, err, types.ErrInactiveContract)
+
+	// and is dropped when the code is activated
+	require.NoError(t, k.activateCode(ctx, example.CodeID))
+	assert.False(t, k.IsQueryDisabledContract(ctx, example.Contract))
+	_, err = k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+
+	// success case for all contracts of the code
+	require.NoError(t, k.deactivateCode(ctx, example.CodeID))
+	require.NoError(t, k.disableCodeQueries(ctx, example.CodeID))
+	assert.True(t, k.IsQueryDisabledContract(ctx, example.Contract))
+
+	// a contract that is inactive itself keeps the policy on code activation
+	require.NoError(t, k.deactivateContract(ctx, example.Contract))
+	require.NoError(t, k.activateCode(ctx, example.CodeID))
+	assert.True(t, k.IsQueryDisabledContract(ctx, example.Contract))
+}
+
+func TestPauseContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	admin := example.CreatorAddr
+	_, _, other := keyPubAddr()
+
+	// request no contract address -> fail
+	err := k.pauseContract(ctx, example.CreatorAddr, admin)
+	require.ErrorIs(t, err, wasmtypes.ErrInvalid)
+
+	// not the admin -> fail
+	err = k.pauseContract(ctx, example.Contract, other)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	assert.False(t, k.IsInactiveContract(ctx, example.Contract))
+
+	// success case
+	err = k.pauseContract(ctx, example.Contract, admin)
+	require.NoError(t, err)
+	assert.True(t, k.IsInactiveContract(ctx, example.Contract))
+	assert.True(t, k.IsAdminPausedContract(ctx, example.Contract))
+
+	// already paused contract -> fail
+	err = k.pauseContract(ctx, example.Contract, admin)
+	require.ErrorIs(t, err, wasmtypes.ErrAccountExists)
+
+	// governance takes over the paused contract
+	err = k.deactivateContract(ctx, example.Contract)
+	require.NoError(t, err)
+	assert.True(t, k.IsInactiveContract(ctx, example.Contract))
+	assert.False(t, k.IsAdminPausedContract(ctx, example.Contract))
+}
+
+func TestUnpauseContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	admin := example.CreatorAddr
+	_, _, other := keyPubAddr()
+
+	// an active contract -> fail
+	err := k.unpauseContract(ctx, example.Contract, admin)
+	require.ErrorIs(t, err, wasmtypes.ErrNotFound)
+
+	// a contract deactivated by governance -> fail
+	require.NoError(t, k.deactivateContract(ctx, example.Contract))
+	err = k.unpauseContract(ctx, example.Contract, admin)
+	require.ErrorIs(t, err, types.ErrGovPausedContract)
+	assert.True(t, k.IsInactiveContract(ctx, example.Contract))
+	require.NoError(t, k.activateContract(ctx, example.Contract))
+
+	require.NoError(t, k.pauseContract(ctx, example.Contract, admin))
+
+	// not the admin -> fail
+	err = k.unpauseContract(ctx, example.Contract, other)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	assert.True(t, k.IsInactiveContract(ctx, example.Contract))
+
+	// success case
+	err = k.unpauseContract(ctx, example.Contract, admin)
+	require.NoError(t, err)
+	assert.False(t, k.IsInactiveContract(ctx, example.Contract))
+	assert.False(t, k.IsAdminPausedContract(ctx, example.Contract))
+}
+
+func TestUpdateInactiveContractInfo(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	past, future := ctx.BlockTime().Add(-time.Second), ctx.BlockTime().Add(time.Hour)
+
+	// an active contract -> fail
+	err := k.updateInactiveContractInfo(ctx, example.Contract, "reason", 20, nil)
+	require.ErrorIs(t, err, wasmtypes.ErrNotFound)
+
+	require.NoError(t, k.deactivateContract(ctx, example.Contract))
+	exp := types.InactiveContractInfo{Address: example.Contract.String(), DeactivatedHeight: 10}
+	assert.Equal(t, &exp, k.GetInactiveContractInfo(ctx, example.Contract))
+
+	// expiry in the past -> expires at the current block
+	err = k.updateInactiveContractInfo(ctx, example.Contract, "reason", 9, nil)
+	require.NoError(t, err)
+	exp = types.InactiveContractInfo{Address: example.Contract.String(), Reason: "reason", DeactivatedHeight: 10, ExpiryHeight: 10}
+	assert.Equal(t, &exp, k.GetInactiveContractInfo(ctx, example.Contract))
+	err = k.updateInactiveContractInfo(ctx, example.Contract, "reason", 0, &past)
+	require.NoError(t, err)
+	now := ctx.BlockTime()
+	exp = types.InactiveContractInfo{Address: example.Contract.String(), Reason: "reason", DeactivatedHeight: 10, ExpiryTime: &now}
+	assert.Equal(t, &exp, k.GetInactiveContractInfo(ctx, example.Contract))
+
+	// success cases
+	err = k.updateInactiveContractInfo(ctx.WithBlockHeight(11), example.Contract, "reason", 20, nil)
+	require.NoError(t, err)
+	exp = types.InactiveContractInfo{Address: example.Contract.String(), Reason: "reason", DeactivatedHeight: 10, ExpiryHeight: 20}
+	assert.Equal(t, &exp, k.GetInactiveContractInfo(ctx, example.Contract))
+
+	err = k.updateInactiveContractInfo(ctx, example.Contract, "other reason", 0, &future)
+	require.NoError(t, err)
+	exp = types.InactiveContractInfo{Address: example.Contract.String(), Reason: "other reason", DeactivatedHeight: 10, ExpiryTime: &future}
+	assert.Equal(t, &exp, k.GetInactiveContractInfo(ctx, example.Contract))
+
+	// the previous expiry is not scheduled anymore
+	k.ActivateExpiredContracts(ctx.WithBlockHeight(20))
+	assert.True(t, k.IsInactiveContract(ctx, example.Contract))
+
+	// activation deletes the info
+	require.NoError(t, k.activateContract(ctx, example.Contract))
+	assert.Nil(t, k.GetInactiveContractInfo(ctx, example.Contract))
+	k.ActivateExpiredContracts(ctx.WithBlockTime(future))
+}
+
+func TestActivateExpiredContracts(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	k := keepers.WasmKeeper
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	expiryTime := ctx.BlockTime().Add(time.Hour)
+
+	byHeight := InstantiateHackatomExampleContract(t, ctx, keepers).Contract
+	byTime := InstantiateHackatomExampleContract(t, ctx, keepers).Contract
+	noExpiry := InstantiateHackatomExampleContract(t, ctx, keepers).Contract
+	for _, addr := range []sdk.AccAddress{byHeight, byTime, noExpiry} {
+		require.NoError(t, k.deactivateContract(ctx, addr))
+	}
+	require.NoError(t, k.updateInactiveContractInfo(ctx, byHeight, "", 20, nil))
+	require.NoError(t, k.updateInactiveContractInfo(ctx, byTime, "", 0, &expiryTime))
+
+	// the steps run in order, as the bank keeper caches the inactive addresses in memory
+	steps := []struct {
+		name        string
+		height      int64
+		blockTime   time.Time
+		expActivate []sdk.AccAddress
+		expInactive []sdk.AccAddress
+	}{
+		{
+			name:        "nothing expired",
+			height:      19,
+			blockTime:   expiryTime.Add(-time.Nanosecond),
+			expInactive: []sdk.AccAddress{byHeight, byTime, noExpiry},
+		},
+		{
+			name:        "height expired",
+			height:      20,
+			blockTime:   expiryTime.Add(-time.Nanosecond),
+			expActivate: []sdk.AccAddress{byHeight},
+			expInactive: []sdk.AccAddress{byTime, noExpiry},
+		},
+		{
+			name:        "time expired",
+			height:      21,
+			blockTime:   expiryTime,
+			expActivate: []sdk.AccAddress{byTime},
+			expInactive: []sdk.AccAddress{noExpiry},
+		},
+		{
+			name:        "no expiry",
+			height:      100,
+			blockTime:   expiryTime.Add(time.Hour),
+			expInactive: []sdk.AccAddress{noExpiry},
+		},
+	}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+			stepCtx := ctx.WithBlockHeight(step.height).WithBlockTime(step.blockTime).WithEventManager(em)
+
+			k.ActivateExpiredContracts(stepCtx)
+
+			expEvents := sdk.Events{}
+			for _, addr := range step.expActivate {
+				event, err := sdk.TypedEventToEvent(&types.EventActivateExpiredContract{Contract: addr.String()})
+				require.NoError(t, err)
+				expEvents = append(expEvents, event)
+			}
+			assert.Equal(t, expEvents, em.Events())
+			for _, addr := range step.expActivate {
+				assert.False(t, k.IsInactiveContract(stepCtx, addr))
+				assert.False(t, k.bank.IsInactiveAddr(addr))
+				assert.Nil(t, k.GetInactiveContractInfo(stepCtx, addr))
+			}
+			for _, addr := range step.expInactive {
+				assert.True(t, k.IsInactiveContract(stepCtx, addr))
+				assert.True(t, k.bank.IsInactiveAddr(addr))
+			}
+		})
+	}
+}
+
+func TestActivateExpiredContractsDropsStaleQueueKeys(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	k := keepers.WasmKeeper
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	active := InstantiateHackatomExampleContract(t, ctx, keepers).Contract
+	store := ctx.KVStore(k.storeKey)
+	staleKeys := [][]byte{
+		types.GetContractExpiryHeightQueueKey(10, active),
+		types.GetContractExpiryHeightQueueKey(9, RandomAccountAddress(t)),
+		types.GetContractExpiryTimeQueueKey(ctx.BlockTime(), active),
+	}
+	for _, key := range staleKeys {
+		store.Set(key, []byte{})
+	}
+	em := sdk.NewEventManager()
+
+	// when
+	k.ActivateExpiredContracts(ctx.WithEventManager(em))
+
+	// then
+	for _, key := range staleKeys {
+		assert.False(t, store.Has(key))
+	}
+	assert.Empty(t, em.Events())
+	assert.False(t, k.IsInactiveContract(ctx, active))
+}
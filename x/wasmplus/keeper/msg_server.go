@@ -6,17 +6,16 @@ import (
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 
-	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
 	"github.com/Finschia/wasmd/x/wasmplus/types"
 )
 
 var _ types.MsgServer = msgServer{}
 
 type msgServer struct {
-	keeper wasmtypes.ContractOpsKeeper
+	keeper types.ContractOpsKeeper
 }
 
-func NewMsgServerImpl(k wasmtypes.ContractOpsKeeper) types.MsgServer {
+func NewMsgServerImpl(k types.ContractOpsKeeper) types.MsgServer {
 	return &msgServer{keeper: k}
 }
 
@@ -28,7 +27,7 @@ func (m msgServer) StoreCodeAndInstantiateContract(goCtx context.Context,
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "sender")
 	}
-	codeID, _, err := m.keeper.Create(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission)
+	codeID, _, err := m.keeper.CreateWithVerificationInfo(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission, msg.Source, msg.Builder, msg.CodeHash)
 	if err != nil {
 		return nil, err
 	}
@@ -53,3 +52,79 @@ func (m msgServer) StoreCodeAndInstantiateContract(goCtx context.Context,
 		Data:    data,
 	}, nil
 }
+
+func (m msgServer) StoreCodeAndMigrateContract(goCtx context.Context,
+	msg *types.MsgStoreCodeAndMigrateContract,
+) (*types.MsgStoreCodeAndMigrateContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	codeID, checksum, err := m.keeper.Create(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := m.keeper.Migrate(ctx, contractAddr, senderAddr, codeID, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStoreCodeAndMigrateContractResponse{
+		CodeID:   codeID,
+		Checksum: checksum,
+		Data:     data,
+	}, nil
+}
+
+func (m msgServer) PauseContract(goCtx context.Context, msg *types.MsgPauseContract) (*types.MsgPauseContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.PauseContract(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	event := types.EventPauseContract{Contract: contractAddr.String()}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseContractResponse{}, nil
+}
+
+func (m msgServer) UnpauseContract(goCtx context.Context, msg *types.MsgUnpauseContract) (*types.MsgUnpauseContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.UnpauseContract(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	event := types.EventUnpauseContract{Contract: contractAddr.String()}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpauseContractResponse{}, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+	"crypto/sha256"
 	_ "embed"
 	"testing"
 	"time"
@@ -11,6 +13,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 
 	"github.com/Finschia/wasmd/appplus"
 
@@ -105,3 +108,149 @@ func TestStoreAndInstantiateContract(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreAndInstantiateContractWithVerificationInfo(t *testing.T) {
+	wasmApp := appplus.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var myAddress sdk.AccAddress = make([]byte, wasmtypes.ContractAddrLen)
+	checksum := sha256.Sum256(wasmContract)
+	const (
+		source  = "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/reflect"
+		builder = "cosmwasm/rust-optimizer:0.12.6"
+	)
+
+	specs := map[string]struct {
+		codeHash []byte
+		expErr   bool
+	}{
+		"code hash matches": {
+			codeHash: checksum[:],
+		},
+		"code hash mismatch": {
+			codeHash: bytes.Repeat([]byte{0x1}, sha256.Size),
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// when
+			msg := &types.MsgStoreCodeAndInstantiateContract{
+				Sender:       myAddress.String(),
+				WASMByteCode: wasmContract,
+				Label:        "test",
+				Msg:          []byte(`{}`),
+				Funds:        sdk.Coins{},
+				Source:       source,
+				Builder:      builder,
+				CodeHash:     spec.codeHash,
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(xCtx, msg)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var storeAndInstantiateResponse types.MsgStoreCodeAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			codeInfo := wasmApp.WasmKeeper.GetCodeInfo(xCtx, storeAndInstantiateResponse.CodeID)
+			require.NotNil(t, codeInfo)
+			assert.Equal(t, source, codeInfo.Source)
+			assert.Equal(t, builder, codeInfo.Builder)
+		})
+	}
+}
+
+func TestPauseAndUnpauseContract(t *testing.T) {
+	wasmApp := appplus.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var myAddress sdk.AccAddress = make([]byte, wasmtypes.ContractAddrLen)
+	otherAddress := bytes.Repeat([]byte{1}, wasmtypes.ContractAddrLen)
+
+	// setup
+	instMsg := &types.MsgStoreCodeAndInstantiateContract{
+		Sender:       myAddress.String(),
+		WASMByteCode: wasmContract,
+		Admin:        myAddress.String(),
+		Label:        "test",
+		Msg:          []byte(`{}`),
+		Funds:        sdk.Coins{},
+	}
+	rsp, err := wasmApp.MsgServiceRouter().Handler(instMsg)(ctx, instMsg)
+	require.NoError(t, err)
+	var instResponse types.MsgStoreCodeAndInstantiateContractResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &instResponse))
+	contractAddr := sdk.MustAccAddressFromBech32(instResponse.Address)
+
+	specs := map[string]struct {
+		msgs      []sdk.Msg
+		expEvents []abci.Event
+		expPaused bool
+		expErr    error
+	}{
+		"admin can pause": {
+			msgs: []sdk.Msg{&types.MsgPauseContract{Sender: myAddress.String(), Contract: contractAddr.String()}},
+			expEvents: []abci.Event{{
+				Type:       "lbm.wasm.v1.EventPauseContract",
+				Attributes: []abci.EventAttribute{{Key: []byte("contract"), Value: []byte("\"" + contractAddr.String() + "\""), Index: false}},
+			}},
+			expPaused: true,
+		},
+		"other address can not pause": {
+			msgs:   []sdk.Msg{&types.MsgPauseContract{Sender: sdk.AccAddress(otherAddress).String(), Contract: contractAddr.String()}},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"admin can unpause": {
+			msgs: []sdk.Msg{
+				&types.MsgPauseContract{Sender: myAddress.String(), Contract: contractAddr.String()},
+				&types.MsgUnpauseContract{Sender: myAddress.String(), Contract: contractAddr.String()},
+			},
+			expEvents: []abci.Event{{
+				Type:       "lbm.wasm.v1.EventUnpauseContract",
+				Attributes: []abci.EventAttribute{{Key: []byte("contract"), Value: []byte("\"" + contractAddr.String() + "\""), Index: false}},
+			}},
+		},
+		"other address can not unpause": {
+			msgs: []sdk.Msg{
+				&types.MsgPauseContract{Sender: myAddress.String(), Contract: contractAddr.String()},
+				&types.MsgUnpauseContract{Sender: sdk.AccAddress(otherAddress).String(), Contract: contractAddr.String()},
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"execute on a paused contract fails": {
+			msgs: []sdk.Msg{
+				&types.MsgPauseContract{Sender: myAddress.String(), Contract: contractAddr.String()},
+				&wasmtypes.MsgExecuteContract{Sender: myAddress.String(), Contract: contractAddr.String(), Msg: []byte(`{}`)},
+			},
+			expErr: types.ErrInactiveContract,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			var (
+				rsp *sdk.Result
+				err error
+			)
+			for _, msg := range spec.msgs {
+				rsp, err = wasmApp.MsgServiceRouter().Handler(msg)(xCtx, msg)
+				if err != nil {
+					break
+				}
+			}
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expEvents, rsp.Events)
+			assert.Equal(t, spec.expPaused, wasmApp.WasmKeeper.IsInactiveContract(xCtx, contractAddr))
+			assert.Equal(t, spec.expPaused, wasmApp.WasmKeeper.IsAdminPausedContract(xCtx, contractAddr))
+		})
+	}
+}
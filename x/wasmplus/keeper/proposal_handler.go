@@ -34,6 +34,10 @@ func NewWasmProposalHandlerX(k types.ContractOpsKeeper, enabledProposalTypes []w
 				return handleDeactivateContractProposal(ctx, k, *c)
 			case *types.ActivateContractProposal:
 				return handleActivateContractProposal(ctx, k, *c)
+			case *types.DeactivateCodeProposal:
+				return handleDeactivateCodeProposal(ctx, k, *c)
+			case *types.ActivateCodeProposal:
+				return handleActivateCodeProposal(ctx, k, *c)
 			default:
 				return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 			}
@@ -55,9 +59,20 @@ func handleDeactivateContractProposal(ctx sdk.Context, k types.ContractOpsKeeper
 	if err != nil {
 		return err
 	}
+	if p.DisableQueries {
+		if err := k.DisableContractQueries(ctx, contractAddr); err != nil {
+			return err
+		}
+	}
+	if err := k.UpdateInactiveContractInfo(ctx, contractAddr, p.Description, p.ExpiryHeight, p.ExpiryTime); err != nil {
+		return err
+	}
 
 	event := types.EventDeactivateContractProposal{
-		Contract: contractAddr.String(),
+		Contract:       contractAddr.String(),
+		DisableQueries: p.DisableQueries,
+		ExpiryHeight:   p.ExpiryHeight,
+		ExpiryTime:     p.ExpiryTime,
 	}
 	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
 		return err
@@ -87,3 +102,34 @@ func handleActivateContractProposal(ctx sdk.Context, k types.ContractOpsKeeper,
 
 	return nil
 }
+
+func handleDeactivateCodeProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.DeactivateCodeProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.DeactivateCode(ctx, p.CodeID); err != nil {
+		return err
+	}
+	if p.DisableQueries {
+		if err := k.DisableCodeQueries(ctx, p.CodeID); err != nil {
+			return err
+		}
+	}
+
+	event := types.EventDeactivateCodeProposal{CodeID: p.CodeID, DisableQueries: p.DisableQueries}
+	return ctx.EventManager().EmitTypedEvent(&event)
+}
+
+func handleActivateCodeProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.ActivateCodeProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.ActivateCode(ctx, p.CodeID); err != nil {
+		return err
+	}
+
+	event := types.EventActivateCodeProposal{CodeID: p.CodeID}
+	return ctx.EventManager().EmitTypedEvent(&event)
+}
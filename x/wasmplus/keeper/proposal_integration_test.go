@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -69,3 +70,210 @@ func TestActivateContractProposal(t *testing.T) {
 	isInactive := wasmKeeper.IsInactiveContract(ctx, example.Contract)
 	require.False(t, isInactive)
 }
+
+func TestDeactivateCodeProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	src := types.DeactivateCodeProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		CodeID:      example.CodeID,
+	}
+
+	em := sdk.NewEventManager()
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, &src)
+	require.NoError(t, err)
+
+	// proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx.WithEventManager(em), storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	require.True(t, wasmKeeper.IsInactiveCode(ctx, example.CodeID))
+	require.True(t, wasmKeeper.IsInactiveContract(ctx, example.Contract))
+	require.Len(t, em.Events(), 1)
+	require.Equal(t, "lbm.wasm.v1.EventDeactivateCodeProposal", em.Events()[0].Type)
+}
+
+func TestActivateCodeProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// set deactivate
+	err := wasmKeeper.deactivateCode(ctx, example.CodeID)
+	require.NoError(t, err)
+
+	src := types.ActivateCodeProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		CodeID:      example.CodeID,
+	}
+
+	em := sdk.NewEventManager()
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, &src)
+	require.NoError(t, err)
+
+	// proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx.WithEventManager(em), storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	require.False(t, wasmKeeper.IsInactiveCode(ctx, example.CodeID))
+	require.False(t, wasmKeeper.IsInactiveContract(ctx, example.Contract))
+	require.Len(t, em.Events(), 1)
+	require.Equal(t, "lbm.wasm.v1.EventActivateCodeProposal", em.Events()[0].Type)
+}
+
+func TestDeactivateContractProposalWithDisabledQueries(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	src := types.DeactivateContractProposal{
+		Title:          "Foo",
+		Description:    "Bar",
+		Contract:       example.Contract.String(),
+		DisableQueries: true,
+	}
+
+	em := sdk.NewEventManager()
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, &src)
+	require.NoError(t, err)
+
+	// proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx.WithEventManager(em), storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	require.True(t, wasmKeeper.IsInactiveContract(ctx, example.Contract))
+	require.True(t, wasmKeeper.IsQueryDisabledContract(ctx, example.Contract))
+	_, err = wasmKeeper.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.ErrorIs(t, err, types.ErrInactiveContract)
+}
+
+func TestDeactivateCodeProposalWithDisabledQueries(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	src := types.DeactivateCodeProposal{
+		Title:          "Foo",
+		Description:    "Bar",
+		CodeID:         example.CodeID,
+		DisableQueries: true,
+	}
+
+	em := sdk.NewEventManager()
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, &src)
+	require.NoError(t, err)
+
+	// proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx.WithEventManager(em), storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	require.True(t, wasmKeeper.IsInactiveContract(ctx, example.Contract))
+	require.True(t, wasmKeeper.IsQueryDisabledContract(ctx, example.Contract))
+	_, err = wasmKeeper.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.ErrorIs(t, err, types.ErrInactiveContract)
+}
+
+func TestDeactivateContractProposalWithExpiry(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	expiryHeight := uint64(ctx.BlockHeight()) + 5
+
+	src := types.DeactivateContractProposal{
+		Title:        "Foo",
+		Description:  "Bar",
+		Contract:     example.Contract.String(),
+		ExpiryHeight: expiryHeight,
+	}
+
+	em := sdk.NewEventManager()
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, &src)
+	require.NoError(t, err)
+
+	// proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx.WithEventManager(em), storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	require.True(t, wasmKeeper.IsInactiveContract(ctx, example.Contract))
+	exp := types.InactiveContractInfo{
+		Address:           example.Contract.String(),
+		Reason:            "Bar",
+		DeactivatedHeight: ctx.BlockHeight(),
+		ExpiryHeight:      expiryHeight,
+	}
+	require.Equal(t, &exp, wasmKeeper.GetInactiveContractInfo(ctx, example.Contract))
+	expEvent, err := sdk.TypedEventToEvent(&types.EventDeactivateContractProposal{Contract: example.Contract.String(), ExpiryHeight: expiryHeight})
+	require.NoError(t, err)
+	require.Contains(t, em.Events(), expEvent)
+
+	// and activated on the expiry
+	wasmKeeper.ActivateExpiredContracts(ctx.WithBlockHeight(int64(expiryHeight)))
+	require.False(t, wasmKeeper.IsInactiveContract(ctx, example.Contract))
+}
+
+func TestDeactivateContractProposalWithPastExpiry(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	expiryTime := ctx.BlockTime().Add(time.Hour)
+
+	src := types.DeactivateContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    example.Contract.String(),
+		ExpiryTime:  &expiryTime,
+	}
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, &src)
+	require.NoError(t, err)
+
+	// and executed after the expiry
+	em := sdk.NewEventManager()
+	ctx = ctx.WithBlockTime(expiryTime.Add(time.Hour)).WithEventManager(em)
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx, storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then the deactivation expires at the current block
+	require.True(t, wasmKeeper.IsInactiveContract(ctx, example.Contract))
+	blockTime := ctx.BlockTime()
+	require.Equal(t, &blockTime, wasmKeeper.GetInactiveContractInfo(ctx, example.Contract).ExpiryTime)
+
+	// and the contract is activated at the end of the block
+	wasmKeeper.ActivateExpiredContracts(ctx)
+	require.False(t, wasmKeeper.IsInactiveContract(ctx, example.Contract))
+	expEvent, err := sdk.TypedEventToEvent(&types.EventActivateExpiredContract{Contract: example.Contract.String()})
+	require.NoError(t, err)
+	require.Contains(t, em.Events(), expEvent)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,6 +18,9 @@ import (
 type queryKeeper interface {
 	IterateInactiveContracts(ctx sdk.Context, fn func(contractAddress sdk.AccAddress) bool)
 	IsInactiveContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	IsQueryDisabledContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	IsAdminPausedContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	GetInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.InactiveContractInfo
 	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
 }
 
@@ -39,11 +43,19 @@ func (q grpcQuerier) InactiveContracts(c context.Context, req *types.QueryInacti
 	ctx := sdk.UnwrapSDKContext(c)
 
 	addresses := make([]string, 0)
+	contracts := make([]types.InactiveContractInfo, 0)
 	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.InactiveContractPrefix)
 	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		if accumulate {
 			contractAddress := sdk.AccAddress(value)
 			addresses = append(addresses, contractAddress.String())
+
+			info := q.keeper.GetInactiveContractInfo(ctx, contractAddress)
+			if info == nil {
+				// deactivated before the details are stored
+				info = &types.InactiveContractInfo{Address: contractAddress.String()}
+			}
+			contracts = append(contracts, *info)
 		}
 		return true, nil
 	})
@@ -53,6 +65,7 @@ func (q grpcQuerier) InactiveContracts(c context.Context, req *types.QueryInacti
 	return &types.QueryInactiveContractsResponse{
 		Addresses:  addresses,
 		Pagination: pageRes,
+		Contracts:  contracts,
 	}, nil
 }
 
@@ -73,6 +86,32 @@ func (q grpcQuerier) InactiveContract(c context.Context, req *types.QueryInactiv
 
 	inactivated := q.keeper.IsInactiveContract(ctx, contractAddr)
 	return &types.QueryInactiveContractResponse{
-		Inactivated: inactivated,
+		Inactivated:     inactivated,
+		QueriesDisabled: inactivated && q.keeper.IsQueryDisabledContract(ctx, contractAddr),
+		PausedByAdmin:   inactivated && q.keeper.IsAdminPausedContract(ctx, contractAddr),
+		Info:            q.keeper.GetInactiveContractInfo(ctx, contractAddr),
+	}, nil
+}
+
+func (q grpcQuerier) InactiveCodes(c context.Context, req *types.QueryInactiveCodesRequest) (*types.QueryInactiveCodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	codeIDs := make([]uint64, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.InactiveCodePrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			codeIDs = append(codeIDs, binary.BigEndian.Uint64(key))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryInactiveCodesResponse{
+		CodeIDs:    codeIDs,
+		Pagination: pageRes,
 	}, nil
 }
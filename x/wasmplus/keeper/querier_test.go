@@ -103,6 +103,11 @@ func TestQueryInactiveContracts(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, spec.expAddrs, got.Addresses)
 			assert.EqualValues(t, spec.expPaginationTotal, got.Pagination.Total)
+			require.Len(t, got.Contracts, len(spec.expAddrs))
+			for i, addr := range spec.expAddrs {
+				assert.Equal(t, addr, got.Contracts[i].Address)
+				assert.Equal(t, ctx.BlockHeight(), got.Contracts[i].DeactivatedHeight)
+			}
 		})
 	}
 }
@@ -120,10 +125,14 @@ func TestQueryInactiveContract(t *testing.T) {
 	got, err := q.InactiveContract(sdk.WrapSDKContext(ctx), rq)
 	require.NoError(t, err)
 	require.False(t, got.Inactivated)
+	require.Nil(t, got.Info)
 
 	// set inactive
 	err = keeper.deactivateContract(ctx, example.Contract)
 	require.NoError(t, err)
+	expiryHeight := uint64(ctx.BlockHeight()) + 10
+	err = keeper.updateInactiveContractInfo(ctx, example.Contract, "reason", expiryHeight, nil)
+	require.NoError(t, err)
 
 	specs := map[string]struct {
 		srcQuery       *types.QueryInactiveContractRequest
@@ -155,8 +164,34 @@ func TestQueryInactiveContract(t *testing.T) {
 
 			require.NoError(t, err)
 			require.True(t, got.Inactivated)
+			require.False(t, got.QueriesDisabled)
+			require.False(t, got.PausedByAdmin)
+			expInfo := types.InactiveContractInfo{
+				Address:           contractAddr.String(),
+				Reason:            "reason",
+				DeactivatedHeight: ctx.BlockHeight(),
+				ExpiryHeight:      expiryHeight,
+			}
+			require.Equal(t, &expInfo, got.Info)
 		})
 	}
+
+	// disable queries
+	err = keeper.disableContractQueries(ctx, example.Contract)
+	require.NoError(t, err)
+	got, err = q.InactiveContract(sdk.WrapSDKContext(ctx), rq)
+	require.NoError(t, err)
+	require.True(t, got.Inactivated)
+	require.True(t, got.QueriesDisabled)
+	require.False(t, got.PausedByAdmin)
+
+	// paused by the admin
+	require.NoError(t, keeper.activateContract(ctx, example.Contract))
+	require.NoError(t, keeper.pauseContract(ctx, example.Contract, example.CreatorAddr))
+	got, err = q.InactiveContract(sdk.WrapSDKContext(ctx), rq)
+	require.NoError(t, err)
+	require.True(t, got.Inactivated)
+	require.True(t, got.PausedByAdmin)
 }
 
 func fromBase64(s string) []byte {
@@ -166,3 +201,64 @@ func fromBase64(s string) []byte {
 	}
 	return r
 }
+
+func TestQueryInactiveCodes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	var codeIDs []uint64
+	for i := 0; i < 3; i++ {
+		example := StoreHackatomExampleContract(t, ctx, keepers)
+		require.NoError(t, keeper.deactivateCode(ctx, example.CodeID))
+		codeIDs = append(codeIDs, example.CodeID)
+	}
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery           *types.QueryInactiveCodesRequest
+		expCodeIDs         []uint64
+		expPaginationTotal uint64
+		expErr             error
+	}{
+		"req nil": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+		"query all": {
+			srcQuery:           &types.QueryInactiveCodesRequest{},
+			expCodeIDs:         codeIDs,
+			expPaginationTotal: 3,
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryInactiveCodesRequest{
+				Pagination: &query.PageRequest{
+					Offset: 1,
+				},
+			},
+			expCodeIDs:         codeIDs[1:],
+			expPaginationTotal: 3,
+		},
+		"with pagination limit": {
+			srcQuery: &types.QueryInactiveCodesRequest{
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			expCodeIDs:         codeIDs[:1],
+			expPaginationTotal: 0,
+		},
+	}
+
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.InactiveCodes(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			if spec.expErr != nil {
+				require.Equal(t, spec.expErr, err, "but got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expCodeIDs, got.CodeIDs)
+			assert.EqualValues(t, spec.expPaginationTotal, got.Pagination.Total)
+		})
+	}
+}
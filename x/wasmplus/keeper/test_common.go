@@ -353,6 +353,7 @@ func createTestInput(
 		tempDir,
 		wasmConfig,
 		availableCapabilities,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		opts...,
 	)
 	keeper.SetParams(ctx, wasmtypes.DefaultParams())
@@ -367,10 +368,10 @@ func createTestInput(
 	)
 	am.RegisterServices(module.NewConfigurator(appCodec, msgRouter, querier))
 	// wasmplus service
-	types.RegisterMsgServer(msgRouter, NewMsgServerImpl(wasmkeeper.NewDefaultPermissionKeeper(keeper)))
+	types.RegisterMsgServer(msgRouter, NewMsgServerImpl(contractKeeper))
 	types.RegisterQueryServer(querier, Querier(&keeper))
 	// wasm service
-	wasmtypes.RegisterMsgServer(msgRouter, wasmkeeper.NewMsgServerImpl(wasmkeeper.NewDefaultPermissionKeeper(keeper)))
+	wasmtypes.RegisterMsgServer(msgRouter, wasmkeeper.NewMsgServerImpl(contractKeeper))
 	wasmtypes.RegisterQueryServer(querier, WasmQuerier(&keeper))
 
 	govRouter := govtypes.NewRouter().
@@ -12,6 +12,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/types/module"
 	authkeeper "github.com/Finschia/finschia-sdk/x/auth/keeper"
 	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
@@ -441,3 +442,135 @@ func TestErrorHandleNonPlusWasmCreate(t *testing.T) {
 	_, err := h(data.ctx, msg)
 	require.ErrorContains(t, err, "Wasm validation")
 }
+
+func TestHandleStoreAndMigrate(t *testing.T) {
+	data := setupTest(t)
+	creator := data.faucet.NewFundedRandomAccount(data.ctx, sdk.NewInt64Coin("denom", 100000))
+
+	h := data.module.Route().Handler()
+	q := data.module.LegacyQuerierHandler(nil)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	_, _, newVerifier := keyPubAddr()
+
+	initMsgBz, err := json.Marshal(initMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+	migMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: newVerifier})
+	require.NoError(t, err)
+
+	res, err := h(data.ctx, &types.MsgStoreCodeAndInstantiateContract{
+		Sender:       creator.String(),
+		WASMByteCode: testContract,
+		Admin:        creator.String(),
+		Msg:          initMsgBz,
+		Label:        "contract for test",
+	})
+	require.NoError(t, err)
+	_, contractBech32Addr := parseStoreAndInitResponse(t, res.Data)
+
+	// when
+	data.ctx = data.ctx.WithEventManager(sdk.NewEventManager())
+	msg := &types.MsgStoreCodeAndMigrateContract{
+		Sender:       creator.String(),
+		WASMByteCode: testContract,
+		Contract:     contractBech32Addr,
+		Msg:          migMsgBz,
+	}
+	res, err = h(data.ctx, msg)
+
+	// then
+	require.NoError(t, err)
+	var pRsp types.MsgStoreCodeAndMigrateContractResponse
+	require.NoError(t, pRsp.Unmarshal(res.Data))
+	assert.Equal(t, uint64(2), pRsp.CodeID)
+	assert.NotEmpty(t, pRsp.Checksum)
+
+	require.Equal(t, 2, len(res.Events), prettyEvents(res.Events))
+	assert.Equal(t, "store_code", res.Events[0].Type)
+	assertAttribute(t, "code_id", "2", res.Events[0].Attributes[1])
+	assert.Equal(t, "migrate", res.Events[1].Type)
+	assertAttribute(t, "code_id", "2", res.Events[1].Attributes[0])
+	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[1].Attributes[1])
+
+	assertCodeList(t, q, data.ctx, 2)
+	assertContractInfo(t, q, data.ctx, contractBech32Addr, 2, creator)
+	assertContractState(t, q, data.ctx, contractBech32Addr, state{
+		Verifier:    newVerifier.String(),
+		Beneficiary: bob.String(),
+		Funder:      creator.String(),
+	})
+}
+
+func TestErrorsStoreAndMigrate(t *testing.T) {
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(initMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+	migMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: bob})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		sender     func(creator sdk.AccAddress) sdk.AccAddress
+		wasmCode   []byte
+		deactivate bool
+		expErr     error
+	}{
+		"not admin": {
+			sender:   func(_ sdk.AccAddress) sdk.AccAddress { return fred },
+			wasmCode: testContract,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"invalid wasm": {
+			sender:   func(creator sdk.AccAddress) sdk.AccAddress { return creator },
+			wasmCode: []byte("foobar"),
+			expErr:   wasmtypes.ErrCreateFailed,
+		},
+		"inactive contract": {
+			sender:     func(creator sdk.AccAddress) sdk.AccAddress { return creator },
+			wasmCode:   testContract,
+			deactivate: true,
+			expErr:     types.ErrInactiveContract,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			data := setupTest(t)
+			creator := data.faucet.NewFundedRandomAccount(data.ctx, sdk.NewInt64Coin("denom", 100000))
+
+			h := data.module.Route().Handler()
+			q := data.module.LegacyQuerierHandler(nil)
+
+			res, err := h(data.ctx, &types.MsgStoreCodeAndInstantiateContract{
+				Sender:       creator.String(),
+				WASMByteCode: testContract,
+				Admin:        creator.String(),
+				Msg:          initMsgBz,
+				Label:        "contract for test",
+			})
+			require.NoError(t, err)
+			_, contractBech32Addr := parseStoreAndInitResponse(t, res.Data)
+			if spec.deactivate {
+				contractKeeper := keeper.NewPermissionedKeeper(*wasmkeeper.NewGovPermissionKeeper(data.keeper), data.keeper)
+				require.NoError(t, contractKeeper.DeactivateContract(data.ctx, sdk.MustAccAddressFromBech32(contractBech32Addr)))
+			}
+
+			// when
+			cacheCtx, _ := data.ctx.CacheContext()
+			_, err = h(cacheCtx, &types.MsgStoreCodeAndMigrateContract{
+				Sender:       spec.sender(creator).String(),
+				WASMByteCode: spec.wasmCode,
+				Contract:     contractBech32Addr,
+				Msg:          migMsgBz,
+			})
+
+			// then
+			require.ErrorIs(t, err, spec.expErr)
+			assertContractInfo(t, q, cacheCtx, contractBech32Addr, 1, creator)
+		})
+	}
+}
@@ -17,9 +17,14 @@ import (
 // RegisterLegacyAminoCodec registers the account types and interface
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint:staticcheck
 	legacy.RegisterAminoMsg(cdc, &MsgStoreCodeAndInstantiateContract{}, "wasm/MsgStoreCodeAndInstantiateContract")
+	legacy.RegisterAminoMsg(cdc, &MsgStoreCodeAndMigrateContract{}, "wasm/MsgStoreCodeAndMigrateContract")
+	legacy.RegisterAminoMsg(cdc, &MsgPauseContract{}, "wasm/MsgPauseContract")
+	legacy.RegisterAminoMsg(cdc, &MsgUnpauseContract{}, "wasm/MsgUnpauseContract")
 
 	cdc.RegisterConcrete(&DeactivateContractProposal{}, "wasm/DeactivateContractProposal", nil)
 	cdc.RegisterConcrete(&ActivateContractProposal{}, "wasm/ActivateContractProposal", nil)
+	cdc.RegisterConcrete(&DeactivateCodeProposal{}, "wasm/DeactivateCodeProposal", nil)
+	cdc.RegisterConcrete(&ActivateCodeProposal{}, "wasm/ActivateCodeProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -27,11 +32,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgStoreCodeAndInstantiateContract{},
+		&MsgStoreCodeAndMigrateContract{},
+		&MsgPauseContract{},
+		&MsgUnpauseContract{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&DeactivateContractProposal{},
 		&ActivateContractProposal{},
+		&DeactivateCodeProposal{},
+		&ActivateCodeProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -10,4 +10,10 @@ import (
 var (
 	// ErrInactiveContract error if the contract set inactive
 	ErrInactiveContract = sdkErrors.Register(wasmtypes.DefaultCodespace, 101, "inactive contract")
+
+	// ErrInactiveCode error if the code set inactive
+	ErrInactiveCode = sdkErrors.Register(wasmtypes.DefaultCodespace, 102, "inactive code")
+
+	// ErrGovPausedContract error if the admin tries to unpause the contract deactivated by governance
+	ErrGovPausedContract = sdkErrors.Register(wasmtypes.DefaultCodespace, 103, "contract deactivated by governance")
 )
@@ -8,8 +8,12 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -18,6 +22,7 @@ var _ = proto.Marshal
 var (
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -31,6 +36,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type EventDeactivateContractProposal struct {
 	// contract is the smart contract's address
 	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// disable_queries is true when smart queries to the contract are rejected
+	DisableQueries bool `protobuf:"varint,2,opt,name=disable_queries,json=disableQueries,proto3" json:"disable_queries,omitempty"`
+	// expiry_height is the block height at which the contract is activated
+	// again automatically
+	ExpiryHeight uint64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// expiry_time is the block time at which the contract is activated again
+	// automatically
+	ExpiryTime *time.Time `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 }
 
 func (m *EventDeactivateContractProposal) Reset()         { *m = EventDeactivateContractProposal{} }
@@ -78,6 +91,27 @@ func (m *EventDeactivateContractProposal) GetContract() string {
 	return ""
 }
 
+func (m *EventDeactivateContractProposal) GetDisableQueries() bool {
+	if m != nil {
+		return m.DisableQueries
+	}
+	return false
+}
+
+func (m *EventDeactivateContractProposal) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *EventDeactivateContractProposal) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
 // EventActivateContractProposal is the event that is emitted when the contract
 // is activates.
 type EventActivateContractProposal struct {
@@ -130,27 +164,317 @@ func (m *EventActivateContractProposal) GetContract() string {
 	return ""
 }
 
+// EventDeactivateCodeProposal is the event that is emitted when the code is
+// deactivated.
+type EventDeactivateCodeProposal struct {
+	// code_id is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// disable_queries is true when smart queries to the contracts of the code
+	// are rejected
+	DisableQueries bool `protobuf:"varint,2,opt,name=disable_queries,json=disableQueries,proto3" json:"disable_queries,omitempty"`
+}
+
+func (m *EventDeactivateCodeProposal) Reset()         { *m = EventDeactivateCodeProposal{} }
+func (m *EventDeactivateCodeProposal) String() string { return proto.CompactTextString(m) }
+func (*EventDeactivateCodeProposal) ProtoMessage()    {}
+func (*EventDeactivateCodeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4be408da9fc96f03, []int{2}
+}
+
+func (m *EventDeactivateCodeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventDeactivateCodeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeactivateCodeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventDeactivateCodeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeactivateCodeProposal.Merge(m, src)
+}
+
+func (m *EventDeactivateCodeProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventDeactivateCodeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeactivateCodeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeactivateCodeProposal proto.InternalMessageInfo
+
+func (m *EventDeactivateCodeProposal) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
+	}
+	return 0
+}
+
+func (m *EventDeactivateCodeProposal) GetDisableQueries() bool {
+	if m != nil {
+		return m.DisableQueries
+	}
+	return false
+}
+
+// EventActivateCodeProposal is the event that is emitted when the code is
+// activated.
+type EventActivateCodeProposal struct {
+	// code_id is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (m *EventActivateCodeProposal) Reset()         { *m = EventActivateCodeProposal{} }
+func (m *EventActivateCodeProposal) String() string { return proto.CompactTextString(m) }
+func (*EventActivateCodeProposal) ProtoMessage()    {}
+func (*EventActivateCodeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4be408da9fc96f03, []int{3}
+}
+
+func (m *EventActivateCodeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventActivateCodeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventActivateCodeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventActivateCodeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventActivateCodeProposal.Merge(m, src)
+}
+
+func (m *EventActivateCodeProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventActivateCodeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventActivateCodeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventActivateCodeProposal proto.InternalMessageInfo
+
+func (m *EventActivateCodeProposal) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
+	}
+	return 0
+}
+
+// EventPauseContract is the event that is emitted when the contract is paused
+// by its admin.
+type EventPauseContract struct {
+	// contract is the smart contract's address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *EventPauseContract) Reset()         { *m = EventPauseContract{} }
+func (m *EventPauseContract) String() string { return proto.CompactTextString(m) }
+func (*EventPauseContract) ProtoMessage()    {}
+func (*EventPauseContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4be408da9fc96f03, []int{4}
+}
+
+func (m *EventPauseContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventPauseContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPauseContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventPauseContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPauseContract.Merge(m, src)
+}
+
+func (m *EventPauseContract) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventPauseContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPauseContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPauseContract proto.InternalMessageInfo
+
+func (m *EventPauseContract) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// EventUnpauseContract is the event that is emitted when the contract is
+// unpaused by its admin.
+type EventUnpauseContract struct {
+	// contract is the smart contract's address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *EventUnpauseContract) Reset()         { *m = EventUnpauseContract{} }
+func (m *EventUnpauseContract) String() string { return proto.CompactTextString(m) }
+func (*EventUnpauseContract) ProtoMessage()    {}
+func (*EventUnpauseContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4be408da9fc96f03, []int{5}
+}
+
+func (m *EventUnpauseContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventUnpauseContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnpauseContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventUnpauseContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnpauseContract.Merge(m, src)
+}
+
+func (m *EventUnpauseContract) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventUnpauseContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnpauseContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnpauseContract proto.InternalMessageInfo
+
+func (m *EventUnpauseContract) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// EventActivateExpiredContract is the event that is emitted when the
+// deactivation of the contract is expired and the contract is activated
+// again.
+type EventActivateExpiredContract struct {
+	// contract is the smart contract's address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *EventActivateExpiredContract) Reset()         { *m = EventActivateExpiredContract{} }
+func (m *EventActivateExpiredContract) String() string { return proto.CompactTextString(m) }
+func (*EventActivateExpiredContract) ProtoMessage()    {}
+func (*EventActivateExpiredContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4be408da9fc96f03, []int{6}
+}
+
+func (m *EventActivateExpiredContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventActivateExpiredContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventActivateExpiredContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventActivateExpiredContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventActivateExpiredContract.Merge(m, src)
+}
+
+func (m *EventActivateExpiredContract) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventActivateExpiredContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventActivateExpiredContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventActivateExpiredContract proto.InternalMessageInfo
+
+func (m *EventActivateExpiredContract) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventDeactivateContractProposal)(nil), "lbm.wasm.v1.EventDeactivateContractProposal")
 	proto.RegisterType((*EventActivateContractProposal)(nil), "lbm.wasm.v1.EventActivateContractProposal")
+	proto.RegisterType((*EventDeactivateCodeProposal)(nil), "lbm.wasm.v1.EventDeactivateCodeProposal")
+	proto.RegisterType((*EventActivateCodeProposal)(nil), "lbm.wasm.v1.EventActivateCodeProposal")
+	proto.RegisterType((*EventPauseContract)(nil), "lbm.wasm.v1.EventPauseContract")
+	proto.RegisterType((*EventUnpauseContract)(nil), "lbm.wasm.v1.EventUnpauseContract")
+	proto.RegisterType((*EventActivateExpiredContract)(nil), "lbm.wasm.v1.EventActivateExpiredContract")
 }
 
 func init() { proto.RegisterFile("lbm/wasm/v1/event.proto", fileDescriptor_4be408da9fc96f03) }
 
 var fileDescriptor_4be408da9fc96f03 = []byte{
-	// 188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcf, 0x49, 0xca, 0xd5,
-	0x2f, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xce, 0x49, 0xca, 0xd5, 0x03, 0x49, 0xe8, 0x95, 0x19, 0x2a, 0xd9,
-	0x72, 0xc9, 0xbb, 0x82, 0xe4, 0x5c, 0x52, 0x13, 0x93, 0x4b, 0x32, 0xcb, 0x12, 0x4b, 0x52, 0x9d,
-	0xf3, 0xf3, 0x4a, 0x8a, 0x12, 0x93, 0x4b, 0x02, 0x8a, 0xf2, 0x0b, 0xf2, 0x8b, 0x13, 0x73, 0x84,
-	0xa4, 0xb8, 0x38, 0x92, 0xa1, 0x62, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x70, 0xbe, 0x92,
-	0x35, 0x97, 0x2c, 0x58, 0xbb, 0x23, 0x19, 0x9a, 0x9d, 0x5c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
-	0xdf, 0x2d, 0x33, 0xaf, 0x38, 0x39, 0x23, 0x33, 0x11, 0xec, 0x97, 0x14, 0xfd, 0x0a, 0x30, 0x5d,
-	0x90, 0x53, 0x5a, 0xac, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x95, 0x31, 0x20,
-	0x00, 0x00, 0xff, 0xff, 0x2b, 0x86, 0x7f, 0x27, 0xf0, 0x00, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0xce, 0x93, 0x40,
+	0x14, 0xc5, 0x3b, 0x4a, 0xea, 0xe7, 0xd4, 0x3f, 0x09, 0xf9, 0x12, 0x11, 0x15, 0x08, 0x5d, 0x48,
+	0x5c, 0x30, 0xb6, 0xee, 0x74, 0x63, 0x6b, 0x6b, 0xec, 0xae, 0x12, 0xdd, 0xb8, 0x69, 0x06, 0x18,
+	0x61, 0x22, 0x30, 0xc8, 0x0c, 0xd8, 0xbe, 0x45, 0x1f, 0xcb, 0x95, 0xe9, 0xd2, 0x95, 0x1a, 0xfa,
+	0x22, 0x66, 0x86, 0xb6, 0x89, 0x9a, 0x98, 0xea, 0x0a, 0xe6, 0xdc, 0x73, 0x2e, 0xf7, 0x77, 0x19,
+	0x78, 0x27, 0x0b, 0x73, 0xf4, 0x09, 0xf3, 0x1c, 0x35, 0x23, 0x44, 0x1a, 0x52, 0x08, 0xbf, 0xac,
+	0x98, 0x60, 0xfa, 0x20, 0x0b, 0x73, 0x5f, 0x16, 0xfc, 0x66, 0x64, 0x5e, 0x26, 0x2c, 0x61, 0x4a,
+	0x47, 0xf2, 0xad, 0xb3, 0x98, 0x76, 0xc2, 0x58, 0x92, 0x11, 0xa4, 0x4e, 0x61, 0xfd, 0x1e, 0x09,
+	0x9a, 0x13, 0x2e, 0x70, 0x5e, 0x76, 0x06, 0xf7, 0x0b, 0x80, 0xf6, 0x5c, 0xf6, 0x9c, 0x11, 0x1c,
+	0x09, 0xda, 0x60, 0x41, 0x5e, 0xb0, 0x42, 0x54, 0x38, 0x12, 0xcb, 0x8a, 0x95, 0x8c, 0xe3, 0x4c,
+	0x37, 0xe1, 0x45, 0x74, 0xd0, 0x0c, 0xe0, 0x00, 0xef, 0x7a, 0x70, 0x3a, 0xeb, 0x0f, 0xe1, 0xed,
+	0x98, 0x72, 0x1c, 0x66, 0x64, 0xf5, 0xb1, 0x26, 0x15, 0x25, 0xdc, 0xb8, 0xe2, 0x00, 0xef, 0x22,
+	0xb8, 0x75, 0x90, 0x5f, 0x77, 0xaa, 0x3e, 0x84, 0x37, 0xc9, 0xba, 0xa4, 0xd5, 0x66, 0x95, 0x12,
+	0x9a, 0xa4, 0xc2, 0xb8, 0xea, 0x00, 0x4f, 0x0b, 0x6e, 0x74, 0xe2, 0x2b, 0xa5, 0xe9, 0x13, 0x38,
+	0x38, 0x98, 0xe4, 0x9c, 0x86, 0xe6, 0x00, 0x6f, 0x30, 0x36, 0xfd, 0x0e, 0xc2, 0x3f, 0x42, 0xf8,
+	0x6f, 0x8e, 0x10, 0x53, 0x6d, 0xfb, 0xdd, 0x06, 0x01, 0xec, 0x42, 0x52, 0x76, 0x9f, 0xc1, 0x07,
+	0x8a, 0x67, 0xf2, 0x1f, 0x34, 0xee, 0x07, 0x78, 0xef, 0x8f, 0x65, 0xc4, 0xe4, 0x14, 0x1d, 0xc2,
+	0x6b, 0x11, 0x8b, 0xc9, 0x8a, 0xc6, 0x2a, 0xa9, 0x4d, 0x61, 0xfb, 0xcd, 0xee, 0x4b, 0xcb, 0x62,
+	0x16, 0xf4, 0x65, 0x69, 0x11, 0x9f, 0xbd, 0x11, 0xf7, 0x39, 0xbc, 0xfb, 0xdb, 0xa4, 0xff, 0xf8,
+	0x29, 0xf7, 0x31, 0xd4, 0x55, 0x87, 0x25, 0xae, 0xf9, 0x09, 0xf4, 0xaf, 0x80, 0x63, 0x78, 0xa9,
+	0x12, 0x6f, 0x8b, 0xf2, 0xec, 0xcc, 0x53, 0x78, 0xff, 0x97, 0x39, 0xe7, 0x72, 0xd9, 0x24, 0x3e,
+	0x27, 0x3b, 0x9d, 0x7d, 0x6e, 0x2d, 0xb0, 0x6b, 0x2d, 0xf0, 0xa3, 0xb5, 0xc0, 0x76, 0x6f, 0xf5,
+	0x76, 0x7b, 0xab, 0xf7, 0x75, 0x6f, 0xf5, 0xde, 0x3d, 0x4a, 0xa8, 0x48, 0xeb, 0xd0, 0x8f, 0x58,
+	0x8e, 0x5e, 0xd2, 0x82, 0x47, 0x29, 0xc5, 0xea, 0x96, 0xc7, 0x68, 0xad, 0x9e, 0x65, 0x56, 0x73,
+	0x24, 0x36, 0x25, 0xe1, 0x61, 0x5f, 0xfd, 0xf9, 0x27, 0x3f, 0x07, 0x00, 0x28, 0xbc, 0xe0, 0x40,
+	0x0a, 0x03, 0x00, 0x00,
 }
 
 func (m *EventDeactivateContractProposal) Marshal() (dAtA []byte, err error) {
@@ -173,6 +497,31 @@ func (m *EventDeactivateContractProposal) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEvent(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DisableQueries {
+		i--
+		if m.DisableQueries {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Contract) > 0 {
 		i -= len(m.Contract)
 		copy(dAtA[i:], m.Contract)
@@ -213,49 +562,281 @@ func (m *EventActivateContractProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventDeactivateCodeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *EventDeactivateContractProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventDeactivateCodeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeactivateCodeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.DisableQueries {
+		i--
+		if m.DisableQueries {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.CodeID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventActivateContractProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventActivateCodeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventActivateCodeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventActivateCodeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.CodeID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventPauseContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func sozEvent(x uint64) (n int) {
+func (m *EventPauseContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPauseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnpauseContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnpauseContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnpauseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventActivateExpiredContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventActivateExpiredContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventActivateExpiredContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *EventDeactivateContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DisableQueries {
+		n += 2
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovEvent(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventActivateContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeactivateCodeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovEvent(uint64(m.CodeID))
+	}
+	if m.DisableQueries {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventActivateCodeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovEvent(uint64(m.CodeID))
+	}
+	return n
+}
+
+func (m *EventPauseContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUnpauseContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventActivateExpiredContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozEvent(x uint64) (n int) {
 	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 
@@ -320,6 +901,81 @@ func (m *EventDeactivateContractProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableQueries", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableQueries = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -425,6 +1081,415 @@ func (m *EventActivateContractProposal) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *EventDeactivateCodeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeactivateCodeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeactivateCodeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableQueries", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableQueries = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EventActivateCodeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventActivateCodeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventActivateCodeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EventPauseContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPauseContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPauseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EventUnpauseContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnpauseContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnpauseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EventActivateExpiredContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventActivateExpiredContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventActivateExpiredContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 
 	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
@@ -9,6 +11,14 @@ import (
 type ViewKeeper interface {
 	IterateInactiveContracts(ctx sdk.Context, fn func(contractAddress sdk.AccAddress) bool)
 	IsInactiveContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	IterateInactiveCodes(ctx sdk.Context, fn func(codeID uint64) bool)
+	IsInactiveCode(ctx sdk.Context, codeID uint64) bool
+	IterateQueryDisabledContracts(ctx sdk.Context, fn func(contractAddress sdk.AccAddress) bool)
+	IsQueryDisabledContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	IterateAdminPausedContracts(ctx sdk.Context, fn func(contractAddress sdk.AccAddress) bool)
+	IsAdminPausedContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	IterateInactiveContractInfos(ctx sdk.Context, fn func(info InactiveContractInfo) bool)
+	GetInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *InactiveContractInfo
 }
 
 type ContractOpsKeeper interface {
@@ -19,4 +29,26 @@ type ContractOpsKeeper interface {
 
 	// ActivateContract remove the contract address from inactive contract list.
 	ActivateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
+
+	// DisableContractQueries rejects smart queries to the inactive contract until it is activated.
+	DisableContractQueries(ctx sdk.Context, contractAddress sdk.AccAddress) error
+
+	// UpdateInactiveContractInfo sets the reason and the optional expiry of the deactivation of the inactive contract.
+	// The contract is activated automatically at the expiry height or time.
+	UpdateInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, reason string, expiryHeight uint64, expiryTime *time.Time) error
+
+	// PauseContract add the contract address to inactive contract list on behalf of the contract admin.
+	PauseContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+
+	// UnpauseContract remove the contract address paused by the contract admin from inactive contract list.
+	UnpauseContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+
+	// DeactivateCode add the code id to inactive code list.
+	DeactivateCode(ctx sdk.Context, codeID uint64) error
+
+	// ActivateCode remove the code id from inactive code list.
+	ActivateCode(ctx sdk.Context, codeID uint64) error
+
+	// DisableCodeQueries rejects smart queries to the contracts of the inactive code until they are activated.
+	DisableCodeQueries(ctx sdk.Context, codeID uint64) error
 }
@@ -36,6 +36,47 @@ func (gs GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "inactive contract address: %d", i)
 		}
 	}
+	inactiveContracts := make(map[string]struct{}, len(gs.InactiveContractAddresses))
+	for _, addr := range gs.InactiveContractAddresses {
+		inactiveContracts[addr] = struct{}{}
+	}
+	// the queries of a contract can also be disabled when it is inactive through its code
+	inactiveCodes := make(map[uint64]struct{}, len(gs.InactiveCodeIDs))
+	for _, codeID := range gs.InactiveCodeIDs {
+		inactiveCodes[codeID] = struct{}{}
+	}
+	queryDisableable := make(map[string]struct{}, len(inactiveContracts))
+	for addr := range inactiveContracts {
+		queryDisableable[addr] = struct{}{}
+	}
+	for _, c := range gs.Contracts {
+		if _, ok := inactiveCodes[c.ContractInfo.CodeID]; ok {
+			queryDisableable[c.ContractAddress] = struct{}{}
+		}
+	}
+	for i, addr := range gs.QueryDisabledContractAddresses {
+		if _, ok := queryDisableable[addr]; !ok {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "query disabled contract address: %d: not an inactive contract", i)
+		}
+	}
+	for i, addr := range gs.AdminPausedContractAddresses {
+		if _, ok := inactiveContracts[addr]; !ok {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "admin paused contract address: %d: not an inactive contract", i)
+		}
+	}
+	for i, info := range gs.InactiveContractInfos {
+		if _, ok := inactiveContracts[info.Address]; !ok {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "inactive contract info: %d: not an inactive contract", i)
+		}
+		if info.ExpiryHeight != 0 && info.ExpiryTime != nil {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "inactive contract info: %d: expiry height and expiry time can not be set together", i)
+		}
+	}
+	for i, codeID := range gs.InactiveCodeIDs {
+		if codeID == 0 {
+			return sdkerrors.Wrapf(wasmtypes.ErrEmpty, "inactive code id: %d", i)
+		}
+	}
 	return nil
 }
 
@@ -45,6 +86,10 @@ func (gs GenesisState) RawWasmState() wasmtypes.GenesisState {
 	params := wasmtypes.Params{
 		CodeUploadAccess:             gs.Params.CodeUploadAccess,
 		InstantiateDefaultPermission: gs.Params.InstantiateDefaultPermission,
+		GasRegister:                  gs.Params.GasRegister,
+		StorageQuota:                 gs.Params.StorageQuota,
+		StargateQueryAcceptList:      gs.Params.StargateQueryAcceptList,
+		StargateMsgFilter:            gs.Params.StargateMsgFilter,
 	}
 	return wasmtypes.GenesisState{
 		Params:    params,
@@ -38,6 +38,17 @@ type GenesisState struct {
 	GenMsgs   []types.GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
 	// InactiveContractAddresses is a list of contract address that set inactive
 	InactiveContractAddresses []string `protobuf:"bytes,6,rep,name=inactive_contract_addresses,json=inactiveContractAddresses,proto3" json:"inactive_contract_address,omitempty"`
+	// InactiveCodeIDs is a list of code ids that set inactive
+	InactiveCodeIDs []uint64 `protobuf:"varint,7,rep,packed,name=inactive_code_ids,json=inactiveCodeIds,proto3" json:"inactive_code_ids,omitempty"`
+	// QueryDisabledContractAddresses is a list of inactive contract addresses
+	// that reject smart queries
+	QueryDisabledContractAddresses []string `protobuf:"bytes,8,rep,name=query_disabled_contract_addresses,json=queryDisabledContractAddresses,proto3" json:"query_disabled_contract_addresses,omitempty"`
+	// AdminPausedContractAddresses is a list of inactive contract addresses
+	// that are paused by their admin
+	AdminPausedContractAddresses []string `protobuf:"bytes,9,rep,name=admin_paused_contract_addresses,json=adminPausedContractAddresses,proto3" json:"admin_paused_contract_addresses,omitempty"`
+	// InactiveContractInfos is a list of the details of the deactivations of
+	// inactive contract addresses
+	InactiveContractInfos []InactiveContractInfo `protobuf:"bytes,10,rep,name=inactive_contract_infos,json=inactiveContractInfos,proto3" json:"inactive_contract_infos,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -120,6 +131,34 @@ func (m *GenesisState) GetInactiveContractAddresses() []string {
 	return nil
 }
 
+func (m *GenesisState) GetInactiveCodeIDs() []uint64 {
+	if m != nil {
+		return m.InactiveCodeIDs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueryDisabledContractAddresses() []string {
+	if m != nil {
+		return m.QueryDisabledContractAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminPausedContractAddresses() []string {
+	if m != nil {
+		return m.AdminPausedContractAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInactiveContractInfos() []InactiveContractInfo {
+	if m != nil {
+		return m.InactiveContractInfos
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lbm.wasm.v1.GenesisState")
 }
@@ -127,33 +166,42 @@ func init() {
 func init() { proto.RegisterFile("lbm/wasm/v1/genesis.proto", fileDescriptor_3308f670fed712dc) }
 
 var fileDescriptor_3308f670fed712dc = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x8b, 0xda, 0x40,
-	0x14, 0xc7, 0x93, 0xfa, 0xa3, 0x35, 0x16, 0x0a, 0x69, 0x69, 0x63, 0x2c, 0x51, 0x5a, 0x68, 0xa5,
-	0x94, 0x04, 0x2d, 0xf4, 0xde, 0xf4, 0xd7, 0xa9, 0x50, 0x94, 0xbd, 0x2c, 0x2c, 0x32, 0x99, 0x0c,
-	0x63, 0xc0, 0xc9, 0x64, 0xf3, 0x46, 0x77, 0x3d, 0xef, 0x3f, 0xb0, 0x7f, 0x96, 0x47, 0x8f, 0x7b,
-	0x92, 0x45, 0x6f, 0xfe, 0x15, 0x4b, 0x26, 0x19, 0xcd, 0xe2, 0x7a, 0x4a, 0xf2, 0xde, 0xe7, 0xfb,
-	0x79, 0x99, 0xe1, 0x19, 0xad, 0x69, 0xc0, 0xbc, 0x2b, 0x04, 0xcc, 0x9b, 0xf7, 0x3d, 0x4a, 0x62,
-	0x02, 0x11, 0xb8, 0x49, 0xca, 0x05, 0x37, 0x9b, 0xd3, 0x80, 0xb9, 0x59, 0xcb, 0x9d, 0xf7, 0xed,
-	0x37, 0x94, 0x53, 0x2e, 0xeb, 0x5e, 0xf6, 0x96, 0x23, 0xf6, 0x7b, 0xcc, 0x81, 0xc9, 0xb4, 0x52,
-	0x88, 0x45, 0x42, 0x0a, 0x81, 0xed, 0x1c, 0x75, 0x1f, 0x0d, 0xf8, 0x70, 0x53, 0x35, 0x5e, 0xfe,
-	0xcd, 0x2b, 0x23, 0x81, 0x04, 0x31, 0xbf, 0x1b, 0xf5, 0x04, 0xa5, 0x88, 0x81, 0xa5, 0x77, 0xf5,
-	0x5e, 0x73, 0x60, 0xb9, 0xca, 0xa0, 0xfe, 0xc3, 0xfd, 0x2f, 0xfb, 0x7e, 0x75, 0xb9, 0xee, 0x68,
-	0xc3, 0x82, 0x36, 0x7f, 0x1b, 0x35, 0xcc, 0x43, 0x02, 0xd6, 0xb3, 0x6e, 0xa5, 0xd7, 0x1c, 0xbc,
-	0x3d, 0x8e, 0xfd, 0xe4, 0x21, 0xf1, 0xdf, 0x65, 0xa1, 0xdd, 0xba, 0xf3, 0x4a, 0xc2, 0x5f, 0x39,
-	0x8b, 0x04, 0x61, 0x89, 0x58, 0x0c, 0xf3, 0xb4, 0x79, 0x66, 0x34, 0x30, 0x8f, 0x45, 0x8a, 0xb0,
-	0x00, 0xab, 0x22, 0x55, 0xf6, 0x53, 0xaa, 0x1c, 0xf1, 0xdb, 0x85, 0xee, 0xf5, 0x3e, 0x54, 0x52,
-	0x1e, 0x4c, 0x99, 0x16, 0xc8, 0xe5, 0x8c, 0xc4, 0x98, 0x80, 0x55, 0x3d, 0xa5, 0x1d, 0x15, 0xc8,
-	0x41, 0xbb, 0x0f, 0x95, 0xb5, 0xfb, 0xa2, 0x79, 0x61, 0xbc, 0xa0, 0x24, 0x1e, 0x33, 0xa0, 0x60,
-	0xd5, 0xa4, 0xf5, 0xd3, 0xb1, 0xb5, 0x7c, 0xbd, 0xd9, 0xc7, 0x3f, 0xa0, 0xe0, 0xdb, 0xc5, 0x04,
-	0x53, 0xe5, 0x4b, 0x03, 0x9e, 0xd3, 0x1c, 0x32, 0xa9, 0xd1, 0x8e, 0x62, 0x84, 0x45, 0x34, 0x27,
-	0x63, 0x75, 0x96, 0x31, 0x0a, 0xc3, 0x94, 0x00, 0x10, 0xb0, 0xea, 0xdd, 0x4a, 0xaf, 0xe1, 0x7f,
-	0xde, 0xad, 0x3b, 0x1f, 0x4f, 0x62, 0x25, 0x6d, 0x4b, 0x41, 0xea, 0xf6, 0x7e, 0x28, 0x93, 0xff,
-	0x6b, 0xb9, 0x71, 0xf4, 0xd5, 0xc6, 0xd1, 0xef, 0x37, 0x8e, 0x7e, 0xbb, 0x75, 0xb4, 0xd5, 0xd6,
-	0xd1, 0xee, 0xb6, 0x8e, 0x76, 0xfe, 0x85, 0x46, 0x62, 0x32, 0x0b, 0x5c, 0xcc, 0x99, 0xf7, 0x27,
-	0x8a, 0x01, 0x4f, 0x22, 0x24, 0x57, 0x29, 0xf4, 0xae, 0xe5, 0x33, 0x99, 0xce, 0x20, 0xdf, 0xb8,
-	0xa0, 0x2e, 0x57, 0xea, 0xdb, 0x43, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x26, 0x39, 0xc4, 0xd0,
-	0x02, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4d, 0x8f, 0xd2, 0x40,
+	0x18, 0xc7, 0xa9, 0xec, 0x1b, 0x83, 0x09, 0xb1, 0xbe, 0xd0, 0x2d, 0x9b, 0x16, 0x34, 0x51, 0xd6,
+	0x97, 0x36, 0x8b, 0x89, 0x77, 0x2b, 0x6a, 0x38, 0x98, 0x6c, 0x76, 0xe3, 0xc5, 0x44, 0x9b, 0xb6,
+	0x33, 0xdb, 0x9d, 0x84, 0x76, 0xba, 0x3c, 0x03, 0x8a, 0x67, 0x3f, 0x80, 0x1f, 0x6b, 0x8f, 0x7b,
+	0xf4, 0x60, 0x1a, 0x03, 0x37, 0x3e, 0x85, 0x61, 0xda, 0xb2, 0xa3, 0x85, 0xec, 0x09, 0xe8, 0xfc,
+	0xfe, 0xbf, 0x7f, 0x9f, 0x76, 0x18, 0xb4, 0x3f, 0xf4, 0x23, 0xfb, 0xab, 0x07, 0x91, 0x3d, 0x39,
+	0xb2, 0x43, 0x12, 0x13, 0xa0, 0x60, 0x25, 0x23, 0xc6, 0x99, 0x5a, 0x1f, 0xfa, 0x91, 0xb5, 0x5c,
+	0xb2, 0x26, 0x47, 0xfa, 0xbd, 0x90, 0x85, 0x4c, 0x5c, 0xb7, 0x97, 0xdf, 0x32, 0x44, 0x3f, 0x08,
+	0x18, 0x44, 0x22, 0x5d, 0x28, 0xf8, 0x34, 0x21, 0xb9, 0x40, 0x37, 0x4a, 0xab, 0xff, 0x14, 0xe8,
+	0xcd, 0xa1, 0xbf, 0x36, 0xf8, 0xf0, 0xf7, 0x2e, 0xba, 0xfd, 0x3e, 0x43, 0x4f, 0xb9, 0xc7, 0x89,
+	0xfa, 0x0a, 0xed, 0x24, 0xde, 0xc8, 0x8b, 0x40, 0x53, 0xda, 0x4a, 0xb7, 0xde, 0xd3, 0xac, 0x42,
+	0x5d, 0xdc, 0xa0, 0x75, 0x2c, 0xd6, 0x9d, 0xad, 0xcb, 0xd4, 0xac, 0x9c, 0xe4, 0xb4, 0xfa, 0x16,
+	0x6d, 0x07, 0x0c, 0x13, 0xd0, 0x6e, 0xb5, 0xab, 0xdd, 0x7a, 0xef, 0x41, 0x39, 0xf6, 0x86, 0x61,
+	0xe2, 0x34, 0x97, 0xa1, 0x45, 0x6a, 0x36, 0x04, 0xfc, 0x9c, 0x45, 0x94, 0x93, 0x28, 0xe1, 0xd3,
+	0x93, 0x2c, 0xad, 0x7e, 0x44, 0xb5, 0x80, 0xc5, 0x7c, 0xe4, 0x05, 0x1c, 0xb4, 0xaa, 0x50, 0xe9,
+	0xeb, 0x54, 0x19, 0xe2, 0xb4, 0x72, 0xdd, 0xdd, 0x55, 0x48, 0x52, 0x5e, 0x9b, 0x96, 0x5a, 0x20,
+	0x17, 0x63, 0x12, 0x07, 0x04, 0xb4, 0xad, 0x4d, 0xda, 0xd3, 0x1c, 0xb9, 0xd6, 0xae, 0x42, 0xb2,
+	0x76, 0x75, 0x51, 0xfd, 0x8c, 0xf6, 0x42, 0x12, 0xbb, 0x11, 0x84, 0xa0, 0x6d, 0x0b, 0xeb, 0xe3,
+	0xb2, 0x55, 0x7e, 0xbc, 0xcb, 0x1f, 0x1f, 0x20, 0x04, 0x47, 0xcf, 0x1b, 0xd4, 0x22, 0x2f, 0x15,
+	0xec, 0x86, 0x19, 0xa4, 0x86, 0xa8, 0x45, 0x63, 0x2f, 0xe0, 0x74, 0x42, 0xdc, 0x62, 0x16, 0xd7,
+	0xc3, 0x78, 0x44, 0x00, 0x08, 0x68, 0x3b, 0xed, 0x6a, 0xb7, 0xe6, 0x3c, 0x59, 0xa4, 0xe6, 0xa3,
+	0x8d, 0x98, 0xa4, 0xdd, 0x2f, 0xa0, 0xe2, 0xe9, 0xbd, 0x2e, 0x4c, 0xea, 0x17, 0x74, 0x47, 0x32,
+	0x60, 0xe2, 0x52, 0x0c, 0xda, 0x6e, 0xbb, 0xda, 0xdd, 0x72, 0x7a, 0xb3, 0xd4, 0x6c, 0x0c, 0x56,
+	0x49, 0x4c, 0x06, 0x7d, 0x58, 0xa4, 0x66, 0xab, 0xc4, 0x4b, 0x4d, 0x0d, 0x2a, 0xf3, 0x18, 0xd4,
+	0xef, 0xa8, 0x73, 0x31, 0x26, 0xa3, 0xa9, 0x8b, 0x29, 0x78, 0xfe, 0x90, 0xe0, 0x75, 0xe3, 0xec,
+	0x89, 0x71, 0xec, 0x45, 0x6a, 0x3e, 0xbb, 0x11, 0x96, 0xca, 0x0c, 0x01, 0xf7, 0x73, 0xb6, 0x3c,
+	0x1b, 0x47, 0xa6, 0x87, 0x23, 0x1a, 0xbb, 0x89, 0x37, 0x86, 0xf5, 0xcd, 0x35, 0xd1, 0xfc, 0x62,
+	0x91, 0x9a, 0x87, 0x37, 0xa0, 0x52, 0xef, 0x81, 0x40, 0x8f, 0x05, 0x59, 0x6e, 0xfd, 0xa1, 0xa0,
+	0x66, 0xf9, 0xa5, 0xd0, 0xf8, 0x8c, 0x81, 0x86, 0xc4, 0x4e, 0xe9, 0x58, 0xd2, 0x9f, 0xde, 0x1a,
+	0xfc, 0xf7, 0x6e, 0x06, 0xf1, 0x19, 0x73, 0x0e, 0xf3, 0x4d, 0xd2, 0xd9, 0x60, 0x92, 0xee, 0xe6,
+	0x3e, 0x5d, 0x23, 0x00, 0xa7, 0x7f, 0x39, 0x33, 0x94, 0xab, 0x99, 0xa1, 0xfc, 0x99, 0x19, 0xca,
+	0xcf, 0xb9, 0x51, 0xb9, 0x9a, 0x1b, 0x95, 0x5f, 0x73, 0xa3, 0xf2, 0xe9, 0x69, 0x48, 0xf9, 0xf9,
+	0xd8, 0xb7, 0x02, 0x16, 0xd9, 0xef, 0x68, 0x0c, 0xc1, 0x39, 0xf5, 0xc4, 0x09, 0x81, 0xed, 0x6f,
+	0xe2, 0x33, 0x19, 0x8e, 0x21, 0x3b, 0x2a, 0xfc, 0x1d, 0x71, 0x56, 0xbc, 0xfc, 0x3b, 0x00, 0xfd,
+	0x46, 0xa9, 0x34, 0xc2, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -176,6 +224,56 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InactiveContractInfos) > 0 {
+		for iNdEx := len(m.InactiveContractInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InactiveContractInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AdminPausedContractAddresses) > 0 {
+		for iNdEx := len(m.AdminPausedContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdminPausedContractAddresses[iNdEx])
+			copy(dAtA[i:], m.AdminPausedContractAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AdminPausedContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.QueryDisabledContractAddresses) > 0 {
+		for iNdEx := len(m.QueryDisabledContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryDisabledContractAddresses[iNdEx])
+			copy(dAtA[i:], m.QueryDisabledContractAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.QueryDisabledContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.InactiveCodeIDs) > 0 {
+		dAtA2 := make([]byte, len(m.InactiveCodeIDs)*10)
+		var j1 int
+		for _, num := range m.InactiveCodeIDs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.InactiveContractAddresses) > 0 {
 		for iNdEx := len(m.InactiveContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.InactiveContractAddresses[iNdEx])
@@ -304,6 +402,31 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.InactiveCodeIDs) > 0 {
+		l = 0
+		for _, e := range m.InactiveCodeIDs {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.QueryDisabledContractAddresses) > 0 {
+		for _, s := range m.QueryDisabledContractAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdminPausedContractAddresses) > 0 {
+		for _, s := range m.AdminPausedContractAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InactiveContractInfos) > 0 {
+		for _, e := range m.InactiveContractInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -545,6 +668,180 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.InactiveContractAddresses = append(m.InactiveContractAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InactiveCodeIDs = append(m.InactiveCodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InactiveCodeIDs) == 0 {
+					m.InactiveCodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InactiveCodeIDs = append(m.InactiveCodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveCodeIDs", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryDisabledContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryDisabledContractAddresses = append(m.QueryDisabledContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminPausedContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminPausedContractAddresses = append(m.AdminPausedContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveContractInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InactiveContractInfos = append(m.InactiveContractInfos, InactiveContractInfo{})
+			if err := m.InactiveContractInfos[len(m.InactiveContractInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 
 	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
@@ -17,7 +20,17 @@ const (
 	StoreKey = wasmtypes.StoreKey
 )
 
-var InactiveContractPrefix = []byte{0x90}
+var (
+	InactiveContractPrefix = []byte{0x90}
+	InactiveCodePrefix     = []byte{0x91}
+
+	QueryDisabledContractPrefix = []byte{0x92}
+	AdminPausedContractPrefix   = []byte{0x93}
+
+	InactiveContractInfoPrefix      = []byte{0x94}
+	ContractExpiryHeightQueuePrefix = []byte{0x95}
+	ContractExpiryTimeQueuePrefix   = []byte{0x96}
+)
 
 func GetInactiveContractKey(contractAddress sdk.AccAddress) []byte {
 	key := make([]byte, len(InactiveContractPrefix)+len(contractAddress))
@@ -25,3 +38,52 @@ func GetInactiveContractKey(contractAddress sdk.AccAddress) []byte {
 	copy(key[len(InactiveContractPrefix):], contractAddress)
 	return key
 }
+
+func GetInactiveCodeKey(codeID uint64) []byte {
+	key := make([]byte, len(InactiveCodePrefix)+8)
+	copy(key, InactiveCodePrefix)
+	binary.BigEndian.PutUint64(key[len(InactiveCodePrefix):], codeID)
+	return key
+}
+
+func GetQueryDisabledContractKey(contractAddress sdk.AccAddress) []byte {
+	key := make([]byte, len(QueryDisabledContractPrefix)+len(contractAddress))
+	copy(key, QueryDisabledContractPrefix)
+	copy(key[len(QueryDisabledContractPrefix):], contractAddress)
+	return key
+}
+
+func GetAdminPausedContractKey(contractAddress sdk.AccAddress) []byte {
+	key := make([]byte, len(AdminPausedContractPrefix)+len(contractAddress))
+	copy(key, AdminPausedContractPrefix)
+	copy(key[len(AdminPausedContractPrefix):], contractAddress)
+	return key
+}
+
+func GetInactiveContractInfoKey(contractAddress sdk.AccAddress) []byte {
+	key := make([]byte, len(InactiveContractInfoPrefix)+len(contractAddress))
+	copy(key, InactiveContractInfoPrefix)
+	copy(key[len(InactiveContractInfoPrefix):], contractAddress)
+	return key
+}
+
+// GetContractExpiryHeightQueuePrefix returns the prefix of the contracts whose deactivation expires at the height
+func GetContractExpiryHeightQueuePrefix(height uint64) []byte {
+	key := make([]byte, len(ContractExpiryHeightQueuePrefix)+8)
+	copy(key, ContractExpiryHeightQueuePrefix)
+	binary.BigEndian.PutUint64(key[len(ContractExpiryHeightQueuePrefix):], height)
+	return key
+}
+
+func GetContractExpiryHeightQueueKey(height uint64, contractAddress sdk.AccAddress) []byte {
+	return append(GetContractExpiryHeightQueuePrefix(height), contractAddress...)
+}
+
+// GetContractExpiryTimeQueuePrefix returns the prefix of the contracts whose deactivation expires at the time
+func GetContractExpiryTimeQueuePrefix(expiry time.Time) []byte {
+	return append(append([]byte{}, ContractExpiryTimeQueuePrefix...), sdk.FormatTimeBytes(expiry)...)
+}
+
+func GetContractExpiryTimeQueueKey(expiry time.Time, contractAddress sdk.AccAddress) []byte {
+	return append(GetContractExpiryTimeQueuePrefix(expiry), contractAddress...)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -17,3 +18,66 @@ func TestGetInactiveContractKey(t *testing.T) {
 	}
 	assert.Equal(t, exp, got)
 }
+
+func TestGetInactiveCodeKey(t *testing.T) {
+	got := GetInactiveCodeKey(260)
+	exp := []byte{
+		0x91,                   // prefix
+		0, 0, 0, 0, 0, 0, 1, 4, // code id
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetQueryDisabledContractKey(t *testing.T) {
+	addr := bytes.Repeat([]byte{4}, 20)
+	got := GetQueryDisabledContractKey(addr)
+	exp := []byte{
+		0x92,                         // prefix
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // address 20 bytes
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetAdminPausedContractKey(t *testing.T) {
+	addr := bytes.Repeat([]byte{4}, 20)
+	got := GetAdminPausedContractKey(addr)
+	exp := []byte{
+		0x93,                         // prefix
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // address 20 bytes
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetInactiveContractInfoKey(t *testing.T) {
+	addr := bytes.Repeat([]byte{4}, 20)
+	got := GetInactiveContractInfoKey(addr)
+	exp := []byte{
+		0x94,                         // prefix
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // address 20 bytes
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetContractExpiryHeightQueueKey(t *testing.T) {
+	addr := bytes.Repeat([]byte{4}, 20)
+	got := GetContractExpiryHeightQueueKey(1, addr)
+	exp := []byte{
+		0x95,                   // prefix
+		0, 0, 0, 0, 0, 0, 0, 1, // height
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // address 20 bytes
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetContractExpiryTimeQueueKey(t *testing.T) {
+	addr := bytes.Repeat([]byte{4}, 20)
+	expiry := time.Date(2023, 1, 2, 3, 4, 5, 6, time.UTC)
+	got := GetContractExpiryTimeQueueKey(expiry, addr)
+	exp := append(append([]byte{0x96}, []byte("2023-01-02T03:04:05.000000006")...), addr...)
+	assert.Equal(t, exp, got)
+	assert.True(t, bytes.HasPrefix(got, GetContractExpiryTimeQueuePrefix(expiry)))
+}
@@ -13,16 +13,22 @@ import (
 const (
 	ProposalTypeDeactivateContract wasmtypes.ProposalType = "DeactivateContract"
 	ProposalTypeActivateContract   wasmtypes.ProposalType = "ActivateContract"
+	ProposalTypeDeactivateCode     wasmtypes.ProposalType = "DeactivateCode"
+	ProposalTypeActivateCode       wasmtypes.ProposalType = "ActivateCode"
 )
 
 var EnableAllProposals = append([]wasmtypes.ProposalType{
 	ProposalTypeDeactivateContract,
 	ProposalTypeActivateContract,
+	ProposalTypeDeactivateCode,
+	ProposalTypeActivateCode,
 }, wasmtypes.EnableAllProposals...)
 
 func init() {
 	govtypes.RegisterProposalType(string(ProposalTypeDeactivateContract))
 	govtypes.RegisterProposalType(string(ProposalTypeActivateContract))
+	govtypes.RegisterProposalType(string(ProposalTypeDeactivateCode))
+	govtypes.RegisterProposalType(string(ProposalTypeActivateCode))
 }
 
 func (p DeactivateContractProposal) GetTitle() string { return p.Title }
@@ -39,16 +45,29 @@ func (p DeactivateContractProposal) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contract")
 	}
+	if p.ExpiryHeight != 0 && p.ExpiryTime != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expiry height and expiry time can not be set together")
+	}
+	if p.ExpiryTime != nil && p.ExpiryTime.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expiry time")
+	}
 
 	return nil
 }
 
 func (p DeactivateContractProposal) String() string {
+	expiryTime := ""
+	if p.ExpiryTime != nil {
+		expiryTime = p.ExpiryTime.String()
+	}
 	return fmt.Sprintf(`Deactivate Contract Proposal:
-  Title:       %s
-  Description: %s
-  Contract:    %s
-`, p.Title, p.Description, p.Contract)
+  Title:           %s
+  Description:     %s
+  Contract:        %s
+  Disable Queries: %t
+  Expiry Height:   %d
+  Expiry Time:     %s
+`, p.Title, p.Description, p.Contract, p.DisableQueries, p.ExpiryHeight, expiryTime)
 }
 
 func (p ActivateContractProposal) GetTitle() string { return p.Title }
@@ -76,3 +95,56 @@ func (p ActivateContractProposal) String() string {
   Contract:    %s
 `, p.Title, p.Description, p.Contract)
 }
+
+func (p DeactivateCodeProposal) GetTitle() string { return p.Title }
+
+func (p DeactivateCodeProposal) GetDescription() string { return p.Description }
+
+func (p DeactivateCodeProposal) ProposalRoute() string { return wasmtypes.RouterKey }
+
+func (p DeactivateCodeProposal) ProposalType() string {
+	return string(ProposalTypeDeactivateCode)
+}
+
+func (p DeactivateCodeProposal) ValidateBasic() error {
+	if p.CodeID == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "code id")
+	}
+
+	return nil
+}
+
+func (p DeactivateCodeProposal) String() string {
+	return fmt.Sprintf(`Deactivate Code Proposal:
+  Title:           %s
+  Description:     %s
+  Code id:         %d
+  Disable Queries: %t
+`, p.Title, p.Description, p.CodeID, p.DisableQueries)
+}
+
+func (p ActivateCodeProposal) GetTitle() string { return p.Title }
+
+func (p ActivateCodeProposal) GetDescription() string { return p.Description }
+
+func (p ActivateCodeProposal) ProposalRoute() string { return wasmtypes.RouterKey }
+
+func (p ActivateCodeProposal) ProposalType() string {
+	return string(ProposalTypeActivateCode)
+}
+
+func (p ActivateCodeProposal) ValidateBasic() error {
+	if p.CodeID == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "code id")
+	}
+
+	return nil
+}
+
+func (p ActivateCodeProposal) String() string {
+	return fmt.Sprintf(`Activate Code Proposal:
+  Title:       %s
+  Description: %s
+  Code id:     %d
+`, p.Title, p.Description, p.CodeID)
+}
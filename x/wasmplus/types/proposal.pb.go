@@ -8,9 +8,12 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -19,6 +22,7 @@ var _ = proto.Marshal
 var (
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -36,6 +40,14 @@ type DeactivateContractProposal struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
 	// Contract is the smart contract address to deactivate
 	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// DisableQueries rejects smart queries to the contract while it is inactive
+	DisableQueries bool `protobuf:"varint,4,opt,name=disable_queries,json=disableQueries,proto3" json:"disable_queries,omitempty" yaml:"disable_queries"`
+	// ExpiryHeight is an optional block height at which the contract is
+	// activated again automatically
+	ExpiryHeight uint64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// ExpiryTime is an optional block time at which the contract is activated
+	// again automatically
+	ExpiryTime *time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
 }
 
 func (m *DeactivateContractProposal) Reset()      { *m = DeactivateContractProposal{} }
@@ -123,33 +135,148 @@ func (m *ActivateContractProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ActivateContractProposal proto.InternalMessageInfo
 
+// DeactivateCodeProposal gov proposal content type adds a code to inactive
+// list. All contracts of the code are inactive and no new contract can be
+// instantiated from it.
+type DeactivateCodeProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// CodeID is the reference to the stored WASM code to deactivate
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	// DisableQueries rejects smart queries to the contracts of the code while
+	// it is inactive
+	DisableQueries bool `protobuf:"varint,4,opt,name=disable_queries,json=disableQueries,proto3" json:"disable_queries,omitempty" yaml:"disable_queries"`
+}
+
+func (m *DeactivateCodeProposal) Reset()      { *m = DeactivateCodeProposal{} }
+func (*DeactivateCodeProposal) ProtoMessage() {}
+func (*DeactivateCodeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b6af62537450c9, []int{2}
+}
+
+func (m *DeactivateCodeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DeactivateCodeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeactivateCodeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *DeactivateCodeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeactivateCodeProposal.Merge(m, src)
+}
+
+func (m *DeactivateCodeProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DeactivateCodeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeactivateCodeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeactivateCodeProposal proto.InternalMessageInfo
+
+// ActivateCodeProposal gov proposal content type deletes a code from inactive
+// list.
+type ActivateCodeProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// CodeID is the reference to the stored WASM code to activate
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+}
+
+func (m *ActivateCodeProposal) Reset()      { *m = ActivateCodeProposal{} }
+func (*ActivateCodeProposal) ProtoMessage() {}
+func (*ActivateCodeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b6af62537450c9, []int{3}
+}
+
+func (m *ActivateCodeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ActivateCodeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivateCodeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ActivateCodeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateCodeProposal.Merge(m, src)
+}
+
+func (m *ActivateCodeProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ActivateCodeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateCodeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivateCodeProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*DeactivateContractProposal)(nil), "lbm.wasm.v1.DeactivateContractProposal")
 	proto.RegisterType((*ActivateContractProposal)(nil), "lbm.wasm.v1.ActivateContractProposal")
+	proto.RegisterType((*DeactivateCodeProposal)(nil), "lbm.wasm.v1.DeactivateCodeProposal")
+	proto.RegisterType((*ActivateCodeProposal)(nil), "lbm.wasm.v1.ActivateCodeProposal")
 }
 
 func init() { proto.RegisterFile("lbm/wasm/v1/proposal.proto", fileDescriptor_38b6af62537450c9) }
 
 var fileDescriptor_38b6af62537450c9 = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x49, 0xca, 0xd5,
-	0x2f, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x28, 0xca, 0x2f, 0xc8, 0x2f, 0x4e, 0xcc,
-	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xce, 0x49, 0xca, 0xd5, 0x03, 0xc9, 0xe9, 0x95,
-	0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0xa5, 0xf5,
-	0x8c, 0x5c, 0x52, 0x2e, 0xa9, 0x89, 0xc9, 0x25, 0x99, 0x65, 0x89, 0x25, 0xa9, 0xce, 0xf9, 0x79,
-	0x25, 0x45, 0x89, 0xc9, 0x25, 0x01, 0x50, 0x73, 0x84, 0xd4, 0xb8, 0x58, 0x4b, 0x32, 0x4b, 0x72,
-	0x52, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x04, 0x3e, 0xdd, 0x93, 0xe7, 0xa9, 0x4c, 0xcc,
-	0xcd, 0xb1, 0x52, 0x02, 0x0b, 0x2b, 0x05, 0x41, 0xa4, 0x85, 0x2c, 0xb8, 0xb8, 0x53, 0x52, 0x8b,
-	0x93, 0x8b, 0x32, 0x0b, 0x4a, 0x32, 0xf3, 0xf3, 0x24, 0x98, 0xc0, 0xaa, 0xc5, 0x3e, 0xdd, 0x93,
-	0x17, 0x82, 0xa8, 0x46, 0x92, 0x54, 0x0a, 0x42, 0x56, 0x2a, 0xa4, 0xcf, 0xc5, 0x91, 0x0c, 0xb5,
-	0x55, 0x82, 0x19, 0xac, 0x4d, 0xf8, 0xd3, 0x3d, 0x79, 0x7e, 0x88, 0x36, 0x98, 0x8c, 0x52, 0x10,
-	0x5c, 0x91, 0xd2, 0x5a, 0x46, 0x2e, 0x09, 0xc7, 0xa1, 0xe3, 0x5e, 0xa7, 0x80, 0x13, 0x0f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x94, 0x63, 0x58, 0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0xdd, 0x32, 0xf3, 0x8a, 0x93, 0x33, 0x32, 0x13, 0xc1, 0xd1, 0x9a, 0xa2, 0x5f, 0x01, 0xa6, 0x0b,
-	0x72, 0x4a, 0x8b, 0xf5, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x51, 0x67, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x8f, 0x1a, 0x5b, 0xb5, 0xfb, 0x01, 0x00, 0x00,
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0xec, 0x76, 0xad, 0xb3, 0xb5, 0x95, 0xb8, 0x2c, 0x21, 0x48, 0x66, 0x99, 0x83,
+	0x2c, 0x1e, 0x32, 0x54, 0x11, 0x44, 0xf0, 0xd0, 0xb4, 0x88, 0xbd, 0xd5, 0x20, 0x08, 0x5e, 0x96,
+	0x49, 0x32, 0x66, 0x07, 0x92, 0x9d, 0x98, 0x99, 0x5d, 0xbb, 0xdf, 0xc1, 0x43, 0x3f, 0x86, 0x17,
+	0xf1, 0x6b, 0xf4, 0xd8, 0x63, 0x4f, 0xd1, 0x66, 0xbf, 0x41, 0x0e, 0x9e, 0x25, 0x33, 0x59, 0xdd,
+	0xde, 0x45, 0xe8, 0x29, 0xf3, 0xde, 0xff, 0xf7, 0xf2, 0x5e, 0xfe, 0x79, 0x0c, 0x74, 0xd2, 0x30,
+	0x23, 0x9f, 0xa9, 0xcc, 0xc8, 0xe2, 0x80, 0xe4, 0x85, 0xc8, 0x85, 0xa4, 0xa9, 0x97, 0x17, 0x42,
+	0x09, 0xab, 0x9f, 0x86, 0x99, 0xd7, 0x68, 0xde, 0xe2, 0xc0, 0x19, 0x24, 0x22, 0x11, 0x3a, 0x4f,
+	0x9a, 0x93, 0x41, 0x1c, 0x94, 0x08, 0x91, 0xa4, 0x8c, 0xe8, 0x28, 0x9c, 0x7f, 0x24, 0x8a, 0x67,
+	0x4c, 0x2a, 0x9a, 0xe5, 0x06, 0xc0, 0x5f, 0xb6, 0xa0, 0x73, 0xcc, 0x68, 0xa4, 0xf8, 0x82, 0x2a,
+	0x76, 0x24, 0x66, 0xaa, 0xa0, 0x91, 0x3a, 0x6d, 0x1b, 0x59, 0x8f, 0xe1, 0xb6, 0xe2, 0x2a, 0x65,
+	0x36, 0x18, 0x81, 0xf1, 0x3d, 0xff, 0x41, 0x5d, 0xa2, 0xdd, 0x25, 0xcd, 0xd2, 0x97, 0x58, 0xa7,
+	0x71, 0x60, 0x64, 0xeb, 0x05, 0xec, 0xc7, 0x4c, 0x46, 0x05, 0xcf, 0x15, 0x17, 0x33, 0xfb, 0x8e,
+	0xa6, 0x87, 0x75, 0x89, 0x2c, 0x43, 0x6f, 0x88, 0x38, 0xd8, 0x44, 0x2d, 0x02, 0x77, 0xa2, 0xb6,
+	0xab, 0xbd, 0xa5, 0xcb, 0x1e, 0xd6, 0x25, 0xda, 0x37, 0x65, 0x6b, 0x05, 0x07, 0x7f, 0x20, 0xeb,
+	0x08, 0xee, 0xc7, 0x5c, 0xd2, 0x30, 0x65, 0x93, 0x4f, 0x73, 0x56, 0x70, 0x26, 0xed, 0xee, 0x08,
+	0x8c, 0x77, 0x7c, 0xa7, 0x2e, 0xd1, 0xb0, 0x6d, 0x77, 0x13, 0xc0, 0xc1, 0x5e, 0x9b, 0x79, 0x6b,
+	0x12, 0xd6, 0x2b, 0x78, 0x9f, 0x9d, 0xe5, 0xbc, 0x58, 0x4e, 0xa6, 0x8c, 0x27, 0x53, 0x65, 0x6f,
+	0x8f, 0xc0, 0xb8, 0xeb, 0xdb, 0x75, 0x89, 0x06, 0xe6, 0x15, 0x37, 0x64, 0x1c, 0xec, 0x9a, 0xf8,
+	0x8d, 0x0e, 0xad, 0xf7, 0xb0, 0xdf, 0xea, 0x8d, 0x9f, 0x76, 0x6f, 0x04, 0xc6, 0xfd, 0xa7, 0x8e,
+	0x67, 0xcc, 0xf6, 0xd6, 0x66, 0x7b, 0xef, 0xd6, 0x66, 0xfb, 0xce, 0x5f, 0x2b, 0x36, 0x0a, 0xf1,
+	0xf9, 0x0f, 0x04, 0x02, 0x68, 0x32, 0x0d, 0x8c, 0xbf, 0x01, 0x68, 0x1f, 0xde, 0x9e, 0x9f, 0x81,
+	0x7f, 0x01, 0x38, 0xdc, 0x5c, 0x9f, 0x98, 0xfd, 0xc7, 0x69, 0x9f, 0xc3, 0xbb, 0x91, 0x88, 0xd9,
+	0x84, 0xc7, 0x7a, 0xd8, 0xae, 0xff, 0xa8, 0x2a, 0x51, 0xaf, 0x19, 0xe2, 0xe4, 0xb8, 0x2e, 0xd1,
+	0xde, 0x7a, 0x6c, 0x8d, 0xe0, 0xa0, 0xd7, 0x9c, 0x4e, 0xe2, 0x7f, 0xb2, 0x40, 0xf8, 0x3b, 0x80,
+	0x83, 0xc3, 0xdb, 0xf4, 0xd9, 0xfe, 0xe9, 0xc5, 0xb5, 0xdb, 0xb9, 0xba, 0x76, 0x3b, 0x5f, 0x2b,
+	0x17, 0x5c, 0x54, 0x2e, 0xb8, 0xac, 0x5c, 0xf0, 0xb3, 0x72, 0xc1, 0xf9, 0xca, 0xed, 0x5c, 0xae,
+	0xdc, 0xce, 0xd5, 0xca, 0xed, 0x7c, 0x78, 0x92, 0x70, 0x35, 0x9d, 0x87, 0x5e, 0x24, 0x32, 0xf2,
+	0x9a, 0xcf, 0x64, 0x34, 0xe5, 0x54, 0xdf, 0x3f, 0x31, 0x39, 0xd3, 0xcf, 0x3c, 0x9d, 0x4b, 0xa2,
+	0x96, 0x39, 0x93, 0x61, 0x4f, 0x2f, 0xfa, 0xb3, 0xdf, 0x03, 0x00, 0x9f, 0xdb, 0xbe, 0x75, 0xa4,
+	0x04, 0x00, 0x00,
 }
 
 func (this *DeactivateContractProposal) Equal(that interface{}) bool {
@@ -180,6 +307,19 @@ func (this *DeactivateContractProposal) Equal(that interface{}) bool {
 	if this.Contract != that1.Contract {
 		return false
 	}
+	if this.DisableQueries != that1.DisableQueries {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	if that1.ExpiryTime == nil {
+		if this.ExpiryTime != nil {
+			return false
+		}
+	} else if !this.ExpiryTime.Equal(*that1.ExpiryTime) {
+		return false
+	}
 	return true
 }
 
@@ -214,6 +354,71 @@ func (this *ActivateContractProposal) Equal(that interface{}) bool {
 	return true
 }
 
+func (this *DeactivateCodeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeactivateCodeProposal)
+	if !ok {
+		that2, ok := that.(DeactivateCodeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.DisableQueries != that1.DisableQueries {
+		return false
+	}
+	return true
+}
+
+func (this *ActivateCodeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActivateCodeProposal)
+	if !ok {
+		that2, ok := that.(ActivateCodeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	return true
+}
+
 func (m *DeactivateContractProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -234,6 +439,31 @@ func (m *DeactivateContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintProposal(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DisableQueries {
+		i--
+		if m.DisableQueries {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Contract) > 0 {
 		i -= len(m.Contract)
 		copy(dAtA[i:], m.Contract)
@@ -302,6 +532,100 @@ func (m *ActivateContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *DeactivateCodeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeactivateCodeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeactivateCodeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisableQueries {
+		i--
+		if m.DisableQueries {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivateCodeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateCodeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateCodeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -332,6 +656,16 @@ func (m *DeactivateContractProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProposal(uint64(l))
 	}
+	if m.DisableQueries {
+		n += 2
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovProposal(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovProposal(uint64(l))
+	}
 	return n
 }
 
@@ -356,6 +690,49 @@ func (m *ActivateContractProposal) Size() (n int) {
 	return n
 }
 
+func (m *DeactivateCodeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovProposal(uint64(m.CodeID))
+	}
+	if m.DisableQueries {
+		n += 2
+	}
+	return n
+}
+
+func (m *ActivateCodeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovProposal(uint64(m.CodeID))
+	}
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -489,11 +866,86 @@ func (m *DeactivateContractProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProposal(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableQueries", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableQueries = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProposal
@@ -658,6 +1110,294 @@ func (m *ActivateContractProposal) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *DeactivateCodeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateCodeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateCodeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableQueries", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableQueries = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ActivateCodeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateCodeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateCodeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
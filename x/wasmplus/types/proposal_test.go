@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +14,7 @@ import (
 
 func TestValidateDeactivateContractProposal(t *testing.T) {
 	var anyAddress sdk.AccAddress = bytes.Repeat([]byte{0x0}, wasmtypes.ContractAddrLen)
+	anyTime := time.Now().UTC()
 
 	specs := map[string]struct {
 		src    DeactivateContractProposal
@@ -25,6 +27,41 @@ func TestValidateDeactivateContractProposal(t *testing.T) {
 				Contract:    anyAddress.String(),
 			},
 		},
+		"with expiry height": {
+			src: DeactivateContractProposal{
+				Title:        "Foo",
+				Description:  "Bar",
+				Contract:     anyAddress.String(),
+				ExpiryHeight: 100,
+			},
+		},
+		"with expiry time": {
+			src: DeactivateContractProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    anyAddress.String(),
+				ExpiryTime:  &anyTime,
+			},
+		},
+		"zero expiry time": {
+			src: DeactivateContractProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    anyAddress.String(),
+				ExpiryTime:  &time.Time{},
+			},
+			expErr: true,
+		},
+		"both expiry height and time": {
+			src: DeactivateContractProposal{
+				Title:        "Foo",
+				Description:  "Bar",
+				Contract:     anyAddress.String(),
+				ExpiryHeight: 100,
+				ExpiryTime:   &anyTime,
+			},
+			expErr: true,
+		},
 		"invalid address": {
 			src: DeactivateContractProposal{
 				Title:       "Foo",
@@ -80,3 +117,67 @@ func TestValidateActivateContractProposal(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDeactivateCodeProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    DeactivateCodeProposal
+		expErr bool
+	}{
+		"all good": {
+			src: DeactivateCodeProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				CodeID:      1,
+			},
+		},
+		"empty code id": {
+			src: DeactivateCodeProposal{
+				Title:       "Foo",
+				Description: "Bar",
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateActivateCodeProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    ActivateCodeProposal
+		expErr bool
+	}{
+		"all good": {
+			src: ActivateCodeProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				CodeID:      1,
+			},
+		},
+		"empty code id": {
+			src: ActivateCodeProposal{
+				Title:       "Foo",
+				Description: "Bar",
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
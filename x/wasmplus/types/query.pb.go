@@ -86,6 +86,9 @@ type QueryInactiveContractsResponse struct {
 	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	// pagination defines the pagination in the response
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// contracts is the details of the deactivation of each address, in the same
+	// order as addresses
+	Contracts []InactiveContractInfo `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts"`
 }
 
 func (m *QueryInactiveContractsResponse) Reset()         { *m = QueryInactiveContractsResponse{} }
@@ -176,6 +179,15 @@ var xxx_messageInfo_QueryInactiveContractRequest proto.InternalMessageInfo
 type QueryInactiveContractResponse struct {
 	// inactivated is the result if the contract is inactive contract or not
 	Inactivated bool `protobuf:"varint,1,opt,name=inactivated,proto3" json:"inactivated,omitempty"`
+	// queries_disabled is true when smart queries to the inactive contract are
+	// rejected
+	QueriesDisabled bool `protobuf:"varint,2,opt,name=queries_disabled,json=queriesDisabled,proto3" json:"queries_disabled,omitempty"`
+	// paused_by_admin is true when the contract is paused by its admin instead
+	// of governance
+	PausedByAdmin bool `protobuf:"varint,3,opt,name=paused_by_admin,json=pausedByAdmin,proto3" json:"paused_by_admin,omitempty"`
+	// info is the details of the deactivation. It is empty when the contract is
+	// not deactivated itself.
+	Info *InactiveContractInfo `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
 }
 
 func (m *QueryInactiveContractResponse) Reset()         { *m = QueryInactiveContractResponse{} }
@@ -216,45 +228,151 @@ func (m *QueryInactiveContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryInactiveContractResponse proto.InternalMessageInfo
 
+// QueryInactiveCodesRequest is the request type for Query/InactiveCodes RPC
+// method.
+type QueryInactiveCodesRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInactiveCodesRequest) Reset()         { *m = QueryInactiveCodesRequest{} }
+func (m *QueryInactiveCodesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInactiveCodesRequest) ProtoMessage()    {}
+func (*QueryInactiveCodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{4}
+}
+
+func (m *QueryInactiveCodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryInactiveCodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInactiveCodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryInactiveCodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInactiveCodesRequest.Merge(m, src)
+}
+
+func (m *QueryInactiveCodesRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryInactiveCodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInactiveCodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInactiveCodesRequest proto.InternalMessageInfo
+
+// QueryInactiveCodesResponse is the response type for the Query/InactiveCodes
+// RPC method.
+type QueryInactiveCodesResponse struct {
+	// code_ids is the inactive code id list, in ascending order
+	CodeIDs []uint64 `protobuf:"varint,1,rep,packed,name=code_ids,json=codeIds,proto3" json:"code_ids,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInactiveCodesResponse) Reset()         { *m = QueryInactiveCodesResponse{} }
+func (m *QueryInactiveCodesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInactiveCodesResponse) ProtoMessage()    {}
+func (*QueryInactiveCodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{5}
+}
+
+func (m *QueryInactiveCodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryInactiveCodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInactiveCodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryInactiveCodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInactiveCodesResponse.Merge(m, src)
+}
+
+func (m *QueryInactiveCodesResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryInactiveCodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInactiveCodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInactiveCodesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryInactiveContractsRequest)(nil), "lbm.wasm.v1.QueryInactiveContractsRequest")
 	proto.RegisterType((*QueryInactiveContractsResponse)(nil), "lbm.wasm.v1.QueryInactiveContractsResponse")
 	proto.RegisterType((*QueryInactiveContractRequest)(nil), "lbm.wasm.v1.QueryInactiveContractRequest")
 	proto.RegisterType((*QueryInactiveContractResponse)(nil), "lbm.wasm.v1.QueryInactiveContractResponse")
+	proto.RegisterType((*QueryInactiveCodesRequest)(nil), "lbm.wasm.v1.QueryInactiveCodesRequest")
+	proto.RegisterType((*QueryInactiveCodesResponse)(nil), "lbm.wasm.v1.QueryInactiveCodesResponse")
 }
 
 func init() { proto.RegisterFile("lbm/wasm/v1/query.proto", fileDescriptor_f1bdb66850244231) }
 
 var fileDescriptor_f1bdb66850244231 = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0x6f, 0xd4, 0x30,
-	0x14, 0xc7, 0xe3, 0x43, 0xfc, 0x38, 0xdf, 0x02, 0x16, 0x12, 0xa7, 0xd3, 0x61, 0x8e, 0x1b, 0x68,
-	0x7b, 0x95, 0x6c, 0xa5, 0x2c, 0xac, 0x80, 0x54, 0x84, 0xc4, 0x00, 0x19, 0x59, 0x90, 0x93, 0x58,
-	0xae, 0xa5, 0xc4, 0x4e, 0x63, 0x27, 0x50, 0x21, 0x16, 0x16, 0x56, 0x24, 0xc4, 0xc6, 0x1f, 0xc0,
-	0x5f, 0xc1, 0xdc, 0xb1, 0x12, 0x0b, 0x23, 0xe4, 0xf8, 0x43, 0x50, 0xec, 0x5c, 0x9b, 0x02, 0xed,
-	0x75, 0x4a, 0xf2, 0xde, 0xf7, 0xbd, 0xef, 0xe7, 0xf9, 0xc5, 0xf0, 0x56, 0x16, 0xe7, 0xf4, 0x35,
-	0x33, 0x39, 0xad, 0x43, 0xba, 0x5f, 0xf1, 0xf2, 0x80, 0x14, 0xa5, 0xb6, 0x1a, 0x8d, 0xb2, 0x38,
-	0x27, 0x6d, 0x82, 0xd4, 0xe1, 0xe4, 0xa6, 0xd0, 0x42, 0xbb, 0x38, 0x6d, 0xdf, 0xbc, 0x64, 0x32,
-	0x15, 0x5a, 0x8b, 0x8c, 0x53, 0x56, 0x48, 0xca, 0x94, 0xd2, 0x96, 0x59, 0xa9, 0x95, 0xe9, 0xb2,
-	0x8b, 0x44, 0x9b, 0x5c, 0x1b, 0x1a, 0x33, 0xc3, 0x7d, 0x67, 0x5a, 0x87, 0x31, 0xb7, 0x2c, 0xa4,
-	0x05, 0x13, 0x52, 0x39, 0xb1, 0xd7, 0xce, 0x05, 0xbc, 0xfd, 0xa2, 0x55, 0x3c, 0x55, 0x2c, 0xb1,
-	0xb2, 0xe6, 0x8f, 0xb5, 0xb2, 0x25, 0x4b, 0xac, 0x89, 0xf8, 0x7e, 0xc5, 0x8d, 0x45, 0xbb, 0x10,
-	0x9e, 0x14, 0x8d, 0xc1, 0x0c, 0x6c, 0x8e, 0x76, 0xee, 0x11, 0xef, 0x40, 0x5a, 0x07, 0xe2, 0xd9,
-	0x3b, 0x07, 0xf2, 0x9c, 0x09, 0xde, 0xd5, 0x46, 0xbd, 0xca, 0xf9, 0x07, 0x00, 0xf1, 0x59, 0x4e,
-	0xa6, 0xd0, 0xca, 0x70, 0x34, 0x85, 0x43, 0x96, 0xa6, 0x25, 0x37, 0x86, 0x9b, 0x31, 0x98, 0x5d,
-	0xda, 0x1c, 0x46, 0x27, 0x01, 0xf4, 0xe4, 0x14, 0xc8, 0xc0, 0x81, 0x6c, 0xac, 0x05, 0xf1, 0xad,
-	0x4f, 0x91, 0x3c, 0x80, 0xd3, 0xff, 0x82, 0xac, 0x26, 0x1e, 0xc3, 0xab, 0x9d, 0xab, 0x1b, 0x77,
-	0x18, 0xad, 0x3e, 0xe7, 0x0f, 0xcf, 0x38, 0xac, 0xe3, 0x09, 0x66, 0x70, 0x24, 0x7d, 0x8e, 0x59,
-	0x9e, 0xba, 0xf2, 0x6b, 0x51, 0x3f, 0xb4, 0xf3, 0x6d, 0x00, 0x2f, 0xbb, 0x1e, 0xe8, 0x33, 0x80,
-	0x37, 0xfe, 0x39, 0x0b, 0xb4, 0x20, 0xbd, 0xed, 0x93, 0x73, 0x57, 0x33, 0xd9, 0xbe, 0x90, 0xd6,
-	0xa3, 0xcd, 0x37, 0xde, 0x7f, 0xff, 0xfd, 0x69, 0x70, 0x17, 0xdd, 0xa1, 0xfd, 0xff, 0xae, 0x43,
-	0xe3, 0xaf, 0x92, 0x63, 0x82, 0x2f, 0x00, 0x5e, 0xff, 0xbb, 0x0d, 0xda, 0x5a, 0x6f, 0xb5, 0xa2,
-	0x5a, 0x5c, 0x44, 0xda, 0x41, 0x85, 0x0e, 0x6a, 0x1b, 0x6d, 0xad, 0x81, 0xa2, 0x6f, 0xbb, 0x15,
-	0xbc, 0x7b, 0xf4, 0xec, 0xf0, 0x17, 0x0e, 0xbe, 0x36, 0x38, 0x38, 0x6c, 0x30, 0x38, 0x6a, 0x30,
-	0xf8, 0xd9, 0x60, 0xf0, 0x71, 0x89, 0x83, 0xa3, 0x25, 0x0e, 0x7e, 0x2c, 0x71, 0xf0, 0x72, 0x21,
-	0xa4, 0xdd, 0xab, 0x62, 0x92, 0xe8, 0x9c, 0xee, 0x4a, 0x65, 0x92, 0x3d, 0xc9, 0x5c, 0xef, 0x94,
-	0xbe, 0x71, 0xcf, 0x22, 0xab, 0x0c, 0xb5, 0x07, 0x05, 0x37, 0xf1, 0x15, 0x77, 0x0b, 0xee, 0xff,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0x28, 0x2a, 0x64, 0x76, 0x8d, 0x03, 0x00, 0x00,
+	// 628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xc7, 0x77, 0x9a, 0xfc, 0x7e, 0x6d, 0x26, 0x94, 0xd6, 0x41, 0x70, 0x8d, 0xed, 0x36, 0x5d,
+	0x21, 0x6d, 0x53, 0xd8, 0x21, 0x15, 0xc1, 0xab, 0xb1, 0x56, 0x02, 0x1e, 0x74, 0x8f, 0x5e, 0xc2,
+	0xec, 0xce, 0x74, 0x3b, 0x90, 0xdd, 0xd9, 0x66, 0x36, 0xd1, 0x20, 0x5e, 0x04, 0x8f, 0x82, 0x20,
+	0xde, 0x7c, 0x01, 0xbe, 0x12, 0x29, 0x08, 0x52, 0xf0, 0xe2, 0xa9, 0x68, 0xea, 0x0b, 0x91, 0x9d,
+	0x9d, 0x34, 0x7f, 0x6c, 0x9a, 0x1c, 0x7a, 0x4a, 0xf6, 0x99, 0xef, 0xf3, 0x3c, 0x9f, 0xe7, 0xbb,
+	0xcf, 0x2c, 0xbc, 0xd5, 0xf2, 0x42, 0xfc, 0x92, 0xc8, 0x10, 0x77, 0x6b, 0xf8, 0xb8, 0xc3, 0xda,
+	0x3d, 0x27, 0x6e, 0x8b, 0x44, 0xa0, 0x62, 0xcb, 0x0b, 0x9d, 0xf4, 0xc0, 0xe9, 0xd6, 0x4a, 0x37,
+	0x03, 0x11, 0x08, 0x15, 0xc7, 0xe9, 0xbf, 0x4c, 0x52, 0x5a, 0x0b, 0x84, 0x08, 0x5a, 0x0c, 0x93,
+	0x98, 0x63, 0x12, 0x45, 0x22, 0x21, 0x09, 0x17, 0x91, 0xd4, 0xa7, 0x55, 0x5f, 0xc8, 0x50, 0x48,
+	0xec, 0x11, 0xc9, 0xb2, 0xca, 0xb8, 0x5b, 0xf3, 0x58, 0x42, 0x6a, 0x38, 0x26, 0x01, 0x8f, 0x94,
+	0x58, 0x6b, 0xc7, 0x28, 0x92, 0x5e, 0xcc, 0x74, 0x11, 0x3b, 0x80, 0xeb, 0xcf, 0xd3, 0xd4, 0x46,
+	0x44, 0xfc, 0x84, 0x77, 0xd9, 0x23, 0x11, 0x25, 0x6d, 0xe2, 0x27, 0xd2, 0x65, 0xc7, 0x1d, 0x26,
+	0x13, 0x74, 0x00, 0xe1, 0xb0, 0x9a, 0x09, 0xca, 0x60, 0xbb, 0xb8, 0x57, 0x71, 0xb2, 0xd6, 0x4e,
+	0xda, 0xda, 0xc9, 0x86, 0xd2, 0xad, 0x9d, 0x67, 0x24, 0x60, 0x3a, 0xd7, 0x1d, 0xc9, 0xb4, 0xbf,
+	0x03, 0x68, 0x4d, 0xeb, 0x24, 0x63, 0x11, 0x49, 0x86, 0xd6, 0x60, 0x81, 0x50, 0xda, 0x66, 0x52,
+	0x32, 0x69, 0x82, 0x72, 0x6e, 0xbb, 0xe0, 0x0e, 0x03, 0xe8, 0xc9, 0x18, 0xc8, 0x82, 0x02, 0xd9,
+	0x9a, 0x09, 0x92, 0x95, 0x1e, 0x25, 0x41, 0x8f, 0x61, 0xc1, 0x1f, 0xf4, 0x36, 0x73, 0xe5, 0xdc,
+	0x76, 0x71, 0x6f, 0xd3, 0x19, 0x79, 0x19, 0xce, 0x24, 0x61, 0x23, 0x3a, 0x14, 0xf5, 0xfc, 0xc9,
+	0xd9, 0x86, 0xe1, 0x0e, 0x33, 0xed, 0x07, 0x70, 0xed, 0xd2, 0x79, 0x06, 0xc6, 0x99, 0x70, 0x51,
+	0xc3, 0x2b, 0xd7, 0x0a, 0xee, 0xe0, 0xd1, 0xfe, 0x06, 0xa6, 0x98, 0x7e, 0xe1, 0x44, 0x19, 0x16,
+	0x79, 0x76, 0x46, 0x12, 0x46, 0x55, 0xfe, 0x92, 0x3b, 0x1a, 0x42, 0x3b, 0x70, 0x35, 0x1d, 0x97,
+	0x33, 0xd9, 0xa4, 0x5c, 0x12, 0xaf, 0xc5, 0xa8, 0xf2, 0x64, 0xc9, 0x5d, 0xd1, 0xf1, 0x7d, 0x1d,
+	0x46, 0x15, 0xb8, 0x12, 0x93, 0x8e, 0x64, 0xb4, 0xe9, 0xf5, 0x9a, 0x84, 0x86, 0x3c, 0x32, 0x73,
+	0x4a, 0xb9, 0x9c, 0x85, 0xeb, 0xbd, 0x87, 0x69, 0x10, 0xdd, 0x87, 0x79, 0x1e, 0x1d, 0x0a, 0x33,
+	0x5f, 0x06, 0x73, 0x59, 0xe2, 0x2a, 0xb9, 0xed, 0xc3, 0xdb, 0x13, 0xc3, 0x50, 0x76, 0xed, 0xdb,
+	0xf3, 0x1e, 0xc0, 0xd2, 0x65, 0x5d, 0xb4, 0x5f, 0x15, 0xb8, 0xe4, 0x0b, 0xca, 0x9a, 0x9c, 0x66,
+	0x8b, 0x93, 0xaf, 0x17, 0xfb, 0x67, 0x1b, 0x8b, 0xa9, 0xa8, 0xb1, 0x2f, 0xdd, 0xc5, 0xf4, 0xb0,
+	0x41, 0xaf, 0x6f, 0x87, 0xf6, 0xbe, 0xe6, 0xe0, 0x7f, 0x8a, 0x07, 0x7d, 0x02, 0xf0, 0xc6, 0x3f,
+	0x2b, 0x8d, 0xaa, 0x63, 0xee, 0x5d, 0x79, 0xc3, 0x4a, 0xbb, 0x73, 0x69, 0x33, 0x08, 0x7b, 0xeb,
+	0xed, 0x8f, 0x3f, 0x1f, 0x17, 0x36, 0xd1, 0x06, 0x1e, 0xbd, 0xd1, 0x7a, 0x33, 0x58, 0xf3, 0x62,
+	0x3d, 0xd1, 0x67, 0x00, 0x57, 0x27, 0xcb, 0xa0, 0x9d, 0xd9, 0xad, 0x06, 0x54, 0xd5, 0x79, 0xa4,
+	0x1a, 0xaa, 0xa6, 0xa0, 0x76, 0xd1, 0xce, 0x0c, 0x28, 0xfc, 0x5a, 0x5f, 0x81, 0x37, 0xe8, 0x1d,
+	0x80, 0xcb, 0x63, 0xef, 0x12, 0x55, 0xae, 0x6a, 0x38, 0x5c, 0xa9, 0xd2, 0xd6, 0x4c, 0x9d, 0xa6,
+	0xba, 0xab, 0xa8, 0xd6, 0xd1, 0x9d, 0x69, 0x54, 0x94, 0xc9, 0xfa, 0xd3, 0x93, 0xdf, 0x96, 0xf1,
+	0xa5, 0x6f, 0x19, 0x27, 0x7d, 0x0b, 0x9c, 0xf6, 0x2d, 0xf0, 0xab, 0x6f, 0x81, 0x0f, 0xe7, 0x96,
+	0x71, 0x7a, 0x6e, 0x19, 0x3f, 0xcf, 0x2d, 0xe3, 0x45, 0x35, 0xe0, 0xc9, 0x51, 0xc7, 0x73, 0x7c,
+	0x11, 0xe2, 0x03, 0x1e, 0x49, 0xff, 0x88, 0x13, 0x55, 0x8d, 0xe2, 0x57, 0xea, 0x37, 0x6e, 0x75,
+	0x64, 0xf6, 0x4d, 0xf5, 0xfe, 0x57, 0x1f, 0xd5, 0x7b, 0x7f, 0x07, 0x00, 0x9e, 0x0d, 0xb2, 0xad,
+	0xf5, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +391,8 @@ type QueryClient interface {
 	InactiveContracts(ctx context.Context, in *QueryInactiveContractsRequest, opts ...grpc.CallOption) (*QueryInactiveContractsResponse, error)
 	// InactiveContract check it the contract is inactive state or not
 	InactiveContract(ctx context.Context, in *QueryInactiveContractRequest, opts ...grpc.CallOption) (*QueryInactiveContractResponse, error)
+	// InactiveCodes queries all inactive codes
+	InactiveCodes(ctx context.Context, in *QueryInactiveCodesRequest, opts ...grpc.CallOption) (*QueryInactiveCodesResponse, error)
 }
 
 type queryClient struct {
@@ -301,12 +421,23 @@ func (c *queryClient) InactiveContract(ctx context.Context, in *QueryInactiveCon
 	return out, nil
 }
 
+func (c *queryClient) InactiveCodes(ctx context.Context, in *QueryInactiveCodesRequest, opts ...grpc.CallOption) (*QueryInactiveCodesResponse, error) {
+	out := new(QueryInactiveCodesResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/InactiveCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// InactiveContracts queries all inactive contracts
 	InactiveContracts(context.Context, *QueryInactiveContractsRequest) (*QueryInactiveContractsResponse, error)
 	// InactiveContract check it the contract is inactive state or not
 	InactiveContract(context.Context, *QueryInactiveContractRequest) (*QueryInactiveContractResponse, error)
+	// InactiveCodes queries all inactive codes
+	InactiveCodes(context.Context, *QueryInactiveCodesRequest) (*QueryInactiveCodesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -320,6 +451,10 @@ func (*UnimplementedQueryServer) InactiveContract(ctx context.Context, req *Quer
 	return nil, status.Errorf(codes.Unimplemented, "method InactiveContract not implemented")
 }
 
+func (*UnimplementedQueryServer) InactiveCodes(ctx context.Context, req *QueryInactiveCodesRequest) (*QueryInactiveCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactiveCodes not implemented")
+}
+
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
@@ -360,6 +495,24 @@ func _Query_InactiveContract_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InactiveCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInactiveCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InactiveCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Query/InactiveCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InactiveCodes(ctx, req.(*QueryInactiveCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.wasm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -372,6 +525,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InactiveContract",
 			Handler:    _Query_InactiveContract_Handler,
 		},
+		{
+			MethodName: "InactiveCodes",
+			Handler:    _Query_InactiveCodes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/wasm/v1/query.proto",
@@ -432,6 +589,20 @@ func (m *QueryInactiveContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -506,6 +677,38 @@ func (m *QueryInactiveContractResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PausedByAdmin {
+		i--
+		if m.PausedByAdmin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QueriesDisabled {
+		i--
+		if m.QueriesDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Inactivated {
 		i--
 		if m.Inactivated {
@@ -519,6 +722,94 @@ func (m *QueryInactiveContractResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInactiveCodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveCodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveCodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInactiveCodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveCodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveCodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeIDs) > 0 {
+		dAtA7 := make([]byte, len(m.CodeIDs)*10)
+		var j6 int
+		for _, num := range m.CodeIDs {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -560,6 +851,12 @@ func (m *QueryInactiveContractsResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -585,6 +882,49 @@ func (m *QueryInactiveContractResponse) Size() (n int) {
 	if m.Inactivated {
 		n += 2
 	}
+	if m.QueriesDisabled {
+		n += 2
+	}
+	if m.PausedByAdmin {
+		n += 2
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInactiveCodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInactiveCodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeIDs) > 0 {
+		l = 0
+		for _, e := range m.CodeIDs {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -780,6 +1120,40 @@ func (m *QueryInactiveContractsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, InactiveContractInfo{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -934,6 +1308,332 @@ func (m *QueryInactiveContractResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Inactivated = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueriesDisabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedByAdmin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PausedByAdmin = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &InactiveContractInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryInactiveCodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveCodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveCodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryInactiveCodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveCodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveCodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CodeIDs) == 0 {
+					m.CodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CodeIDs = append(m.CodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIDs", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+
+	wasmtypes "github.com/Finschia/wasmd/x/wasm/types"
 )
 
 func (msg MsgStoreCodeAndInstantiateContract) Route() string {
@@ -28,6 +30,10 @@ func (msg MsgStoreCodeAndInstantiateContract) ValidateBasic() error {
 		}
 	}
 
+	if err := wasmtypes.ValidateVerificationInfo(msg.Source, msg.Builder, msg.CodeHash); err != nil {
+		return sdkerrors.Wrap(err, "code verification info")
+	}
+
 	if err := validateLabel(msg.Label); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "label is required")
 	}
@@ -56,3 +62,99 @@ func (msg MsgStoreCodeAndInstantiateContract) GetSigners() []sdk.AccAddress {
 	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
 	return []sdk.AccAddress{senderAddr}
 }
+
+func (msg MsgStoreCodeAndMigrateContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgStoreCodeAndMigrateContract) Type() string {
+	return "store-code-and-migrate"
+}
+
+func (msg MsgStoreCodeAndMigrateContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
+	if err := validateWasmCode(msg.WASMByteCode); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
+	}
+
+	if msg.InstantiatePermission != nil {
+		if err := msg.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := msg.Msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "payload msg")
+	}
+	return nil
+}
+
+func (msg MsgStoreCodeAndMigrateContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStoreCodeAndMigrateContract) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgPauseContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgPauseContract) Type() string {
+	return "pause-contract"
+}
+
+func (msg MsgPauseContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgPauseContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPauseContract) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUnpauseContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnpauseContract) Type() string {
+	return "unpause-contract"
+}
+
+func (msg MsgUnpauseContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgUnpauseContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnpauseContract) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
@@ -53,6 +53,14 @@ type MsgStoreCodeAndInstantiateContract struct {
 	Msg github_com_Finschia_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,8,opt,name=msg,proto3,casttype=github.com/Finschia/wasmd/x/wasm/types.RawContractMessage" json:"msg,omitempty"`
 	// Funds coins that are transferred to the contract on instantiation
 	Funds github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,9,rep,name=funds,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"funds"`
+	// Source is the URL where the code is hosted, optional
+	Source string `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
+	// Builder is the docker image used to build the code deterministically,
+	// used for smart contract verification, optional
+	Builder string `protobuf:"bytes,11,opt,name=builder,proto3" json:"builder,omitempty"`
+	// CodeHash is the SHA256 sum of the code outputted by the builder, used for
+	// smart contract verification, optional
+	CodeHash []byte `protobuf:"bytes,12,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
 }
 
 func (m *MsgStoreCodeAndInstantiateContract) Reset()         { *m = MsgStoreCodeAndInstantiateContract{} }
@@ -147,49 +155,349 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStoreCodeAndInstantiateContractResponse proto.InternalMessageInfo
 
+// MsgStoreCodeAndMigrateContract submit Wasm code to the system and
+// migrate a contract to it.
+type MsgStoreCodeAndMigrateContract struct {
+	// Sender is the that actor that signed the messages
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// WASMByteCode can be raw or gzip compressed
+	WASMByteCode []byte `protobuf:"bytes,2,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
+	// InstantiatePermission access control to apply on contract creation,
+	// optional
+	InstantiatePermission *types.AccessConfig `protobuf:"bytes,3,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Msg json encoded message to be passed to the contract on migration
+	Msg github_com_Finschia_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,5,opt,name=msg,proto3,casttype=github.com/Finschia/wasmd/x/wasm/types.RawContractMessage" json:"msg,omitempty"`
+}
+
+func (m *MsgStoreCodeAndMigrateContract) Reset()         { *m = MsgStoreCodeAndMigrateContract{} }
+func (m *MsgStoreCodeAndMigrateContract) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreCodeAndMigrateContract) ProtoMessage()    {}
+func (*MsgStoreCodeAndMigrateContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{2}
+}
+
+func (m *MsgStoreCodeAndMigrateContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgStoreCodeAndMigrateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreCodeAndMigrateContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgStoreCodeAndMigrateContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreCodeAndMigrateContract.Merge(m, src)
+}
+
+func (m *MsgStoreCodeAndMigrateContract) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgStoreCodeAndMigrateContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreCodeAndMigrateContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStoreCodeAndMigrateContract proto.InternalMessageInfo
+
+// MsgStoreCodeAndMigrateContractResponse returns store and migrate result
+// data.
+type MsgStoreCodeAndMigrateContractResponse struct {
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Checksum is the sha256 hash of the stored code
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// Data contains same raw bytes returned as data from the wasm contract.
+	// (May be empty)
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) Reset() {
+	*m = MsgStoreCodeAndMigrateContractResponse{}
+}
+func (m *MsgStoreCodeAndMigrateContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreCodeAndMigrateContractResponse) ProtoMessage()    {}
+func (*MsgStoreCodeAndMigrateContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{3}
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreCodeAndMigrateContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreCodeAndMigrateContractResponse.Merge(m, src)
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreCodeAndMigrateContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStoreCodeAndMigrateContractResponse proto.InternalMessageInfo
+
+// MsgPauseContract deactivates a contract in an emergency. Only the admin of
+// the contract can send it.
+type MsgPauseContract struct {
+	// Sender is the admin of the contract
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *MsgPauseContract) Reset()         { *m = MsgPauseContract{} }
+func (m *MsgPauseContract) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseContract) ProtoMessage()    {}
+func (*MsgPauseContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{4}
+}
+
+func (m *MsgPauseContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgPauseContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgPauseContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseContract.Merge(m, src)
+}
+
+func (m *MsgPauseContract) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgPauseContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseContract proto.InternalMessageInfo
+
+// MsgPauseContractResponse returns empty data
+type MsgPauseContractResponse struct{}
+
+func (m *MsgPauseContractResponse) Reset()         { *m = MsgPauseContractResponse{} }
+func (m *MsgPauseContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseContractResponse) ProtoMessage()    {}
+func (*MsgPauseContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{5}
+}
+
+func (m *MsgPauseContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgPauseContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgPauseContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseContractResponse.Merge(m, src)
+}
+
+func (m *MsgPauseContractResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgPauseContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseContractResponse proto.InternalMessageInfo
+
+// MsgUnpauseContract activates a contract paused by MsgPauseContract. Only the
+// admin of the contract can send it.
+type MsgUnpauseContract struct {
+	// Sender is the admin of the contract
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *MsgUnpauseContract) Reset()         { *m = MsgUnpauseContract{} }
+func (m *MsgUnpauseContract) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseContract) ProtoMessage()    {}
+func (*MsgUnpauseContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{6}
+}
+
+func (m *MsgUnpauseContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgUnpauseContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgUnpauseContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseContract.Merge(m, src)
+}
+
+func (m *MsgUnpauseContract) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgUnpauseContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseContract proto.InternalMessageInfo
+
+// MsgUnpauseContractResponse returns empty data
+type MsgUnpauseContractResponse struct{}
+
+func (m *MsgUnpauseContractResponse) Reset()         { *m = MsgUnpauseContractResponse{} }
+func (m *MsgUnpauseContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseContractResponse) ProtoMessage()    {}
+func (*MsgUnpauseContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{7}
+}
+
+func (m *MsgUnpauseContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgUnpauseContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgUnpauseContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseContractResponse.Merge(m, src)
+}
+
+func (m *MsgUnpauseContractResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgUnpauseContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseContractResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStoreCodeAndInstantiateContract)(nil), "lbm.wasm.v1.MsgStoreCodeAndInstantiateContract")
 	proto.RegisterType((*MsgStoreCodeAndInstantiateContractResponse)(nil), "lbm.wasm.v1.MsgStoreCodeAndInstantiateContractResponse")
+	proto.RegisterType((*MsgStoreCodeAndMigrateContract)(nil), "lbm.wasm.v1.MsgStoreCodeAndMigrateContract")
+	proto.RegisterType((*MsgStoreCodeAndMigrateContractResponse)(nil), "lbm.wasm.v1.MsgStoreCodeAndMigrateContractResponse")
+	proto.RegisterType((*MsgPauseContract)(nil), "lbm.wasm.v1.MsgPauseContract")
+	proto.RegisterType((*MsgPauseContractResponse)(nil), "lbm.wasm.v1.MsgPauseContractResponse")
+	proto.RegisterType((*MsgUnpauseContract)(nil), "lbm.wasm.v1.MsgUnpauseContract")
+	proto.RegisterType((*MsgUnpauseContractResponse)(nil), "lbm.wasm.v1.MsgUnpauseContractResponse")
 }
 
 func init() { proto.RegisterFile("lbm/wasm/v1/tx.proto", fileDescriptor_751e1d2b9f9bf9e8) }
 
 var fileDescriptor_751e1d2b9f9bf9e8 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6a, 0x13, 0x41,
-	0x18, 0xcf, 0xba, 0x9b, 0xb4, 0x9d, 0x16, 0x29, 0x43, 0x2d, 0x6b, 0x91, 0xdd, 0x50, 0x2f, 0xa1,
-	0xe8, 0x2c, 0x89, 0xa2, 0x78, 0xf0, 0x90, 0x44, 0xc4, 0x14, 0x82, 0xb2, 0x45, 0x04, 0x2f, 0x61,
-	0x76, 0x67, 0xb2, 0x1d, 0xcc, 0xce, 0x84, 0xfd, 0x26, 0x69, 0x73, 0xf2, 0x01, 0xbc, 0x08, 0xbe,
-	0x85, 0xaf, 0xe0, 0x0b, 0xe4, 0xd8, 0xa3, 0xa7, 0xa8, 0xc9, 0x5b, 0x78, 0x92, 0xd9, 0xcd, 0xd6,
-	0x1e, 0x84, 0xe0, 0xed, 0xfb, 0x33, 0xbf, 0xdf, 0xf7, 0xcd, 0xef, 0x37, 0x83, 0x0e, 0x46, 0x51,
-	0x1a, 0x5c, 0x50, 0x48, 0x83, 0x69, 0x33, 0xd0, 0x97, 0x64, 0x9c, 0x29, 0xad, 0xf0, 0xee, 0x28,
-	0x4a, 0x89, 0xa9, 0x92, 0x69, 0xf3, 0xe8, 0x20, 0x51, 0x89, 0xca, 0xeb, 0x81, 0x89, 0x8a, 0x23,
-	0x47, 0x5e, 0xac, 0x20, 0x55, 0x10, 0x44, 0x14, 0x78, 0x30, 0x6d, 0x46, 0x5c, 0xd3, 0x66, 0x10,
-	0x2b, 0x21, 0xd7, 0xfd, 0x7b, 0xa6, 0x9f, 0x13, 0x5f, 0xb3, 0xcf, 0xc6, 0x1c, 0x8a, 0xee, 0xf1,
-	0x37, 0x1b, 0x1d, 0xf7, 0x21, 0x39, 0xd3, 0x2a, 0xe3, 0x5d, 0xc5, 0x78, 0x5b, 0xb2, 0x9e, 0x04,
-	0x4d, 0xa5, 0x16, 0x54, 0xf3, 0xae, 0x92, 0x3a, 0xa3, 0xb1, 0xc6, 0x87, 0xa8, 0x06, 0x5c, 0x32,
-	0x9e, 0xb9, 0x56, 0xdd, 0x6a, 0xec, 0x84, 0xeb, 0x0c, 0x3f, 0x41, 0xb7, 0x0d, 0xeb, 0x20, 0x9a,
-	0x69, 0x3e, 0x88, 0x15, 0xe3, 0xee, 0xad, 0xba, 0xd5, 0xd8, 0xeb, 0xec, 0x2f, 0x17, 0xfe, 0xde,
-	0xbb, 0xf6, 0x59, 0xbf, 0x33, 0xd3, 0x39, 0x6f, 0xb8, 0x67, 0xce, 0x95, 0x19, 0x7e, 0x8b, 0x0e,
-	0xc5, 0xdf, 0x31, 0x83, 0x31, 0xcf, 0x52, 0x01, 0x20, 0x94, 0x74, 0xab, 0x75, 0xab, 0xb1, 0xdb,
-	0xf2, 0x48, 0xb9, 0x75, 0x79, 0x7b, 0xd2, 0x8e, 0x63, 0x0e, 0xd0, 0x55, 0x72, 0x28, 0x92, 0xf0,
-	0xce, 0x0d, 0xf4, 0x9b, 0x6b, 0x30, 0x3e, 0x40, 0x55, 0xca, 0x52, 0x21, 0xdd, 0x5a, 0xbe, 0x65,
-	0x91, 0x98, 0xea, 0x88, 0x46, 0x7c, 0xe4, 0x6e, 0x15, 0xd5, 0x3c, 0xc1, 0xaf, 0x91, 0x9d, 0x42,
-	0xe2, 0x6e, 0xe7, 0xfb, 0x3e, 0xff, 0xbd, 0xf0, 0x9f, 0x25, 0x42, 0x9f, 0x4f, 0x22, 0x12, 0xab,
-	0x34, 0x78, 0x29, 0x24, 0xc4, 0xe7, 0x82, 0xe6, 0x9a, 0xb1, 0xe0, 0xb2, 0xd0, 0xae, 0x10, 0x2e,
-	0xa4, 0x17, 0xa5, 0x2e, 0x7d, 0x0e, 0x40, 0x13, 0x1e, 0x1a, 0x26, 0xcc, 0x51, 0x75, 0x38, 0x91,
-	0x0c, 0xdc, 0x9d, 0xba, 0xdd, 0xd8, 0x6d, 0xdd, 0x25, 0x85, 0x31, 0xc4, 0x18, 0x43, 0xd6, 0xc6,
-	0x90, 0xae, 0x12, 0xb2, 0xf3, 0x78, 0xbe, 0xf0, 0x2b, 0x5f, 0x7f, 0xf8, 0x0f, 0xfe, 0x35, 0x71,
-	0xb8, 0x0e, 0x1e, 0x02, 0xfb, 0xb0, 0x9e, 0x68, 0x40, 0x10, 0x16, 0xec, 0xa7, 0xce, 0xb6, 0xbd,
-	0xef, 0x9c, 0x3a, 0xdb, 0xce, 0x7e, 0xf5, 0xf8, 0x23, 0x3a, 0xd9, 0x6c, 0x5e, 0xc8, 0x61, 0xac,
-	0x24, 0x70, 0x7c, 0x1f, 0x6d, 0x19, 0x8b, 0x06, 0x82, 0xe5, 0x2e, 0x3a, 0x1d, 0xb4, 0x5c, 0xf8,
-	0x35, 0x03, 0xec, 0xbd, 0x08, 0x6b, 0xa6, 0xd5, 0x63, 0xd8, 0x45, 0x5b, 0x94, 0xb1, 0x8c, 0x03,
-	0xe4, 0x56, 0xee, 0x84, 0x65, 0x8a, 0x31, 0x72, 0x18, 0xd5, 0xd4, 0xb5, 0x8d, 0x62, 0x61, 0x1e,
-	0xb7, 0xbe, 0x58, 0xc8, 0xee, 0x43, 0x82, 0x3f, 0x59, 0xc8, 0xdf, 0xf4, 0x86, 0x02, 0x72, 0xe3,
-	0x31, 0x93, 0xcd, 0x7b, 0x1f, 0x3d, 0xfd, 0x4f, 0x40, 0x79, 0xd1, 0xce, 0xab, 0xf9, 0x2f, 0xaf,
-	0x32, 0x5f, 0x7a, 0xd6, 0xd5, 0xd2, 0xb3, 0x7e, 0x2e, 0x3d, 0xeb, 0xf3, 0xca, 0xab, 0x5c, 0xad,
-	0xbc, 0xca, 0xf7, 0x95, 0x57, 0x79, 0x7f, 0xb2, 0xc9, 0xe7, 0xf1, 0x68, 0x02, 0x85, 0xf2, 0x51,
-	0x2d, 0xff, 0x25, 0x8f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xe4, 0x91, 0xcd, 0x9e, 0x03,
+	// 738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x1b, 0x27, 0x4d, 0x26, 0xf9, 0x3e, 0x22, 0xab, 0x54, 0xc6, 0x2d, 0x4e, 0x14, 0x04,
+	0x44, 0x05, 0x6c, 0xa5, 0x45, 0x20, 0x16, 0x2c, 0x9a, 0xa0, 0xaa, 0xad, 0x14, 0x51, 0xb9, 0xaa,
+	0x90, 0x60, 0x11, 0x8d, 0xed, 0xa9, 0x63, 0x35, 0xf6, 0x44, 0xbe, 0x4e, 0x7f, 0x16, 0xa8, 0x7b,
+	0xd8, 0xf0, 0x1c, 0xac, 0x79, 0x88, 0x2c, 0xbb, 0x64, 0x15, 0x20, 0x7d, 0x0b, 0x56, 0x68, 0xc6,
+	0x71, 0x68, 0x4d, 0x95, 0xa8, 0x2a, 0x12, 0xbb, 0xb9, 0x7f, 0xe7, 0x1e, 0xdf, 0x73, 0x67, 0x8c,
+	0x16, 0xba, 0xa6, 0xa7, 0x1f, 0x61, 0xf0, 0xf4, 0xc3, 0xba, 0x1e, 0x1e, 0x6b, 0xbd, 0x80, 0x86,
+	0x54, 0x2a, 0x74, 0x4d, 0x4f, 0x63, 0x5e, 0xed, 0xb0, 0xae, 0x2c, 0x38, 0xd4, 0xa1, 0xdc, 0xaf,
+	0xb3, 0x53, 0x94, 0xa2, 0xa8, 0x16, 0x05, 0x8f, 0x82, 0x6e, 0x62, 0x20, 0xfa, 0x61, 0xdd, 0x24,
+	0x21, 0xae, 0xeb, 0x16, 0x75, 0xfd, 0x71, 0x7c, 0x99, 0xc5, 0x39, 0xf0, 0x04, 0xfd, 0xa4, 0x47,
+	0x20, 0x8a, 0x56, 0x3f, 0x88, 0xa8, 0xda, 0x02, 0x67, 0x37, 0xa4, 0x01, 0x69, 0x52, 0x9b, 0xac,
+	0xfb, 0xf6, 0x96, 0x0f, 0x21, 0xf6, 0x43, 0x17, 0x87, 0xa4, 0x49, 0xfd, 0x30, 0xc0, 0x56, 0x28,
+	0x2d, 0xa2, 0x2c, 0x10, 0xdf, 0x26, 0x81, 0x2c, 0x54, 0x84, 0x5a, 0xde, 0x18, 0x5b, 0xd2, 0x33,
+	0xf4, 0x3f, 0x43, 0x6d, 0x9b, 0x27, 0x21, 0x69, 0x5b, 0xd4, 0x26, 0xf2, 0x5c, 0x45, 0xa8, 0x15,
+	0x1b, 0xa5, 0xd1, 0xb0, 0x5c, 0x7c, 0xb3, 0xbe, 0xdb, 0x6a, 0x9c, 0x84, 0x1c, 0xd7, 0x28, 0xb2,
+	0xbc, 0xd8, 0x92, 0xf6, 0xd0, 0xa2, 0xfb, 0xbb, 0x4d, 0xbb, 0x47, 0x02, 0xcf, 0x05, 0x70, 0xa9,
+	0x2f, 0x67, 0x2a, 0x42, 0xad, 0xb0, 0xaa, 0x6a, 0x31, 0xeb, 0xf8, 0xeb, 0xb5, 0x75, 0xcb, 0x22,
+	0x00, 0x4d, 0xea, 0xef, 0xbb, 0x8e, 0x71, 0xfb, 0x42, 0xf5, 0xce, 0xa4, 0x58, 0x5a, 0x40, 0x19,
+	0x6c, 0x7b, 0xae, 0x2f, 0x67, 0x39, 0xcb, 0xc8, 0x60, 0xde, 0x2e, 0x36, 0x49, 0x57, 0x9e, 0x8f,
+	0xbc, 0xdc, 0x90, 0x5e, 0xa3, 0xb4, 0x07, 0x8e, 0x9c, 0xe3, 0x7c, 0x5f, 0xfe, 0x1c, 0x96, 0x5f,
+	0x38, 0x6e, 0xd8, 0xe9, 0x9b, 0x9a, 0x45, 0x3d, 0x7d, 0xc3, 0xf5, 0xc1, 0xea, 0xb8, 0x98, 0xcf,
+	0xcc, 0xd6, 0x8f, 0xa3, 0xd9, 0x45, 0x83, 0x33, 0xf0, 0x51, 0x3c, 0x97, 0x16, 0x01, 0xc0, 0x0e,
+	0x31, 0x18, 0x92, 0x44, 0x50, 0x66, 0xbf, 0xef, 0xdb, 0x20, 0xe7, 0x2b, 0xe9, 0x5a, 0x61, 0xf5,
+	0x8e, 0x16, 0x09, 0xa3, 0x31, 0x61, 0xb4, 0xb1, 0x30, 0x5a, 0x93, 0xba, 0x7e, 0xe3, 0xe9, 0x60,
+	0x58, 0x4e, 0x7d, 0xfe, 0x56, 0x7e, 0x7c, 0x55, 0xc7, 0xfd, 0xf1, 0xe1, 0x09, 0xd8, 0x07, 0xe3,
+	0x8e, 0xac, 0x08, 0x8c, 0x08, 0x9d, 0x4b, 0x41, 0xfb, 0x81, 0x45, 0x64, 0x34, 0x96, 0x82, 0x5b,
+	0x92, 0x8c, 0xe6, 0xcd, 0xbe, 0xdb, 0x65, 0x1a, 0x15, 0x78, 0x20, 0x36, 0xa5, 0x25, 0x94, 0x67,
+	0xd2, 0xb4, 0x3b, 0x18, 0x3a, 0x72, 0x91, 0x7d, 0xaf, 0x91, 0x63, 0x8e, 0x4d, 0x0c, 0x9d, 0x6d,
+	0x31, 0x97, 0x2e, 0x89, 0xdb, 0x62, 0x4e, 0x2c, 0x65, 0xaa, 0xa7, 0x68, 0x65, 0xf6, 0x2e, 0x18,
+	0x04, 0x7a, 0xd4, 0x07, 0x22, 0xdd, 0x43, 0xf3, 0x1c, 0xd6, 0xb5, 0xf9, 0x52, 0x88, 0x0d, 0x34,
+	0x1a, 0x96, 0xb3, 0xac, 0x70, 0xeb, 0x95, 0x91, 0x65, 0xa1, 0x2d, 0x9b, 0xb1, 0xc2, 0xb6, 0x1d,
+	0x10, 0x00, 0xbe, 0x19, 0x79, 0x23, 0x36, 0x25, 0x09, 0x89, 0x36, 0x0e, 0xb1, 0x9c, 0xe6, 0x84,
+	0xf8, 0xb9, 0xfa, 0x65, 0x0e, 0xa9, 0x09, 0x06, 0x2d, 0xd7, 0x09, 0xfe, 0xcd, 0x26, 0xa6, 0x6f,
+	0xb2, 0x89, 0x0a, 0xca, 0x59, 0x63, 0xca, 0xb2, 0xc8, 0x89, 0x4e, 0xec, 0x78, 0xf3, 0x32, 0x7f,
+	0x6b, 0xf3, 0xaa, 0xef, 0xd1, 0x83, 0xe9, 0x53, 0xbb, 0x9e, 0x66, 0x8c, 0x7b, 0x87, 0x58, 0x07,
+	0xd0, 0xf7, 0xa2, 0x21, 0x1a, 0x13, 0xfb, 0x4a, 0xd5, 0x36, 0x50, 0xa9, 0x05, 0xce, 0x0e, 0xee,
+	0xc3, 0x6c, 0x99, 0x2e, 0xce, 0x65, 0xee, 0xf2, 0x5c, 0xaa, 0x0a, 0x92, 0x93, 0x38, 0x31, 0xf1,
+	0xea, 0x26, 0x92, 0x5a, 0xe0, 0xec, 0xf9, 0xbd, 0x1b, 0x77, 0x59, 0x46, 0xca, 0x9f, 0x48, 0x71,
+	0x9f, 0xd5, 0x41, 0x1a, 0xa5, 0x5b, 0xe0, 0x48, 0x1f, 0x05, 0x54, 0x9e, 0xf5, 0x28, 0xea, 0xda,
+	0x85, 0xd7, 0x59, 0x9b, 0x7d, 0x73, 0x94, 0xe7, 0xd7, 0x2c, 0x98, 0xc8, 0x76, 0x8a, 0x96, 0xa6,
+	0xdd, 0x89, 0x47, 0xd3, 0x70, 0x13, 0xc9, 0xca, 0xda, 0x35, 0x92, 0x27, 0x04, 0xf6, 0xd0, 0x7f,
+	0x97, 0xf5, 0xbd, 0x9b, 0x44, 0xb9, 0x14, 0x56, 0xee, 0x4f, 0x0d, 0x4f, 0x60, 0xdf, 0xa1, 0x5b,
+	0x49, 0x49, 0xcb, 0xc9, 0xca, 0x44, 0x82, 0xf2, 0x70, 0x46, 0x42, 0x0c, 0xde, 0xd8, 0x1c, 0xfc,
+	0x50, 0x53, 0x83, 0x91, 0x2a, 0x9c, 0x8d, 0x54, 0xe1, 0xfb, 0x48, 0x15, 0x3e, 0x9d, 0xab, 0xa9,
+	0xb3, 0x73, 0x35, 0xf5, 0xf5, 0x5c, 0x4d, 0xbd, 0x5d, 0x99, 0x75, 0xe7, 0x7a, 0xdd, 0x3e, 0x44,
+	0xf7, 0xce, 0xcc, 0xf2, 0x7f, 0xe5, 0xda, 0xaf, 0x01, 0x00, 0x4c, 0xb7, 0x67, 0x31, 0xa4, 0x07,
 	0x00, 0x00,
 }
 
@@ -208,6 +516,12 @@ type MsgClient interface {
 	// StoreCodeAndInstantiateContract upload code and instantiate a contract
 	// using it
 	StoreCodeAndInstantiateContract(ctx context.Context, in *MsgStoreCodeAndInstantiateContract, opts ...grpc.CallOption) (*MsgStoreCodeAndInstantiateContractResponse, error)
+	// StoreCodeAndMigrateContract upload code and migrate a contract to it
+	StoreCodeAndMigrateContract(ctx context.Context, in *MsgStoreCodeAndMigrateContract, opts ...grpc.CallOption) (*MsgStoreCodeAndMigrateContractResponse, error)
+	// PauseContract deactivates a contract by its admin
+	PauseContract(ctx context.Context, in *MsgPauseContract, opts ...grpc.CallOption) (*MsgPauseContractResponse, error)
+	// UnpauseContract activates a contract paused by its admin
+	UnpauseContract(ctx context.Context, in *MsgUnpauseContract, opts ...grpc.CallOption) (*MsgUnpauseContractResponse, error)
 }
 
 type msgClient struct {
@@ -227,11 +541,44 @@ func (c *msgClient) StoreCodeAndInstantiateContract(ctx context.Context, in *Msg
 	return out, nil
 }
 
+func (c *msgClient) StoreCodeAndMigrateContract(ctx context.Context, in *MsgStoreCodeAndMigrateContract, opts ...grpc.CallOption) (*MsgStoreCodeAndMigrateContractResponse, error) {
+	out := new(MsgStoreCodeAndMigrateContractResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Msg/StoreCodeAndMigrateContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseContract(ctx context.Context, in *MsgPauseContract, opts ...grpc.CallOption) (*MsgPauseContractResponse, error) {
+	out := new(MsgPauseContractResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Msg/PauseContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseContract(ctx context.Context, in *MsgUnpauseContract, opts ...grpc.CallOption) (*MsgUnpauseContractResponse, error) {
+	out := new(MsgUnpauseContractResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Msg/UnpauseContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// StoreCodeAndInstantiateContract upload code and instantiate a contract
 	// using it
 	StoreCodeAndInstantiateContract(context.Context, *MsgStoreCodeAndInstantiateContract) (*MsgStoreCodeAndInstantiateContractResponse, error)
+	// StoreCodeAndMigrateContract upload code and migrate a contract to it
+	StoreCodeAndMigrateContract(context.Context, *MsgStoreCodeAndMigrateContract) (*MsgStoreCodeAndMigrateContractResponse, error)
+	// PauseContract deactivates a contract by its admin
+	PauseContract(context.Context, *MsgPauseContract) (*MsgPauseContractResponse, error)
+	// UnpauseContract activates a contract paused by its admin
+	UnpauseContract(context.Context, *MsgUnpauseContract) (*MsgUnpauseContractResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -241,6 +588,18 @@ func (*UnimplementedMsgServer) StoreCodeAndInstantiateContract(ctx context.Conte
 	return nil, status.Errorf(codes.Unimplemented, "method StoreCodeAndInstantiateContract not implemented")
 }
 
+func (*UnimplementedMsgServer) StoreCodeAndMigrateContract(ctx context.Context, req *MsgStoreCodeAndMigrateContract) (*MsgStoreCodeAndMigrateContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreCodeAndMigrateContract not implemented")
+}
+
+func (*UnimplementedMsgServer) PauseContract(ctx context.Context, req *MsgPauseContract) (*MsgPauseContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseContract not implemented")
+}
+
+func (*UnimplementedMsgServer) UnpauseContract(ctx context.Context, req *MsgUnpauseContract) (*MsgUnpauseContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseContract not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
@@ -263,6 +622,60 @@ func _Msg_StoreCodeAndInstantiateContract_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StoreCodeAndMigrateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreCodeAndMigrateContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreCodeAndMigrateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Msg/StoreCodeAndMigrateContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreCodeAndMigrateContract(ctx, req.(*MsgStoreCodeAndMigrateContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Msg/PauseContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseContract(ctx, req.(*MsgPauseContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Msg/UnpauseContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseContract(ctx, req.(*MsgUnpauseContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.wasm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -271,6 +684,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StoreCodeAndInstantiateContract",
 			Handler:    _Msg_StoreCodeAndInstantiateContract_Handler,
 		},
+		{
+			MethodName: "StoreCodeAndMigrateContract",
+			Handler:    _Msg_StoreCodeAndMigrateContract_Handler,
+		},
+		{
+			MethodName: "PauseContract",
+			Handler:    _Msg_PauseContract_Handler,
+		},
+		{
+			MethodName: "UnpauseContract",
+			Handler:    _Msg_UnpauseContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/wasm/v1/tx.proto",
@@ -296,6 +721,27 @@ func (m *MsgStoreCodeAndInstantiateContract) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Builder) > 0 {
+		i -= len(m.Builder)
+		copy(dAtA[i:], m.Builder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Builder)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.Funds) > 0 {
 		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -402,21 +848,246 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgStoreCodeAndMigrateContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *MsgStoreCodeAndInstantiateContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStoreCodeAndMigrateContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreCodeAndMigrateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InstantiatePermission != nil {
+		{
+			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WASMByteCode) > 0 {
+		i -= len(m.WASMByteCode)
+		copy(dAtA[i:], m.WASMByteCode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WASMByteCode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *MsgStoreCodeAndInstantiateContract) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -450,38 +1121,670 @@ func (m *MsgStoreCodeAndInstantiateContract) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreCodeAndInstantiateContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreCodeAndMigrateContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &types.AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types1.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgStoreCodeAndInstantiateContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTx(uint64(m.CodeID))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgStoreCodeAndMigrateContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -504,10 +1807,10 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStoreCodeAndMigrateContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStoreCodeAndMigrateContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -576,7 +1879,7 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				m.WASMByteCode = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
 			}
@@ -611,12 +1914,148 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgStoreCodeAndMigrateContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodeAndMigrateContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodeAndMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -626,29 +2065,31 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -658,29 +2099,82 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgPauseContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -690,31 +2184,29 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -724,25 +2216,23 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funds = append(m.Funds, types1.Coin{})
-			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -766,7 +2256,7 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -789,34 +2279,66 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.CodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 2:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgUnpauseContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -844,13 +2366,13 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -860,25 +2382,23 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -902,6 +2422,57 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) erro
 	return nil
 }
 
+func (m *MsgUnpauseContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"testing"
@@ -75,9 +76,23 @@ func TestStoreCodeAndInstantiateContractValidation(t *testing.T) {
 				Label:        "foo",
 				Msg:          []byte(`{"some": "data"}`),
 				Funds:        sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+				Source:       "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom",
+				Builder:      "cosmwasm/rust-optimizer:0.12.6",
+				CodeHash:     bytes.Repeat([]byte{0x1}, 32),
 			},
 			valid: true,
 		},
+		"incomplete verification info": {
+			msg: MsgStoreCodeAndInstantiateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				Msg:          []byte(`{"some": "data"}`),
+				Source:       "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom",
+				Builder:      "cosmwasm/rust-optimizer:0.12.6",
+			},
+			valid: false,
+		},
 		"invalid InstantiatePermission": {
 			msg: MsgStoreCodeAndInstantiateContract{
 				Sender:                goodAddress,
@@ -131,6 +146,163 @@ func TestStoreCodeAndInstantiateContractValidation(t *testing.T) {
 	}
 }
 
+func TestStoreCodeAndMigrateContractValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, wasmTypes.ContractAddrLen)).String()
+	sdk.GetConfig().SetAddressVerifier(wasmTypes.VerifyAddressLen())
+
+	cases := map[string]struct {
+		msg   MsgStoreCodeAndMigrateContract
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgStoreCodeAndMigrateContract{},
+			valid: false,
+		},
+		"correct minimal": {
+			msg: MsgStoreCodeAndMigrateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Contract:     goodAddress,
+				Msg:          []byte("{}"),
+			},
+			valid: true,
+		},
+		"correct maximal": {
+			msg: MsgStoreCodeAndMigrateContract{
+				Sender:                goodAddress,
+				WASMByteCode:          []byte("foo"),
+				InstantiatePermission: &wasmTypes.AllowEverybody,
+				Contract:              goodAddress,
+				Msg:                   []byte(`{"some": "data"}`),
+			},
+			valid: true,
+		},
+		"missing code": {
+			msg: MsgStoreCodeAndMigrateContract{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+				Msg:      []byte("{}"),
+			},
+			valid: false,
+		},
+		"invalid InstantiatePermission": {
+			msg: MsgStoreCodeAndMigrateContract{
+				Sender:                goodAddress,
+				WASMByteCode:          []byte("foo"),
+				InstantiatePermission: &wasmTypes.AccessConfig{Permission: wasmTypes.AccessTypeOnlyAddress, Address: badAddress},
+				Contract:              goodAddress,
+				Msg:                   []byte("{}"),
+			},
+			valid: false,
+		},
+		"missing contract": {
+			msg: MsgStoreCodeAndMigrateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Msg:          []byte("{}"),
+			},
+			valid: false,
+		},
+		"bad contract": {
+			msg: MsgStoreCodeAndMigrateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Contract:     badAddress,
+				Msg:          []byte("{}"),
+			},
+			valid: false,
+		},
+		"missing migrate message": {
+			msg: MsgStoreCodeAndMigrateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Contract:     goodAddress,
+			},
+			valid: false,
+		},
+		"non json migrate msg": {
+			msg: MsgStoreCodeAndMigrateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Contract:     goodAddress,
+				Msg:          []byte("invalid-json"),
+			},
+			valid: false,
+		},
+		"bad sender minimal": {
+			msg: MsgStoreCodeAndMigrateContract{
+				Sender:       badAddress,
+				WASMByteCode: []byte("foo"),
+				Contract:     goodAddress,
+				Msg:          []byte("{}"),
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPauseContractValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, wasmTypes.ContractAddrLen)).String()
+	sdk.GetConfig().SetAddressVerifier(wasmTypes.VerifyAddressLen())
+
+	cases := map[string]struct {
+		sender   string
+		contract string
+		valid    bool
+	}{
+		"empty": {
+			valid: false,
+		},
+		"correct": {
+			sender:   goodAddress,
+			contract: goodAddress,
+			valid:    true,
+		},
+		"bad sender": {
+			sender:   badAddress,
+			contract: goodAddress,
+			valid:    false,
+		},
+		"bad contract": {
+			sender:   goodAddress,
+			contract: badAddress,
+			valid:    false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pause := MsgPauseContract{Sender: tc.sender, Contract: tc.contract}
+			unpause := MsgUnpauseContract{Sender: tc.sender, Contract: tc.contract}
+			if tc.valid {
+				assert.NoError(t, pause.ValidateBasic())
+				assert.NoError(t, unpause.ValidateBasic())
+			} else {
+				assert.Error(t, pause.ValidateBasic())
+				assert.Error(t, unpause.ValidateBasic())
+			}
+		})
+	}
+}
+
 func TestNewMsgStoreCodeAndInstantiateContractGetSigners(t *testing.T) {
 	res := NewMsgStoreCodeAndInstantiateContract(sdk.AccAddress([]byte("input111111111111111"))).GetSigners()
 	bytes := sdk.MustAccAddressFromBech32(res[0].String())
@@ -162,6 +334,35 @@ func TestMsgJsonSignBytes(t *testing.T) {
 {
 	"type":"wasm/MsgStoreCodeAndInstantiateContract",
 	"value": {"funds":[]}
+}`,
+		},
+		"MsgStoreCodeAndMigrateContract with every field": {
+			src: &MsgStoreCodeAndMigrateContract{
+				Sender: "sender1", WASMByteCode: []byte{89, 69, 76, 76, 79, 87, 32, 83, 85, 66, 77, 65, 82, 73, 78, 69},
+				InstantiatePermission: &wasmTypes.AccessConfig{Permission: wasmTypes.AccessTypeAnyOfAddresses, Addresses: []string{"address1", "address2"}},
+				Contract:              "contract1", Msg: wasmTypes.RawContractMessage(myInnerMsg),
+			},
+			exp: `
+{
+	"type":"wasm/MsgStoreCodeAndMigrateContract",
+	"value": {"contract":"contract1","instantiate_permission":{"addresses":["address1","address2"],
+		"permission":"AnyOfAddresses"},"msg":{"foo":"bar"},"sender":"sender1","wasm_byte_code":"WUVMTE9XIFNVQk1BUklORQ=="}
+}`,
+		},
+		"MsgPauseContract": {
+			src: &MsgPauseContract{Sender: "sender1", Contract: "contract1"},
+			exp: `
+{
+	"type":"wasm/MsgPauseContract",
+	"value": {"contract":"contract1","sender":"sender1"}
+}`,
+		},
+		"MsgUnpauseContract": {
+			src: &MsgUnpauseContract{Sender: "sender1", Contract: "contract1"},
+			exp: `
+{
+	"type":"wasm/MsgUnpauseContract",
+	"value": {"contract":"contract1","sender":"sender1"}
 }`,
 		},
 	}
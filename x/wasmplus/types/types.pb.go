@@ -0,0 +1,536 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/wasm/v1/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InactiveContractInfo stores the details of a contract deactivation
+type InactiveContractInfo struct {
+	// Address is the smart contract address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Reason is a human readable text explaining the deactivation
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// DeactivatedHeight is the block height at which the contract was
+	// deactivated
+	DeactivatedHeight int64 `protobuf:"varint,3,opt,name=deactivated_height,json=deactivatedHeight,proto3" json:"deactivated_height,omitempty"`
+	// ExpiryHeight is the block height at which the contract is activated again
+	// automatically. Zero means no expiry by block height.
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// ExpiryTime is the block time at which the contract is activated again
+	// automatically. Empty means no expiry by block time.
+	ExpiryTime *time.Time `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+}
+
+func (m *InactiveContractInfo) Reset()         { *m = InactiveContractInfo{} }
+func (m *InactiveContractInfo) String() string { return proto.CompactTextString(m) }
+func (*InactiveContractInfo) ProtoMessage()    {}
+func (*InactiveContractInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a7988258faf20f7, []int{0}
+}
+
+func (m *InactiveContractInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *InactiveContractInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InactiveContractInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *InactiveContractInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InactiveContractInfo.Merge(m, src)
+}
+
+func (m *InactiveContractInfo) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *InactiveContractInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_InactiveContractInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InactiveContractInfo proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*InactiveContractInfo)(nil), "lbm.wasm.v1.InactiveContractInfo")
+}
+
+func init() { proto.RegisterFile("lbm/wasm/v1/types.proto", fileDescriptor_5a7988258faf20f7) }
+
+var fileDescriptor_5a7988258faf20f7 = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x3d, 0x4e, 0xc3, 0x30,
+	0x1c, 0xc5, 0x63, 0x5a, 0x8a, 0x70, 0x61, 0x20, 0xaa, 0x20, 0xea, 0xe0, 0x46, 0xb0, 0x44, 0x48,
+	0xd8, 0x2a, 0x9c, 0x80, 0x22, 0x21, 0x2a, 0x31, 0x45, 0x4c, 0x2c, 0xc8, 0x49, 0xdc, 0xc4, 0x52,
+	0x1c, 0x47, 0xb1, 0x5b, 0xda, 0x5b, 0xf4, 0x18, 0x1c, 0xa5, 0x63, 0x47, 0xb6, 0x42, 0x7a, 0x11,
+	0x14, 0xbb, 0x91, 0x98, 0xec, 0xf7, 0xde, 0xcf, 0x1f, 0xef, 0x0f, 0xaf, 0xf2, 0x48, 0x90, 0x4f,
+	0xaa, 0x04, 0x59, 0x8c, 0x89, 0x5e, 0x95, 0x4c, 0xe1, 0xb2, 0x92, 0x5a, 0xba, 0xfd, 0x3c, 0x12,
+	0xb8, 0x09, 0xf0, 0x62, 0x3c, 0x1c, 0xa4, 0x32, 0x95, 0xc6, 0x27, 0xcd, 0xce, 0x22, 0xc3, 0x51,
+	0x2a, 0x65, 0x9a, 0x33, 0x62, 0x54, 0x34, 0x9f, 0x11, 0xcd, 0x05, 0x53, 0x9a, 0x8a, 0xd2, 0x02,
+	0xd7, 0x3b, 0x00, 0x07, 0xd3, 0x82, 0xc6, 0x9a, 0x2f, 0xd8, 0x93, 0x2c, 0x74, 0x45, 0x63, 0x3d,
+	0x2d, 0x66, 0xd2, 0xf5, 0xe0, 0x09, 0x4d, 0x92, 0x8a, 0x29, 0xe5, 0x01, 0x1f, 0x04, 0xa7, 0x61,
+	0x2b, 0xdd, 0x4b, 0xd8, 0xab, 0x18, 0x55, 0xb2, 0xf0, 0x8e, 0x4c, 0x70, 0x50, 0xee, 0x1d, 0x74,
+	0x13, 0x66, 0x6e, 0xa2, 0x9a, 0x25, 0x1f, 0x19, 0xe3, 0x69, 0xa6, 0xbd, 0x8e, 0x0f, 0x82, 0x4e,
+	0x78, 0xf1, 0x2f, 0x79, 0x31, 0x81, 0x7b, 0x03, 0xcf, 0xd9, 0xb2, 0xe4, 0xd5, 0xaa, 0x25, 0xbb,
+	0x3e, 0x08, 0xba, 0xe1, 0x99, 0x35, 0x0f, 0xd0, 0x23, 0xec, 0x1f, 0xa0, 0xe6, 0xe3, 0xde, 0xb1,
+	0x0f, 0x82, 0xfe, 0xfd, 0x10, 0xdb, 0x56, 0xb8, 0x6d, 0x85, 0xdf, 0xda, 0x56, 0x93, 0xee, 0x7a,
+	0x37, 0x02, 0x21, 0xb4, 0x87, 0x1a, 0x7b, 0xf2, 0xba, 0xf9, 0x45, 0xce, 0x57, 0x8d, 0xc0, 0xa6,
+	0x46, 0x60, 0x5b, 0x23, 0xf0, 0x53, 0x23, 0xb0, 0xde, 0x23, 0x67, 0xbb, 0x47, 0xce, 0xf7, 0x1e,
+	0x39, 0xef, 0xb7, 0x29, 0xd7, 0xd9, 0x3c, 0xc2, 0xb1, 0x14, 0xe4, 0x99, 0x17, 0x2a, 0xce, 0x38,
+	0x35, 0x03, 0x4f, 0xc8, 0xd2, 0xac, 0x65, 0x3e, 0x57, 0x76, 0xf2, 0x51, 0xcf, 0xbc, 0xf9, 0xf0,
+	0x37, 0x00, 0x0a, 0x0c, 0x18, 0x2e, 0x95, 0x01, 0x00, 0x00,
+}
+
+func (this *InactiveContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InactiveContractInfo)
+	if !ok {
+		that2, ok := that.(InactiveContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.DeactivatedHeight != that1.DeactivatedHeight {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	if that1.ExpiryTime == nil {
+		if this.ExpiryTime != nil {
+			return false
+		}
+	} else if !this.ExpiryTime.Equal(*that1.ExpiryTime) {
+		return false
+	}
+	return true
+}
+
+func (m *InactiveContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InactiveContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InactiveContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTypes(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DeactivatedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DeactivatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *InactiveContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DeactivatedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.DeactivatedHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *InactiveContractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InactiveContractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InactiveContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivatedHeight", wireType)
+			}
+			m.DeactivatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeactivatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)